@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	httpHandler "pills-taking-reminder/internal/api/http"
 	"pills-taking-reminder/internal/config"
 	"pills-taking-reminder/internal/infrastructure/container"
 	"pills-taking-reminder/pkg/mw"
@@ -41,6 +42,9 @@ func main() {
 	router := chi.NewRouter()
 	router.Use(mw.HTTPLoggingMiddleware(log))
 	router.Use(middleware.Recoverer)
+	if c.Authenticator != nil {
+		router.Use(mw.HTTPAuthMiddleware(c.Authenticator, log, httpHandler.PublicPaths...))
+	}
 
 	c.HTTPHandler.RegisterRoutes(router)
 
@@ -72,6 +76,18 @@ func main() {
 		}
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		c.Worker.Run(ctx)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		c.Cleaner.Run(ctx)
+	}()
+
 	log.Info("both servers have been started successfully")
 
 	sigChan := make(chan os.Signal, 1)
@@ -95,6 +111,8 @@ func main() {
 
 	c.GRPCServer.Stop()
 
+	cancel()
+
 	wg.Wait()
 	log.Info("app down!")
 }
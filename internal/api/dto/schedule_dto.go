@@ -21,10 +21,6 @@ type Taking struct {
 	TakingTime   string `json:"taking_time"`
 }
 
-type ErrorResponse struct {
-	Error string `json:"error"`
-}
-
 type GetScheduleParams struct {
 	UserID     int64 `json:"user_id"`
 	ScheduleID int64 `json:"schedule_id"`
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) CreateAPIKey(ctx context.Context, req *pb.APIKeyRequest) (*pb.APIKeyResponse, error) {
+	s.logger.Info("got CreateAPIKey request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	key, err := s.apiKeyUseCase.CreateAPIKey(ctx, usecase.APIKeyInput{
+		UserID: req.UserId,
+		Name:   req.Name,
+		Scopes: req.Scopes,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("api key creation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("api key creation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to create api key in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return apiKeyResponse(*key), nil
+}
+
+func (s *GRPCServer) GetAPIKeys(ctx context.Context, req *pb.UserIDRequest) (*pb.APIKeyList, error) {
+	s.logger.Info("got GetAPIKeys request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	keys, err := s.apiKeyUseCase.GetAPIKeys(ctx, req.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("request for getting api keys rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting api keys rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to get api keys in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	pbKeys := make([]*pb.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		pbKeys[i] = apiKeyResponse(key)
+	}
+
+	return &pb.APIKeyList{
+		Keys: pbKeys,
+	}, nil
+}
+
+func (s *GRPCServer) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.APIKeyResponse, error) {
+	s.logger.Info("got RevokeAPIKey request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("key_id", req.KeyId))
+
+	if err := s.apiKeyUseCase.RevokeAPIKey(ctx, req.UserId, req.KeyId); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("api key revocation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("api key revocation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrAPIKeyNotFound):
+			s.logger.Debug("api key revocation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "API key was not found")
+		default:
+			s.logger.Error("failed to revoke api key in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.APIKeyResponse{
+		Id:     req.KeyId,
+		UserId: req.UserId,
+	}, nil
+}
+
+func apiKeyResponse(key usecase.APIKeyOutput) *pb.APIKeyResponse {
+	response := &pb.APIKeyResponse{
+		Id:        key.ID,
+		UserId:    key.UserID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		CreatedAt: key.CreatedAt.Format(time.RFC3339),
+		Key:       key.Key,
+	}
+	if key.RevokedAt != nil {
+		response.RevokedAt = key.RevokedAt.Format(time.RFC3339)
+	}
+	return response
+}
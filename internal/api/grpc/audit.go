@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) GetScheduleHistory(ctx context.Context, req *pb.ScheduleHistoryRequest) (*pb.ScheduleHistory, error) {
+	s.logger.Info("got GetScheduleHistory request in grpc",
+		slog.Int64("schedule_id", req.ScheduleId))
+
+	entries, err := s.scheduleUseCase.GetScheduleHistory(ctx, req.ScheduleId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("request for getting schedule history rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting schedule history rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			s.logger.Debug("request for getting schedule history rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		default:
+			s.logger.Error("failed to get schedule history in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	pbEntries := make([]*pb.AuditEntry, len(entries))
+	for i, entry := range entries {
+		pbEntries[i] = &pb.AuditEntry{
+			Id:         entry.ID,
+			ScheduleId: entry.ScheduleID,
+			UserId:     entry.UserID,
+			Action:     entry.Action,
+			TraceId:    entry.TraceID,
+			Before:     string(entry.Before),
+			After:      string(entry.After),
+			CreatedAt:  entry.CreatedAt.Format(time.RFC3339),
+		}
+		if entry.ActorID != nil {
+			pbEntries[i].ActorId = *entry.ActorID
+		}
+	}
+
+	return &pb.ScheduleHistory{
+		Entries: pbEntries,
+	}, nil
+}
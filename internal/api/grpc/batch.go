@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateSchedules answers with the per-schedule results even when an
+// all-or-nothing batch was not created.
+func (s *GRPCServer) CreateSchedules(ctx context.Context, req *pb.BatchScheduleRequest) (*pb.BatchScheduleResponse, error) {
+	s.logger.Info("got batch schedule creating request in grpc",
+		slog.Int("schedules", len(req.Schedules)),
+		slog.String("mode", req.Mode))
+
+	inputs := make([]usecase.ScheduleInput, len(req.Schedules))
+	for i, schedule := range req.Schedules {
+		inputs[i] = usecase.ScheduleInput{
+			MedicineName:         schedule.MedicineName,
+			MedicineID:           schedule.MedicineId,
+			Frequency:            int(schedule.Frequency),
+			Duration:             int(schedule.Duration),
+			UserID:               schedule.UserId,
+			Dose:                 schedule.Dose,
+			OverrideInteractions: schedule.OverrideInteractions,
+		}
+	}
+
+	output, err := s.scheduleUseCase.CreateSchedules(ctx, inputs, usecase.BatchMode(req.Mode))
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidInput) {
+			s.logger.Debug("batch schedule creation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		}
+		s.logger.Error("failed to create schedules in gRPC", slog.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	results := make([]*pb.BatchScheduleResult, len(output.Items))
+	for i, item := range output.Items {
+		results[i] = &pb.BatchScheduleResult{
+			Id:       item.ID,
+			Error:    item.Error,
+			Warnings: interactionWarnings(item.Warnings),
+		}
+	}
+	return &pb.BatchScheduleResponse{
+		Created: int32(output.Created),
+		Results: results,
+	}, nil
+}
@@ -0,0 +1,178 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) GetCalendar(ctx context.Context, req *pb.CalendarRequest) (*pb.Calendar, error) {
+	s.logger.Info("got GetCalendar request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	from, err := time.Parse(time.DateOnly, req.From)
+	if err != nil {
+		s.logger.Debug("calendar request rejected in gRPC", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+	}
+	to, err := time.Parse(time.DateOnly, req.To)
+	if err != nil {
+		s.logger.Debug("calendar request rejected in gRPC", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+	}
+
+	takings, err := s.calendarUseCase.GetCalendar(ctx, req.UserId, from, to)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("calendar request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("calendar request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to get calendar in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	pbTakings := make([]*pb.CalendarTaking, len(takings))
+	for i, taking := range takings {
+		pbTakings[i] = &pb.CalendarTaking{
+			ScheduleId:   taking.ScheduleID,
+			MedicineName: taking.MedicineName,
+			PlannedAt:    taking.PlannedAt.Format(time.RFC3339),
+			Status:       taking.Status,
+		}
+		if taking.TakenAt != nil {
+			pbTakings[i].TakenAt = taking.TakenAt.Format(time.RFC3339)
+		}
+	}
+
+	return &pb.Calendar{
+		Takings: pbTakings,
+	}, nil
+}
+
+func (s *GRPCServer) ExportSchedulesICS(ctx context.Context, req *pb.UserIDRequest) (*pb.ICSCalendar, error) {
+	s.logger.Info("got ExportSchedulesICS request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	calendar, err := s.calendarUseCase.ExportICS(ctx, req.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("iCalendar export request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("iCalendar export request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to export schedules to iCalendar in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.ICSCalendar{
+		Data: string(calendar),
+	}, nil
+}
+
+func (s *GRPCServer) ImportSchedulesICS(ctx context.Context, req *pb.ImportICSRequest) (*pb.ICSImportReport, error) {
+	s.logger.Info("got ImportSchedulesICS request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Bool("dry_run", req.DryRun))
+
+	report, err := s.scheduleUseCase.ImportICS(ctx, req.UserId, strings.NewReader(req.Data), req.DryRun)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("iCalendar import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("iCalendar import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to import schedules from iCalendar in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	schedules := make([]*pb.ScheduleResponse, len(report.Schedules))
+	for i := range report.Schedules {
+		schedules[i] = scheduleResponse(&report.Schedules[i])
+	}
+	skipped := make([]*pb.SkippedEvent, len(report.Skipped))
+	for i, event := range report.Skipped {
+		skipped[i] = &pb.SkippedEvent{
+			Uid:     event.UID,
+			Summary: event.Summary,
+			Reason:  event.Reason,
+		}
+	}
+
+	return &pb.ICSImportReport{
+		DryRun:    report.DryRun,
+		Schedules: schedules,
+		Skipped:   skipped,
+	}, nil
+}
+
+func (s *GRPCServer) CreateCalendarFeed(ctx context.Context, req *pb.UserIDRequest) (*pb.CalendarFeed, error) {
+	s.logger.Info("got CreateCalendarFeed request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	feed, err := s.calendarUseCase.CreateFeed(ctx, req.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("calendar feed request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("calendar feed request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to create calendar feed in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.CalendarFeed{
+		UserId:    feed.UserID,
+		Path:      feed.Path,
+		CreatedAt: feed.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (s *GRPCServer) RevokeCalendarFeed(ctx context.Context, req *pb.UserIDRequest) (*pb.CalendarFeed, error) {
+	s.logger.Info("got RevokeCalendarFeed request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	if err := s.calendarUseCase.RevokeFeed(ctx, req.UserId); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("calendar feed revoke request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("calendar feed revoke request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrFeedNotFound):
+			s.logger.Debug("calendar feed revoke request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Calendar feed was not found")
+		default:
+			s.logger.Error("failed to revoke calendar feed in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.CalendarFeed{
+		UserId: req.UserId,
+	}, nil
+}
@@ -0,0 +1,166 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) LinkCaregiver(ctx context.Context, req *pb.CaregiverRequest) (*pb.CaregiverResponse, error) {
+	s.logger.Info("got LinkCaregiver request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	caregiver, err := s.caregiverUseCase.LinkCaregiver(ctx, caregiverInput(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("caregiver link request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("caregiver link request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrLinkExists):
+			s.logger.Debug("caregiver link request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.AlreadyExists, "Caregiver already linked")
+		default:
+			s.logger.Error("failed to link caregiver in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return caregiverResponse(*caregiver), nil
+}
+
+func (s *GRPCServer) UpdateCaregiver(ctx context.Context, req *pb.CaregiverRequest) (*pb.CaregiverResponse, error) {
+	s.logger.Info("got UpdateCaregiver request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	caregiver, err := s.caregiverUseCase.UpdateCaregiver(ctx, caregiverInput(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("caregiver update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("caregiver update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrLinkNotFound):
+			s.logger.Debug("caregiver update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Caregiver link was not found")
+		default:
+			s.logger.Error("failed to update caregiver in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return caregiverResponse(*caregiver), nil
+}
+
+func (s *GRPCServer) UnlinkCaregiver(ctx context.Context, req *pb.CaregiverRequest) (*pb.CaregiverResponse, error) {
+	s.logger.Info("got UnlinkCaregiver request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	if err := s.caregiverUseCase.UnlinkCaregiver(ctx, req.UserId, req.CaregiverId); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("caregiver unlink request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("caregiver unlink request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrLinkNotFound):
+			s.logger.Debug("caregiver unlink request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Caregiver link was not found")
+		default:
+			s.logger.Error("failed to unlink caregiver in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.CaregiverResponse{
+		UserId:      req.UserId,
+		CaregiverId: req.CaregiverId,
+	}, nil
+}
+
+func (s *GRPCServer) GetCaregivers(ctx context.Context, req *pb.UserIDRequest) (*pb.CaregiverList, error) {
+	s.logger.Info("got GetCaregivers request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	caregivers, err := s.caregiverUseCase.GetCaregivers(ctx, req.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("request for getting caregivers rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting caregivers rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to get caregivers in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return caregiverList(caregivers), nil
+}
+
+func (s *GRPCServer) GetDependants(ctx context.Context, req *pb.UserIDRequest) (*pb.CaregiverList, error) {
+	s.logger.Info("got GetDependants request in grpc",
+		slog.Int64("caregiver_id", req.UserId))
+
+	dependants, err := s.caregiverUseCase.GetDependants(ctx, req.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("request for getting dependants rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting dependants rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to get dependants in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return caregiverList(dependants), nil
+}
+
+func caregiverInput(req *pb.CaregiverRequest) usecase.CaregiverInput {
+	return usecase.CaregiverInput{
+		UserID:        req.UserId,
+		CaregiverID:   req.CaregiverId,
+		CanView:       req.CanView,
+		CanEdit:       req.CanEdit,
+		ReceiveAlerts: req.ReceiveAlerts,
+	}
+}
+
+func caregiverResponse(caregiver usecase.CaregiverOutput) *pb.CaregiverResponse {
+	return &pb.CaregiverResponse{
+		UserId:        caregiver.UserID,
+		CaregiverId:   caregiver.CaregiverID,
+		LinkedAt:      caregiver.LinkedAt.Format(time.RFC3339),
+		CanView:       caregiver.CanView,
+		CanEdit:       caregiver.CanEdit,
+		ReceiveAlerts: caregiver.ReceiveAlerts,
+	}
+}
+
+func caregiverList(caregivers []usecase.CaregiverOutput) *pb.CaregiverList {
+	pbCaregivers := make([]*pb.CaregiverResponse, len(caregivers))
+	for i, caregiver := range caregivers {
+		pbCaregivers[i] = caregiverResponse(caregiver)
+	}
+
+	return &pb.CaregiverList{
+		Caregivers: pbCaregivers,
+	}
+}
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) SetDoseLimit(ctx context.Context, req *pb.DoseLimitRequest) (*pb.DoseLimit, error) {
+	s.logger.Info("got SetDoseLimit request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.String("medicine", req.MedicineName))
+
+	limit, err := s.doseLimitUseCase.SetDoseLimit(ctx, usecase.DoseLimitInput{
+		UserID:       req.UserId,
+		MedicineID:   req.MedicineId,
+		MedicineName: req.MedicineName,
+		MaxDailyDose: req.MaxDailyDose,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("dose limit setting request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("dose limit setting request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrMedicineNotFound):
+			s.logger.Debug("dose limit setting request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.NotFound, problem.CodeMedicineNotFound, "Medicine was not found", mw.GetTraceID(ctx), nil).Err()
+		default:
+			s.logger.Error("failed to set dose limit in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return doseLimitMessage(limit), nil
+}
+
+func (s *GRPCServer) GetDoseLimits(ctx context.Context, req *pb.UserIDRequest) (*pb.DoseLimitList, error) {
+	s.logger.Info("got GetDoseLimits request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	limits, err := s.doseLimitUseCase.GetDoseLimits(ctx, req.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("request for getting dose limits rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting dose limits rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to get dose limits in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	pbLimits := make([]*pb.DoseLimit, len(limits))
+	for i := range limits {
+		pbLimits[i] = doseLimitMessage(&limits[i])
+	}
+
+	return &pb.DoseLimitList{
+		DoseLimits: pbLimits,
+	}, nil
+}
+
+func (s *GRPCServer) DeleteDoseLimit(ctx context.Context, req *pb.DeleteDoseLimitRequest) (*pb.DoseLimit, error) {
+	s.logger.Info("got DeleteDoseLimit request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.String("ingredient", req.Ingredient))
+
+	limit, err := s.doseLimitUseCase.DeleteDoseLimit(ctx, req.UserId, req.Ingredient)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("dose limit deletion request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("dose limit deletion request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrDoseLimitNotFound):
+			s.logger.Debug("dose limit deletion request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Dose limit was not found")
+		default:
+			s.logger.Error("failed to delete dose limit in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return doseLimitMessage(limit), nil
+}
+
+func doseLimitMessage(limit *usecase.DoseLimitOutput) *pb.DoseLimit {
+	return &pb.DoseLimit{
+		UserId:       limit.UserID,
+		Ingredient:   limit.Ingredient,
+		MaxDailyDose: limit.MaxDailyDose,
+	}
+}
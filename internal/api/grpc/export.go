@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) ExportUserData(ctx context.Context, req *pb.ExportRequest) (*pb.UserDataExport, error) {
+	s.logger.Info("got ExportUserData request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.String("format", req.Format))
+
+	format := usecase.ExportJSON
+	if req.Format != "" {
+		format = usecase.ExportFormat(req.Format)
+	}
+
+	data, err := s.exportUseCase.Export(ctx, req.UserId, format)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("export request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("export request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to export user data in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.UserDataExport{
+		Format: string(format),
+		Data:   data,
+	}, nil
+}
+
+func (s *GRPCServer) ImportUserData(ctx context.Context, req *pb.ImportRequest) (*pb.ImportReport, error) {
+	s.logger.Info("got ImportUserData request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.String("format", req.Format),
+		slog.String("policy", req.Policy))
+
+	input := usecase.ImportInput{
+		UserID: req.UserId,
+		Format: usecase.ExportJSON,
+		Policy: usecase.ImportPolicy(req.Policy),
+		Data:   req.Data,
+	}
+	if req.Format != "" {
+		input.Format = usecase.ExportFormat(req.Format)
+	}
+
+	report, err := s.exportUseCase.Import(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleExists):
+			s.logger.Debug("import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.AlreadyExists, problem.CodeScheduleExists, "Schedule already exists", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrScheduleModified):
+			s.logger.Debug("import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.Aborted, problem.CodeScheduleModified, "Schedule was modified during the import", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrContraindicated):
+			s.logger.Debug("import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeInteractionContraindicated,
+				"Medicine is contraindicated with another one", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			s.logger.Debug("import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeDailyDoseExceeded, "Daily dose exceeds the limit", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			s.logger.Debug("import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, separationError(ctx, err)
+		default:
+			s.logger.Error("failed to import user data in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	schedules := make([]*pb.ImportedSchedule, len(report.Schedules))
+	for i, schedule := range report.Schedules {
+		schedules[i] = &pb.ImportedSchedule{
+			MedicineName:   schedule.MedicineName,
+			Action:         schedule.Action,
+			ScheduleId:     schedule.ScheduleID,
+			Intakes:        int32(schedule.Intakes),
+			SkippedIntakes: int32(schedule.SkippedIntakes),
+		}
+	}
+
+	return &pb.ImportReport{
+		Schedules: schedules,
+	}, nil
+}
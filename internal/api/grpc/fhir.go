@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) ImportMedicationRequest(ctx context.Context, req *pb.FHIRResource) (*pb.ScheduleResponse, error) {
+	s.logger.Info("got ImportMedicationRequest request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	schedule, err := s.scheduleUseCase.ImportMedicationRequest(ctx, req.UserId, bytes.NewReader(req.Data))
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("FHIR import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrUnsupportedDosage):
+			s.logger.Debug("FHIR import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Dosage can not be mapped to a daily schedule")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("FHIR import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleExists):
+			s.logger.Debug("FHIR import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.AlreadyExists, problem.CodeScheduleExists, "Schedule already exists", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrContraindicated):
+			s.logger.Debug("FHIR import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeInteractionContraindicated,
+				"Medicine is contraindicated with another one", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			s.logger.Debug("FHIR import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeDailyDoseExceeded, "Daily dose exceeds the limit", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			s.logger.Debug("FHIR import request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, separationError(ctx, err)
+		default:
+			s.logger.Error("failed to import FHIR medication request in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return scheduleResponse(schedule), nil
+}
+
+func (s *GRPCServer) ExportMedicationStatements(ctx context.Context, req *pb.UserIDRequest) (*pb.FHIRResource, error) {
+	s.logger.Info("got ExportMedicationStatements request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	bundle, err := s.exportUseCase.MedicationStatements(ctx, req.UserId)
+	if err != nil {
+		return nil, s.fhirExportError(err)
+	}
+
+	return &pb.FHIRResource{
+		UserId: req.UserId,
+		Data:   bundle,
+	}, nil
+}
+
+func (s *GRPCServer) ExportMedicationAdministrations(ctx context.Context, req *pb.UserIDRequest) (*pb.FHIRResource, error) {
+	s.logger.Info("got ExportMedicationAdministrations request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	bundle, err := s.exportUseCase.MedicationAdministrations(ctx, req.UserId)
+	if err != nil {
+		return nil, s.fhirExportError(err)
+	}
+
+	return &pb.FHIRResource{
+		UserId: req.UserId,
+		Data:   bundle,
+	}, nil
+}
+
+func (s *GRPCServer) fhirExportError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidInput):
+		s.logger.Debug("FHIR export request rejected in gRPC", slog.String("error", err.Error()))
+		return status.Error(codes.InvalidArgument, "Invalid input parameters")
+	case errors.Is(err, usecase.ErrPermissionDenied):
+		s.logger.Debug("FHIR export request rejected in gRPC", slog.String("error", err.Error()))
+		return status.Error(codes.PermissionDenied, "Permission denied")
+	default:
+		s.logger.Error("failed to export FHIR bundle in gRPC", slog.String("error", err.Error()))
+		return status.Error(codes.Internal, "Internal server error")
+	}
+}
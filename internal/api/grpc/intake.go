@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) RecordIntake(ctx context.Context, req *pb.IntakeRequest) (*pb.IntakeResponse, error) {
+	s.logger.Info("got RecordIntake request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("schedule_id", req.ScheduleId),
+		slog.String("planned_at", req.PlannedAt))
+
+	plannedAt, err := time.Parse(time.RFC3339, req.PlannedAt)
+	if err != nil {
+		s.logger.Debug("intake request rejected in gRPC", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+	}
+
+	input := usecase.IntakeInput{
+		UserID:     req.UserId,
+		ScheduleID: req.ScheduleId,
+		PlannedAt:  plannedAt,
+	}
+
+	if req.TakenAt != "" {
+		input.TakenAt, err = time.Parse(time.RFC3339, req.TakenAt)
+		if err != nil {
+			s.logger.Debug("intake request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		}
+	}
+
+	intake, err := s.intakeUseCase.RecordIntake(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("intake request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("intake request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrTakingNotPlanned):
+			s.logger.Debug("intake request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Taking is not planned at this time")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			s.logger.Debug("intake request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrIntakeExists):
+			s.logger.Debug("intake request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.AlreadyExists, "Intake already recorded")
+		default:
+			s.logger.Error("failed to record intake in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.IntakeResponse{
+		Id:           intake.ID,
+		ScheduleId:   intake.ScheduleID,
+		MedicineName: intake.MedicineName,
+		PlannedAt:    intake.PlannedAt.Format(time.RFC3339),
+		TakenAt:      intake.TakenAt.Format(time.RFC3339),
+	}, nil
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) RecordRefill(ctx context.Context, req *pb.RefillRequest) (*pb.InventoryResponse, error) {
+	s.logger.Info("got RecordRefill request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("schedule_id", req.ScheduleId),
+		slog.Int("quantity", int(req.Quantity)))
+
+	input := usecase.RefillInput{
+		UserID:        req.UserId,
+		ScheduleID:    req.ScheduleId,
+		Quantity:      int(req.Quantity),
+		DosePerTaking: int(req.DosePerTaking),
+	}
+
+	inventory, err := s.inventoryUseCase.RecordRefill(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("refill request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("refill request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			s.logger.Debug("refill request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		default:
+			s.logger.Error("failed to record refill in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return inventoryResponse(inventory), nil
+}
+
+func (s *GRPCServer) GetInventory(ctx context.Context, req *pb.ScheduleIDRequest) (*pb.InventoryResponse, error) {
+	s.logger.Info("got GetInventory request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("schedule_id", req.ScheduleId))
+
+	inventory, err := s.inventoryUseCase.GetInventory(ctx, req.UserId, req.ScheduleId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("request for getting inventory rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting inventory rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			s.logger.Debug("request for getting inventory rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrPackNotFound):
+			s.logger.Debug("request for getting inventory rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Pack was not found")
+		default:
+			s.logger.Error("failed to get inventory in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return inventoryResponse(inventory), nil
+}
+
+func inventoryResponse(inventory *usecase.InventoryOutput) *pb.InventoryResponse {
+	return &pb.InventoryResponse{
+		ScheduleId:    inventory.ScheduleID,
+		MedicineName:  inventory.MedicineName,
+		Quantity:      int32(inventory.Quantity),
+		DosePerTaking: int32(inventory.DosePerTaking),
+		Remaining:     int32(inventory.Remaining),
+		DaysLeft:      inventory.DaysLeft,
+		RefilledAt:    inventory.RefilledAt,
+	}
+}
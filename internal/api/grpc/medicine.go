@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) SearchMedicines(ctx context.Context, req *pb.MedicineSearchRequest) (*pb.MedicineList, error) {
+	s.logger.Info("got SearchMedicines request in grpc",
+		slog.String("query", req.Query),
+		slog.Int("limit", int(req.Limit)))
+
+	medicines, err := s.medicineUseCase.SearchMedicines(ctx, req.Query, int(req.Limit))
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidInput) {
+			s.logger.Debug("medicine search request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		}
+		s.logger.Error("failed to search medicines in gRPC", slog.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	pbMedicines := make([]*pb.Medicine, len(medicines))
+	for i, m := range medicines {
+		pbMedicines[i] = &pb.Medicine{
+			Id:           m.ID,
+			Name:         m.Name,
+			Ingredient:   m.Ingredient,
+			Synonyms:     m.Synonyms,
+			MaxDailyDose: m.MaxDailyDose,
+		}
+	}
+
+	return &pb.MedicineList{
+		Medicines: pbMedicines,
+	}, nil
+}
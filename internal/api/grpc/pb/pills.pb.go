@@ -21,12 +21,21 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// medicine_id is the catalog medicine, the schedule is named after it when
+// medicine_name is empty. Without it the medicine is found in the catalog by
+// the name, the medicines missing there are custom ones.
 type ScheduleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MedicineName  string                 `protobuf:"bytes,1,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
-	Frequency     int32                  `protobuf:"varint,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
-	Duration      int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
-	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	MedicineName string                 `protobuf:"bytes,1,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	Frequency    int32                  `protobuf:"varint,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Duration     int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	UserId       int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MedicineId   string                 `protobuf:"bytes,5,opt,name=medicine_id,json=medicineId,proto3" json:"medicine_id,omitempty"`
+	// override_interactions creates the schedule even if its medicine is
+	// contraindicated with the medicine of an active schedule.
+	OverrideInteractions bool `protobuf:"varint,6,opt,name=override_interactions,json=overrideInteractions,proto3" json:"override_interactions,omitempty"`
+	// dose is the dose of one taking in mg, 0 when unknown.
+	Dose          float64 `protobuf:"fixed64,7,opt,name=dose,proto3" json:"dose,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,16 +98,152 @@ func (x *ScheduleRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *ScheduleRequest) GetMedicineId() string {
+	if x != nil {
+		return x.MedicineId
+	}
+	return ""
+}
+
+func (x *ScheduleRequest) GetOverrideInteractions() bool {
+	if x != nil {
+		return x.OverrideInteractions
+	}
+	return false
+}
+
+func (x *ScheduleRequest) GetDose() float64 {
+	if x != nil {
+		return x.Dose
+	}
+	return 0
+}
+
+type UpdateScheduleRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId   int64                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MedicineName string                 `protobuf:"bytes,3,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	Frequency    int32                  `protobuf:"varint,4,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Duration     int32                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// version is the version of the schedule the update is based on.
+	Version    int64   `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	MedicineId string  `protobuf:"bytes,7,opt,name=medicine_id,json=medicineId,proto3" json:"medicine_id,omitempty"`
+	Dose       float64 `protobuf:"fixed64,8,opt,name=dose,proto3" json:"dose,omitempty"`
+	// override_interactions updates the schedule even if its new medicine is
+	// contraindicated with the medicine of an active schedule.
+	OverrideInteractions bool `protobuf:"varint,9,opt,name=override_interactions,json=overrideInteractions,proto3" json:"override_interactions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateScheduleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetFrequency() int32 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetMedicineId() string {
+	if x != nil {
+		return x.MedicineId
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetDose() float64 {
+	if x != nil {
+		return x.Dose
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetOverrideInteractions() bool {
+	if x != nil {
+		return x.OverrideInteractions
+	}
+	return false
+}
+
+// ScheduleIDResponse has the id of the created schedule and the warnings of
+// the interactions of its medicine with the active schedules, the most
+// severe first.
 type ScheduleIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Warnings      []*InteractionWarning  `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScheduleIDResponse) Reset() {
 	*x = ScheduleIDResponse{}
-	mi := &file_api_proto_pills_proto_msgTypes[1]
+	mi := &file_api_proto_pills_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -110,7 +255,7 @@ func (x *ScheduleIDResponse) String() string {
 func (*ScheduleIDResponse) ProtoMessage() {}
 
 func (x *ScheduleIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_pills_proto_msgTypes[1]
+	mi := &file_api_proto_pills_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,7 +268,7 @@ func (x *ScheduleIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleIDResponse.ProtoReflect.Descriptor instead.
 func (*ScheduleIDResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_pills_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ScheduleIDResponse) GetScheduleId() int64 {
@@ -133,6 +278,91 @@ func (x *ScheduleIDResponse) GetScheduleId() int64 {
 	return 0
 }
 
+func (x *ScheduleIDResponse) GetWarnings() []*InteractionWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// InteractionWarning tells the active schedule of an interacting medicine.
+// The severity is minor, moderate, major or contraindicated.
+type InteractionWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MedicineId    string                 `protobuf:"bytes,2,opt,name=medicine_id,json=medicineId,proto3" json:"medicine_id,omitempty"`
+	MedicineName  string                 `protobuf:"bytes,3,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractionWarning) Reset() {
+	*x = InteractionWarning{}
+	mi := &file_api_proto_pills_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractionWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractionWarning) ProtoMessage() {}
+
+func (x *InteractionWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractionWarning.ProtoReflect.Descriptor instead.
+func (*InteractionWarning) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InteractionWarning) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *InteractionWarning) GetMedicineId() string {
+	if x != nil {
+		return x.MedicineId
+	}
+	return ""
+}
+
+func (x *InteractionWarning) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *InteractionWarning) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *InteractionWarning) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 type ScheduleIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -143,7 +373,7 @@ type ScheduleIDRequest struct {
 
 func (x *ScheduleIDRequest) Reset() {
 	*x = ScheduleIDRequest{}
-	mi := &file_api_proto_pills_proto_msgTypes[2]
+	mi := &file_api_proto_pills_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -155,7 +385,7 @@ func (x *ScheduleIDRequest) String() string {
 func (*ScheduleIDRequest) ProtoMessage() {}
 
 func (x *ScheduleIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_pills_proto_msgTypes[2]
+	mi := &file_api_proto_pills_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,7 +398,7 @@ func (x *ScheduleIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleIDRequest.ProtoReflect.Descriptor instead.
 func (*ScheduleIDRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_pills_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ScheduleIDRequest) GetUserId() int64 {
@@ -194,7 +424,7 @@ type UserIDRequest struct {
 
 func (x *UserIDRequest) Reset() {
 	*x = UserIDRequest{}
-	mi := &file_api_proto_pills_proto_msgTypes[3]
+	mi := &file_api_proto_pills_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +436,7 @@ func (x *UserIDRequest) String() string {
 func (*UserIDRequest) ProtoMessage() {}
 
 func (x *UserIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_pills_proto_msgTypes[3]
+	mi := &file_api_proto_pills_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +449,7 @@ func (x *UserIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserIDRequest.ProtoReflect.Descriptor instead.
 func (*UserIDRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_pills_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UserIDRequest) GetUserId() int64 {
@@ -230,20 +460,30 @@ func (x *UserIDRequest) GetUserId() int64 {
 }
 
 type ScheduleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	MedicineName  string                 `protobuf:"bytes,2,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
-	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	UserId        int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TakingTime    []string               `protobuf:"bytes,6,rep,name=taking_time,json=takingTime,proto3" json:"taking_time,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MedicineName string                 `protobuf:"bytes,2,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	StartDate    string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate      string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	UserId       int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TakingTime   []string               `protobuf:"bytes,6,rep,name=taking_time,json=takingTime,proto3" json:"taking_time,omitempty"`
+	Version      int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// medicine_id is empty for the custom medicines.
+	MedicineId string `protobuf:"bytes,8,opt,name=medicine_id,json=medicineId,proto3" json:"medicine_id,omitempty"`
+	// dose is the dose of one taking in mg, 0 when unknown.
+	Dose float64 `protobuf:"fixed64,9,opt,name=dose,proto3" json:"dose,omitempty"`
+	// warnings are the interactions of a medicine changed by the update with
+	// the active schedules, the most severe first. Empty in the other replies.
+	Warnings []*InteractionWarning `protobuf:"bytes,10,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// paused schedules have no takings and send no reminders.
+	Paused        bool `protobuf:"varint,11,opt,name=paused,proto3" json:"paused,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScheduleResponse) Reset() {
 	*x = ScheduleResponse{}
-	mi := &file_api_proto_pills_proto_msgTypes[4]
+	mi := &file_api_proto_pills_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -255,7 +495,7 @@ func (x *ScheduleResponse) String() string {
 func (*ScheduleResponse) ProtoMessage() {}
 
 func (x *ScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_pills_proto_msgTypes[4]
+	mi := &file_api_proto_pills_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +508,7 @@ func (x *ScheduleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleResponse.ProtoReflect.Descriptor instead.
 func (*ScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_pills_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ScheduleResponse) GetId() int64 {
@@ -313,6 +553,112 @@ func (x *ScheduleResponse) GetTakingTime() []string {
 	return nil
 }
 
+func (x *ScheduleResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ScheduleResponse) GetMedicineId() string {
+	if x != nil {
+		return x.MedicineId
+	}
+	return ""
+}
+
+func (x *ScheduleResponse) GetDose() float64 {
+	if x != nil {
+		return x.Dose
+	}
+	return 0
+}
+
+func (x *ScheduleResponse) GetWarnings() []*InteractionWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ScheduleResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// SchedulePauseRequest pauses the schedule, or resumes it with paused unset.
+// override_interactions resumes it even if its medicine is contraindicated
+// with the medicine of an active schedule.
+type SchedulePauseRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId           int64                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Paused               bool                   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	OverrideInteractions bool                   `protobuf:"varint,4,opt,name=override_interactions,json=overrideInteractions,proto3" json:"override_interactions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SchedulePauseRequest) Reset() {
+	*x = SchedulePauseRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePauseRequest) ProtoMessage() {}
+
+func (x *SchedulePauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePauseRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePauseRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SchedulePauseRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SchedulePauseRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *SchedulePauseRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *SchedulePauseRequest) GetOverrideInteractions() bool {
+	if x != nil {
+		return x.OverrideInteractions
+	}
+	return false
+}
+
 type ScheduleIDList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScheduleIds   []int64                `protobuf:"varint,1,rep,packed,name=schedule_ids,json=scheduleIds,proto3" json:"schedule_ids,omitempty"`
@@ -322,7 +668,7 @@ type ScheduleIDList struct {
 
 func (x *ScheduleIDList) Reset() {
 	*x = ScheduleIDList{}
-	mi := &file_api_proto_pills_proto_msgTypes[5]
+	mi := &file_api_proto_pills_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +680,7 @@ func (x *ScheduleIDList) String() string {
 func (*ScheduleIDList) ProtoMessage() {}
 
 func (x *ScheduleIDList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_pills_proto_msgTypes[5]
+	mi := &file_api_proto_pills_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +693,7 @@ func (x *ScheduleIDList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleIDList.ProtoReflect.Descriptor instead.
 func (*ScheduleIDList) Descriptor() ([]byte, []int) {
-	return file_api_proto_pills_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ScheduleIDList) GetScheduleIds() []int64 {
@@ -358,16 +704,18 @@ func (x *ScheduleIDList) GetScheduleIds() []int64 {
 }
 
 type Taking struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MedicineName  string                 `protobuf:"bytes,1,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
-	TakingTime    string                 `protobuf:"bytes,2,opt,name=taking_time,json=takingTime,proto3" json:"taking_time,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	MedicineName string                 `protobuf:"bytes,1,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	TakingTime   string                 `protobuf:"bytes,2,opt,name=taking_time,json=takingTime,proto3" json:"taking_time,omitempty"`
+	// taking_date is the day of the taking, YYYY-MM-DD.
+	TakingDate    string `protobuf:"bytes,3,opt,name=taking_date,json=takingDate,proto3" json:"taking_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Taking) Reset() {
 	*x = Taking{}
-	mi := &file_api_proto_pills_proto_msgTypes[6]
+	mi := &file_api_proto_pills_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -379,7 +727,7 @@ func (x *Taking) String() string {
 func (*Taking) ProtoMessage() {}
 
 func (x *Taking) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_pills_proto_msgTypes[6]
+	mi := &file_api_proto_pills_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +740,7 @@ func (x *Taking) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Taking.ProtoReflect.Descriptor instead.
 func (*Taking) Descriptor() ([]byte, []int) {
-	return file_api_proto_pills_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Taking) GetMedicineName() string {
@@ -409,28 +757,2888 @@ func (x *Taking) GetTakingTime() string {
 	return ""
 }
 
-type TakingList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Takings       []*Taking              `protobuf:"bytes,1,rep,name=takings,proto3" json:"takings,omitempty"`
+func (x *Taking) GetTakingDate() string {
+	if x != nil {
+		return x.TakingDate
+	}
+	return ""
+}
+
+// NextTakingsRequest selects the takings in the next within, a duration like
+// 90m or 12h, at most limit of them. Without within the configured window is
+// used, or up to a week when only a limit is given.
+type NextTakingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Within        string                 `protobuf:"bytes,2,opt,name=within,proto3" json:"within,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextTakingsRequest) Reset() {
+	*x = NextTakingsRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextTakingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextTakingsRequest) ProtoMessage() {}
+
+func (x *NextTakingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextTakingsRequest.ProtoReflect.Descriptor instead.
+func (*NextTakingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NextTakingsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NextTakingsRequest) GetWithin() string {
+	if x != nil {
+		return x.Within
+	}
+	return ""
+}
+
+func (x *NextTakingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TakingList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Takings       []*Taking              `protobuf:"bytes,1,rep,name=takings,proto3" json:"takings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakingList) Reset() {
+	*x = TakingList{}
+	mi := &file_api_proto_pills_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakingList) ProtoMessage() {}
+
+func (x *TakingList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakingList.ProtoReflect.Descriptor instead.
+func (*TakingList) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TakingList) GetTakings() []*Taking {
+	if x != nil {
+		return x.Takings
+	}
+	return nil
+}
+
+type RefillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId    int64                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	DosePerTaking int32                  `protobuf:"varint,4,opt,name=dose_per_taking,json=dosePerTaking,proto3" json:"dose_per_taking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefillRequest) Reset() {
+	*x = RefillRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefillRequest) ProtoMessage() {}
+
+func (x *RefillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefillRequest.ProtoReflect.Descriptor instead.
+func (*RefillRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefillRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RefillRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *RefillRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RefillRequest) GetDosePerTaking() int32 {
+	if x != nil {
+		return x.DosePerTaking
+	}
+	return 0
+}
+
+type InventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MedicineName  string                 `protobuf:"bytes,2,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	DosePerTaking int32                  `protobuf:"varint,4,opt,name=dose_per_taking,json=dosePerTaking,proto3" json:"dose_per_taking,omitempty"`
+	Remaining     int32                  `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	DaysLeft      float64                `protobuf:"fixed64,6,opt,name=days_left,json=daysLeft,proto3" json:"days_left,omitempty"`
+	RefilledAt    string                 `protobuf:"bytes,7,opt,name=refilled_at,json=refilledAt,proto3" json:"refilled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryResponse) Reset() {
+	*x = InventoryResponse{}
+	mi := &file_api_proto_pills_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryResponse) ProtoMessage() {}
+
+func (x *InventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryResponse.ProtoReflect.Descriptor instead.
+func (*InventoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InventoryResponse) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *InventoryResponse) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *InventoryResponse) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventoryResponse) GetDosePerTaking() int32 {
+	if x != nil {
+		return x.DosePerTaking
+	}
+	return 0
+}
+
+func (x *InventoryResponse) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *InventoryResponse) GetDaysLeft() float64 {
+	if x != nil {
+		return x.DaysLeft
+	}
+	return 0
+}
+
+func (x *InventoryResponse) GetRefilledAt() string {
+	if x != nil {
+		return x.RefilledAt
+	}
+	return ""
+}
+
+type SnoozeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId    int64                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	PlannedAt     string                 `protobuf:"bytes,3,opt,name=planned_at,json=plannedAt,proto3" json:"planned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnoozeRequest) Reset() {
+	*x = SnoozeRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnoozeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnoozeRequest) ProtoMessage() {}
+
+func (x *SnoozeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnoozeRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SnoozeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SnoozeRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *SnoozeRequest) GetPlannedAt() string {
+	if x != nil {
+		return x.PlannedAt
+	}
+	return ""
+}
+
+type TakingStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MedicineName  string                 `protobuf:"bytes,2,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	PlannedAt     string                 `protobuf:"bytes,3,opt,name=planned_at,json=plannedAt,proto3" json:"planned_at,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SnoozeCount   int32                  `protobuf:"varint,5,opt,name=snooze_count,json=snoozeCount,proto3" json:"snooze_count,omitempty"`
+	SnoozedUntil  string                 `protobuf:"bytes,6,opt,name=snoozed_until,json=snoozedUntil,proto3" json:"snoozed_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakingStateResponse) Reset() {
+	*x = TakingStateResponse{}
+	mi := &file_api_proto_pills_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakingStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakingStateResponse) ProtoMessage() {}
+
+func (x *TakingStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakingStateResponse.ProtoReflect.Descriptor instead.
+func (*TakingStateResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TakingStateResponse) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *TakingStateResponse) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *TakingStateResponse) GetPlannedAt() string {
+	if x != nil {
+		return x.PlannedAt
+	}
+	return ""
+}
+
+func (x *TakingStateResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TakingStateResponse) GetSnoozeCount() int32 {
+	if x != nil {
+		return x.SnoozeCount
+	}
+	return 0
+}
+
+func (x *TakingStateResponse) GetSnoozedUntil() string {
+	if x != nil {
+		return x.SnoozedUntil
+	}
+	return ""
+}
+
+type IntakeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId    int64                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	PlannedAt     string                 `protobuf:"bytes,3,opt,name=planned_at,json=plannedAt,proto3" json:"planned_at,omitempty"`
+	TakenAt       string                 `protobuf:"bytes,4,opt,name=taken_at,json=takenAt,proto3" json:"taken_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntakeRequest) Reset() {
+	*x = IntakeRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntakeRequest) ProtoMessage() {}
+
+func (x *IntakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntakeRequest.ProtoReflect.Descriptor instead.
+func (*IntakeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IntakeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *IntakeRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *IntakeRequest) GetPlannedAt() string {
+	if x != nil {
+		return x.PlannedAt
+	}
+	return ""
+}
+
+func (x *IntakeRequest) GetTakenAt() string {
+	if x != nil {
+		return x.TakenAt
+	}
+	return ""
+}
+
+type IntakeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleId    int64                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MedicineName  string                 `protobuf:"bytes,3,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	PlannedAt     string                 `protobuf:"bytes,4,opt,name=planned_at,json=plannedAt,proto3" json:"planned_at,omitempty"`
+	TakenAt       string                 `protobuf:"bytes,5,opt,name=taken_at,json=takenAt,proto3" json:"taken_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntakeResponse) Reset() {
+	*x = IntakeResponse{}
+	mi := &file_api_proto_pills_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntakeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntakeResponse) ProtoMessage() {}
+
+func (x *IntakeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntakeResponse.ProtoReflect.Descriptor instead.
+func (*IntakeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IntakeResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IntakeResponse) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *IntakeResponse) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *IntakeResponse) GetPlannedAt() string {
+	if x != nil {
+		return x.PlannedAt
+	}
+	return ""
+}
+
+func (x *IntakeResponse) GetTakenAt() string {
+	if x != nil {
+		return x.TakenAt
+	}
+	return ""
+}
+
+type CaregiverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CaregiverId   int64                  `protobuf:"varint,2,opt,name=caregiver_id,json=caregiverId,proto3" json:"caregiver_id,omitempty"`
+	CanView       *bool                  `protobuf:"varint,3,opt,name=can_view,json=canView,proto3,oneof" json:"can_view,omitempty"`
+	CanEdit       *bool                  `protobuf:"varint,4,opt,name=can_edit,json=canEdit,proto3,oneof" json:"can_edit,omitempty"`
+	ReceiveAlerts *bool                  `protobuf:"varint,5,opt,name=receive_alerts,json=receiveAlerts,proto3,oneof" json:"receive_alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaregiverRequest) Reset() {
+	*x = CaregiverRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaregiverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaregiverRequest) ProtoMessage() {}
+
+func (x *CaregiverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaregiverRequest.ProtoReflect.Descriptor instead.
+func (*CaregiverRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CaregiverRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CaregiverRequest) GetCaregiverId() int64 {
+	if x != nil {
+		return x.CaregiverId
+	}
+	return 0
+}
+
+func (x *CaregiverRequest) GetCanView() bool {
+	if x != nil && x.CanView != nil {
+		return *x.CanView
+	}
+	return false
+}
+
+func (x *CaregiverRequest) GetCanEdit() bool {
+	if x != nil && x.CanEdit != nil {
+		return *x.CanEdit
+	}
+	return false
+}
+
+func (x *CaregiverRequest) GetReceiveAlerts() bool {
+	if x != nil && x.ReceiveAlerts != nil {
+		return *x.ReceiveAlerts
+	}
+	return false
+}
+
+type CaregiverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaregiverId   int64                  `protobuf:"varint,1,opt,name=caregiver_id,json=caregiverId,proto3" json:"caregiver_id,omitempty"`
+	LinkedAt      string                 `protobuf:"bytes,2,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CanView       bool                   `protobuf:"varint,4,opt,name=can_view,json=canView,proto3" json:"can_view,omitempty"`
+	CanEdit       bool                   `protobuf:"varint,5,opt,name=can_edit,json=canEdit,proto3" json:"can_edit,omitempty"`
+	ReceiveAlerts bool                   `protobuf:"varint,6,opt,name=receive_alerts,json=receiveAlerts,proto3" json:"receive_alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaregiverResponse) Reset() {
+	*x = CaregiverResponse{}
+	mi := &file_api_proto_pills_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaregiverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaregiverResponse) ProtoMessage() {}
+
+func (x *CaregiverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaregiverResponse.ProtoReflect.Descriptor instead.
+func (*CaregiverResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CaregiverResponse) GetCaregiverId() int64 {
+	if x != nil {
+		return x.CaregiverId
+	}
+	return 0
+}
+
+func (x *CaregiverResponse) GetLinkedAt() string {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return ""
+}
+
+func (x *CaregiverResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CaregiverResponse) GetCanView() bool {
+	if x != nil {
+		return x.CanView
+	}
+	return false
+}
+
+func (x *CaregiverResponse) GetCanEdit() bool {
+	if x != nil {
+		return x.CanEdit
+	}
+	return false
+}
+
+func (x *CaregiverResponse) GetReceiveAlerts() bool {
+	if x != nil {
+		return x.ReceiveAlerts
+	}
+	return false
+}
+
+type CaregiverList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Caregivers    []*CaregiverResponse   `protobuf:"bytes,1,rep,name=caregivers,proto3" json:"caregivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaregiverList) Reset() {
+	*x = CaregiverList{}
+	mi := &file_api_proto_pills_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaregiverList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaregiverList) ProtoMessage() {}
+
+func (x *CaregiverList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaregiverList.ProtoReflect.Descriptor instead.
+func (*CaregiverList) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CaregiverList) GetCaregivers() []*CaregiverResponse {
+	if x != nil {
+		return x.Caregivers
+	}
+	return nil
+}
+
+type EscalationRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId            int64                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	GraceMinutes          int32                  `protobuf:"varint,3,opt,name=grace_minutes,json=graceMinutes,proto3" json:"grace_minutes,omitempty"`
+	CaregiverDelayMinutes int32                  `protobuf:"varint,4,opt,name=caregiver_delay_minutes,json=caregiverDelayMinutes,proto3" json:"caregiver_delay_minutes,omitempty"`
+	NotifyCaregivers      bool                   `protobuf:"varint,5,opt,name=notify_caregivers,json=notifyCaregivers,proto3" json:"notify_caregivers,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *EscalationRequest) Reset() {
+	*x = EscalationRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationRequest) ProtoMessage() {}
+
+func (x *EscalationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationRequest.ProtoReflect.Descriptor instead.
+func (*EscalationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EscalationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EscalationRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *EscalationRequest) GetGraceMinutes() int32 {
+	if x != nil {
+		return x.GraceMinutes
+	}
+	return 0
+}
+
+func (x *EscalationRequest) GetCaregiverDelayMinutes() int32 {
+	if x != nil {
+		return x.CaregiverDelayMinutes
+	}
+	return 0
+}
+
+func (x *EscalationRequest) GetNotifyCaregivers() bool {
+	if x != nil {
+		return x.NotifyCaregivers
+	}
+	return false
+}
+
+type EscalationResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId            int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	GraceMinutes          int32                  `protobuf:"varint,2,opt,name=grace_minutes,json=graceMinutes,proto3" json:"grace_minutes,omitempty"`
+	CaregiverDelayMinutes int32                  `protobuf:"varint,3,opt,name=caregiver_delay_minutes,json=caregiverDelayMinutes,proto3" json:"caregiver_delay_minutes,omitempty"`
+	NotifyCaregivers      bool                   `protobuf:"varint,4,opt,name=notify_caregivers,json=notifyCaregivers,proto3" json:"notify_caregivers,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *EscalationResponse) Reset() {
+	*x = EscalationResponse{}
+	mi := &file_api_proto_pills_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationResponse) ProtoMessage() {}
+
+func (x *EscalationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationResponse.ProtoReflect.Descriptor instead.
+func (*EscalationResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EscalationResponse) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *EscalationResponse) GetGraceMinutes() int32 {
+	if x != nil {
+		return x.GraceMinutes
+	}
+	return 0
+}
+
+func (x *EscalationResponse) GetCaregiverDelayMinutes() int32 {
+	if x != nil {
+		return x.CaregiverDelayMinutes
+	}
+	return 0
+}
+
+func (x *EscalationResponse) GetNotifyCaregivers() bool {
+	if x != nil {
+		return x.NotifyCaregivers
+	}
+	return false
+}
+
+type APIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKeyRequest) Reset() {
+	*x = APIKeyRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyRequest) ProtoMessage() {}
+
+func (x *APIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyRequest.ProtoReflect.Descriptor instead.
+func (*APIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *APIKeyRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *APIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	KeyId         int64                  `protobuf:"varint,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeAPIKeyRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyId() int64 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+type APIKeyResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix    string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes    []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt string                 `protobuf:"bytes,7,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	// key is only set in the response to CreateAPIKey.
+	Key           string `protobuf:"bytes,8,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKeyResponse) Reset() {
+	*x = APIKeyResponse{}
+	mi := &file_api_proto_pills_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyResponse) ProtoMessage() {}
+
+func (x *APIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyResponse.ProtoReflect.Descriptor instead.
+func (*APIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *APIKeyResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *APIKeyResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *APIKeyResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKeyResponse) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *APIKeyResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKeyResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *APIKeyResponse) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+func (x *APIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type APIKeyList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*APIKeyResponse      `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKeyList) Reset() {
+	*x = APIKeyList{}
+	mi := &file_api_proto_pills_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKeyList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyList) ProtoMessage() {}
+
+func (x *APIKeyList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyList.ProtoReflect.Descriptor instead.
+func (*APIKeyList) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *APIKeyList) GetKeys() []*APIKeyResponse {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleRequest) Reset() {
+	*x = RoleRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleRequest) ProtoMessage() {}
+
+func (x *RoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleRequest.ProtoReflect.Descriptor instead.
+func (*RoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleResponse) Reset() {
+	*x = RoleResponse{}
+	mi := &file_api_proto_pills_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleResponse) ProtoMessage() {}
+
+func (x *RoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleResponse.ProtoReflect.Descriptor instead.
+func (*RoleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RoleResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RoleResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ScheduleHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleHistoryRequest) Reset() {
+	*x = ScheduleHistoryRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleHistoryRequest) ProtoMessage() {}
+
+func (x *ScheduleHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ScheduleHistoryRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+type AuditEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleId int64                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	UserId     int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// actor_id is 0 when the actor is unknown.
+	ActorId int64  `protobuf:"varint,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action  string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	TraceId string `protobuf:"bytes,6,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// before and after are JSON snapshots, before is empty for creations.
+	Before        string `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After         string `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	CreatedAt     string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_api_proto_pills_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AuditEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ScheduleHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleHistory) Reset() {
+	*x = ScheduleHistory{}
+	mi := &file_api_proto_pills_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleHistory) ProtoMessage() {}
+
+func (x *ScheduleHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleHistory.ProtoReflect.Descriptor instead.
+func (*ScheduleHistory) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScheduleHistory) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// ListSchedulesRequest leaves empty fields for the defaults: all schedules
+// sorted by id in ascending order, 20 per page.
+type ListSchedulesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// status is one of all, active, paused, finished.
+	Status         string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	MedicinePrefix string `protobuf:"bytes,3,opt,name=medicine_prefix,json=medicinePrefix,proto3" json:"medicine_prefix,omitempty"`
+	// from and to are dates in the YYYY-MM-DD format.
+	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// sort is one of id, medicine_name, start_date.
+	Sort          string `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	Descending    bool   `protobuf:"varint,7,opt,name=descending,proto3" json:"descending,omitempty"`
+	Limit         int32  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListSchedulesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetMedicinePrefix() string {
+	if x != nil {
+		return x.MedicinePrefix
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListSchedulesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ScheduleList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*ScheduleResponse    `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleList) Reset() {
+	*x = ScheduleList{}
+	mi := &file_api_proto_pills_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleList) ProtoMessage() {}
+
+func (x *ScheduleList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleList.ProtoReflect.Descriptor instead.
+func (*ScheduleList) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ScheduleList) GetSchedules() []*ScheduleResponse {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *ScheduleList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// CalendarRequest covers the days from and to, both in the YYYY-MM-DD format
+// and at most 92 days apart.
+type CalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarRequest) Reset() {
+	*x = CalendarRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarRequest) ProtoMessage() {}
+
+func (x *CalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarRequest.ProtoReflect.Descriptor instead.
+func (*CalendarRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CalendarRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CalendarRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CalendarRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type CalendarTaking struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId   int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MedicineName string                 `protobuf:"bytes,2,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	PlannedAt    string                 `protobuf:"bytes,3,opt,name=planned_at,json=plannedAt,proto3" json:"planned_at,omitempty"`
+	// status is one of taken, missed, snoozed, reminded, upcoming, unconfirmed.
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// taken_at is empty unless the taking was taken.
+	TakenAt       string `protobuf:"bytes,5,opt,name=taken_at,json=takenAt,proto3" json:"taken_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarTaking) Reset() {
+	*x = CalendarTaking{}
+	mi := &file_api_proto_pills_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarTaking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarTaking) ProtoMessage() {}
+
+func (x *CalendarTaking) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarTaking.ProtoReflect.Descriptor instead.
+func (*CalendarTaking) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CalendarTaking) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *CalendarTaking) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *CalendarTaking) GetPlannedAt() string {
+	if x != nil {
+		return x.PlannedAt
+	}
+	return ""
+}
+
+func (x *CalendarTaking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CalendarTaking) GetTakenAt() string {
+	if x != nil {
+		return x.TakenAt
+	}
+	return ""
+}
+
+type Calendar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Takings       []*CalendarTaking      `protobuf:"bytes,1,rep,name=takings,proto3" json:"takings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	mi := &file_api_proto_pills_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Calendar) GetTakings() []*CalendarTaking {
+	if x != nil {
+		return x.Takings
+	}
+	return nil
+}
+
+// ICSCalendar holds an RFC 5545 calendar.
+type ICSCalendar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ICSCalendar) Reset() {
+	*x = ICSCalendar{}
+	mi := &file_api_proto_pills_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ICSCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ICSCalendar) ProtoMessage() {}
+
+func (x *ICSCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ICSCalendar.ProtoReflect.Descriptor instead.
+func (*ICSCalendar) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ICSCalendar) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type CalendarFeed struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// path is served by the HTTP API without credentials, it is only set when
+	// the feed is created.
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	CreatedAt     string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	mi := &file_api_proto_pills_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeed) ProtoMessage() {}
+
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CalendarFeed) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CalendarFeed) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ImportICSRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// data is an RFC 5545 calendar.
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// dry_run only reports what would be created.
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportICSRequest) Reset() {
+	*x = ImportICSRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportICSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportICSRequest) ProtoMessage() {}
+
+func (x *ImportICSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportICSRequest.ProtoReflect.Descriptor instead.
+func (*ImportICSRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ImportICSRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportICSRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ImportICSRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SkippedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedEvent) Reset() {
+	*x = SkippedEvent{}
+	mi := &file_api_proto_pills_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedEvent) ProtoMessage() {}
+
+func (x *SkippedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedEvent.ProtoReflect.Descriptor instead.
+func (*SkippedEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SkippedEvent) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SkippedEvent) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *SkippedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ICSImportReport struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DryRun bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// schedules have no ids on a dry run.
+	Schedules     []*ScheduleResponse `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	Skipped       []*SkippedEvent     `protobuf:"bytes,3,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ICSImportReport) Reset() {
+	*x = ICSImportReport{}
+	mi := &file_api_proto_pills_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ICSImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ICSImportReport) ProtoMessage() {}
+
+func (x *ICSImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ICSImportReport.ProtoReflect.Descriptor instead.
+func (*ICSImportReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ICSImportReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ICSImportReport) GetSchedules() []*ScheduleResponse {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *ICSImportReport) GetSkipped() []*SkippedEvent {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type ExportRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// format is json or csv, json by default.
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type UserDataExport struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// data is a JSON document or a ZIP of CSV files.
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExport) Reset() {
+	*x = UserDataExport{}
+	mi := &file_api_proto_pills_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport) ProtoMessage() {}
+
+func (x *UserDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport.ProtoReflect.Descriptor instead.
+func (*UserDataExport) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UserDataExport) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *UserDataExport) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// format is json or csv, json by default.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// policy is skip, merge or replace, skip by default.
+	Policy        string `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	Data          []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequest) ProtoMessage() {}
+
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *ImportRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportedSchedule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MedicineName   string                 `protobuf:"bytes,1,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	Action         string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ScheduleId     int64                  `protobuf:"varint,3,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Intakes        int32                  `protobuf:"varint,4,opt,name=intakes,proto3" json:"intakes,omitempty"`
+	SkippedIntakes int32                  `protobuf:"varint,5,opt,name=skipped_intakes,json=skippedIntakes,proto3" json:"skipped_intakes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportedSchedule) Reset() {
+	*x = ImportedSchedule{}
+	mi := &file_api_proto_pills_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedSchedule) ProtoMessage() {}
+
+func (x *ImportedSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedSchedule.ProtoReflect.Descriptor instead.
+func (*ImportedSchedule) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportedSchedule) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *ImportedSchedule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ImportedSchedule) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *ImportedSchedule) GetIntakes() int32 {
+	if x != nil {
+		return x.Intakes
+	}
+	return 0
+}
+
+func (x *ImportedSchedule) GetSkippedIntakes() int32 {
+	if x != nil {
+		return x.SkippedIntakes
+	}
+	return 0
+}
+
+type ImportReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*ImportedSchedule    `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportReport) Reset() {
+	*x = ImportReport{}
+	mi := &file_api_proto_pills_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReport) ProtoMessage() {}
+
+func (x *ImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReport.ProtoReflect.Descriptor instead.
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ImportReport) GetSchedules() []*ImportedSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type FHIRResource struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// data is a FHIR R4 resource in JSON.
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FHIRResource) Reset() {
+	*x = FHIRResource{}
+	mi := &file_api_proto_pills_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FHIRResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FHIRResource) ProtoMessage() {}
+
+func (x *FHIRResource) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FHIRResource.ProtoReflect.Descriptor instead.
+func (*FHIRResource) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FHIRResource) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FHIRResource) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// mode is all_or_nothing (the default) or best_effort.
+type BatchScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*ScheduleRequest     `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchScheduleRequest) Reset() {
+	*x = BatchScheduleRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchScheduleRequest) ProtoMessage() {}
+
+func (x *BatchScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchScheduleRequest.ProtoReflect.Descriptor instead.
+func (*BatchScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BatchScheduleRequest) GetSchedules() []*ScheduleRequest {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *BatchScheduleRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+// BatchScheduleResult has the id of the created schedule and the
+// interactions of its medicine, or the error code why it was not created.
+type BatchScheduleResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Warnings      []*InteractionWarning  `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchScheduleResult) Reset() {
+	*x = BatchScheduleResult{}
+	mi := &file_api_proto_pills_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchScheduleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchScheduleResult) ProtoMessage() {}
+
+func (x *BatchScheduleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchScheduleResult.ProtoReflect.Descriptor instead.
+func (*BatchScheduleResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BatchScheduleResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchScheduleResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchScheduleResult) GetWarnings() []*InteractionWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type BatchScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Results       []*BatchScheduleResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchScheduleResponse) Reset() {
+	*x = BatchScheduleResponse{}
+	mi := &file_api_proto_pills_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchScheduleResponse) ProtoMessage() {}
+
+func (x *BatchScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchScheduleResponse.ProtoReflect.Descriptor instead.
+func (*BatchScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchScheduleResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BatchScheduleResponse) GetResults() []*BatchScheduleResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// locale is en or ru.
+type ProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileRequest) Reset() {
+	*x = ProfileRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileRequest) ProtoMessage() {}
+
+func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileRequest.ProtoReflect.Descriptor instead.
+func (*ProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ProfileRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ProfileRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileResponse) Reset() {
+	*x = ProfileResponse{}
+	mi := &file_api_proto_pills_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileResponse) ProtoMessage() {}
+
+func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileResponse.ProtoReflect.Descriptor instead.
+func (*ProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ProfileResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ProfileResponse) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// MedicineSearchRequest autocompletes the query with the catalog, at most
+// limit medicines are returned, 10 by default.
+type MedicineSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicineSearchRequest) Reset() {
+	*x = MedicineSearchRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicineSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicineSearchRequest) ProtoMessage() {}
+
+func (x *MedicineSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicineSearchRequest.ProtoReflect.Descriptor instead.
+func (*MedicineSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MedicineSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *MedicineSearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Medicine struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ingredient string                 `protobuf:"bytes,3,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	Synonyms   []string               `protobuf:"bytes,4,rep,name=synonyms,proto3" json:"synonyms,omitempty"`
+	// max_daily_dose of the ingredient in mg, 0 when there is no limit.
+	MaxDailyDose  float64 `protobuf:"fixed64,5,opt,name=max_daily_dose,json=maxDailyDose,proto3" json:"max_daily_dose,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Medicine) Reset() {
+	*x = Medicine{}
+	mi := &file_api_proto_pills_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Medicine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Medicine) ProtoMessage() {}
+
+func (x *Medicine) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Medicine.ProtoReflect.Descriptor instead.
+func (*Medicine) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Medicine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Medicine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Medicine) GetIngredient() string {
+	if x != nil {
+		return x.Ingredient
+	}
+	return ""
+}
+
+func (x *Medicine) GetSynonyms() []string {
+	if x != nil {
+		return x.Synonyms
+	}
+	return nil
+}
+
+func (x *Medicine) GetMaxDailyDose() float64 {
+	if x != nil {
+		return x.MaxDailyDose
+	}
+	return 0
+}
+
+type MedicineList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Medicines     []*Medicine            `protobuf:"bytes,1,rep,name=medicines,proto3" json:"medicines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TakingList) Reset() {
-	*x = TakingList{}
-	mi := &file_api_proto_pills_proto_msgTypes[7]
+func (x *MedicineList) Reset() {
+	*x = MedicineList{}
+	mi := &file_api_proto_pills_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TakingList) String() string {
+func (x *MedicineList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TakingList) ProtoMessage() {}
+func (*MedicineList) ProtoMessage() {}
 
-func (x *TakingList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_pills_proto_msgTypes[7]
+func (x *MedicineList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,14 +3649,241 @@ func (x *TakingList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TakingList.ProtoReflect.Descriptor instead.
-func (*TakingList) Descriptor() ([]byte, []int) {
-	return file_api_proto_pills_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MedicineList.ProtoReflect.Descriptor instead.
+func (*MedicineList) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *TakingList) GetTakings() []*Taking {
+func (x *MedicineList) GetMedicines() []*Medicine {
 	if x != nil {
-		return x.Takings
+		return x.Medicines
+	}
+	return nil
+}
+
+// DoseLimitRequest sets the maximum daily dose in mg of the ingredient of the
+// medicine, found by medicine_id or else by medicine_name. The dose limit of
+// the user replaces the one of the catalog.
+type DoseLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MedicineName  string                 `protobuf:"bytes,2,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	MedicineId    string                 `protobuf:"bytes,3,opt,name=medicine_id,json=medicineId,proto3" json:"medicine_id,omitempty"`
+	MaxDailyDose  float64                `protobuf:"fixed64,4,opt,name=max_daily_dose,json=maxDailyDose,proto3" json:"max_daily_dose,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoseLimitRequest) Reset() {
+	*x = DoseLimitRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoseLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoseLimitRequest) ProtoMessage() {}
+
+func (x *DoseLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoseLimitRequest.ProtoReflect.Descriptor instead.
+func (*DoseLimitRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DoseLimitRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DoseLimitRequest) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *DoseLimitRequest) GetMedicineId() string {
+	if x != nil {
+		return x.MedicineId
+	}
+	return ""
+}
+
+func (x *DoseLimitRequest) GetMaxDailyDose() float64 {
+	if x != nil {
+		return x.MaxDailyDose
+	}
+	return 0
+}
+
+type DeleteDoseLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ingredient    string                 `protobuf:"bytes,2,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDoseLimitRequest) Reset() {
+	*x = DeleteDoseLimitRequest{}
+	mi := &file_api_proto_pills_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDoseLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDoseLimitRequest) ProtoMessage() {}
+
+func (x *DeleteDoseLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDoseLimitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDoseLimitRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteDoseLimitRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteDoseLimitRequest) GetIngredient() string {
+	if x != nil {
+		return x.Ingredient
+	}
+	return ""
+}
+
+type DoseLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ingredient    string                 `protobuf:"bytes,2,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	MaxDailyDose  float64                `protobuf:"fixed64,3,opt,name=max_daily_dose,json=maxDailyDose,proto3" json:"max_daily_dose,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoseLimit) Reset() {
+	*x = DoseLimit{}
+	mi := &file_api_proto_pills_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoseLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoseLimit) ProtoMessage() {}
+
+func (x *DoseLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoseLimit.ProtoReflect.Descriptor instead.
+func (*DoseLimit) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DoseLimit) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DoseLimit) GetIngredient() string {
+	if x != nil {
+		return x.Ingredient
+	}
+	return ""
+}
+
+func (x *DoseLimit) GetMaxDailyDose() float64 {
+	if x != nil {
+		return x.MaxDailyDose
+	}
+	return 0
+}
+
+type DoseLimitList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DoseLimits    []*DoseLimit           `protobuf:"bytes,1,rep,name=dose_limits,json=doseLimits,proto3" json:"dose_limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoseLimitList) Reset() {
+	*x = DoseLimitList{}
+	mi := &file_api_proto_pills_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoseLimitList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoseLimitList) ProtoMessage() {}
+
+func (x *DoseLimitList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pills_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoseLimitList.ProtoReflect.Descriptor instead.
+func (*DoseLimitList) Descriptor() ([]byte, []int) {
+	return file_api_proto_pills_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DoseLimitList) GetDoseLimits() []*DoseLimit {
+	if x != nil {
+		return x.DoseLimits
 	}
 	return nil
 }
@@ -457,21 +3892,46 @@ var File_api_proto_pills_proto protoreflect.FileDescriptor
 
 const file_api_proto_pills_proto_rawDesc = "" +
 	"\n" +
-	"\x15api/proto/pills.proto\x12\x03ptr\"\x89\x01\n" +
+	"\x15api/proto/pills.proto\x12\x03ptr\"\xf3\x01\n" +
 	"\x0fScheduleRequest\x12#\n" +
 	"\rmedicine_name\x18\x01 \x01(\tR\fmedicineName\x12\x1c\n" +
 	"\tfrequency\x18\x02 \x01(\x05R\tfrequency\x12\x1a\n" +
 	"\bduration\x18\x03 \x01(\x05R\bduration\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\x03R\x06userId\"5\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vmedicine_id\x18\x05 \x01(\tR\n" +
+	"medicineId\x123\n" +
+	"\x15override_interactions\x18\x06 \x01(\bR\x14overrideInteractions\x12\x12\n" +
+	"\x04dose\x18\a \x01(\x01R\x04dose\"\xb4\x02\n" +
+	"\x15UpdateScheduleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\x12#\n" +
+	"\rmedicine_name\x18\x03 \x01(\tR\fmedicineName\x12\x1c\n" +
+	"\tfrequency\x18\x04 \x01(\x05R\tfrequency\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\x05R\bduration\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12\x1f\n" +
+	"\vmedicine_id\x18\a \x01(\tR\n" +
+	"medicineId\x12\x12\n" +
+	"\x04dose\x18\b \x01(\x01R\x04dose\x123\n" +
+	"\x15override_interactions\x18\t \x01(\bR\x14overrideInteractions\"j\n" +
 	"\x12ScheduleIDResponse\x12\x1f\n" +
 	"\vschedule_id\x18\x01 \x01(\x03R\n" +
-	"scheduleId\"M\n" +
+	"scheduleId\x123\n" +
+	"\bwarnings\x18\x02 \x03(\v2\x17.ptr.InteractionWarningR\bwarnings\"\xb9\x01\n" +
+	"\x12InteractionWarning\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x03R\n" +
+	"scheduleId\x12\x1f\n" +
+	"\vmedicine_id\x18\x02 \x01(\tR\n" +
+	"medicineId\x12#\n" +
+	"\rmedicine_name\x18\x03 \x01(\tR\fmedicineName\x12\x1a\n" +
+	"\bseverity\x18\x04 \x01(\tR\bseverity\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"M\n" +
 	"\x11ScheduleIDRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
 	"\vschedule_id\x18\x02 \x01(\x03R\n" +
 	"scheduleId\"(\n" +
 	"\rUserIDRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xbb\x01\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xd7\x02\n" +
 	"\x10ScheduleResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
 	"\rmedicine_name\x18\x02 \x01(\tR\fmedicineName\x12\x1d\n" +
@@ -480,22 +3940,326 @@ const file_api_proto_pills_proto_rawDesc = "" +
 	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x17\n" +
 	"\auser_id\x18\x05 \x01(\x03R\x06userId\x12\x1f\n" +
 	"\vtaking_time\x18\x06 \x03(\tR\n" +
-	"takingTime\"3\n" +
+	"takingTime\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\x12\x1f\n" +
+	"\vmedicine_id\x18\b \x01(\tR\n" +
+	"medicineId\x12\x12\n" +
+	"\x04dose\x18\t \x01(\x01R\x04dose\x123\n" +
+	"\bwarnings\x18\n" +
+	" \x03(\v2\x17.ptr.InteractionWarningR\bwarnings\x12\x16\n" +
+	"\x06paused\x18\v \x01(\bR\x06paused\"\x9d\x01\n" +
+	"\x14SchedulePauseRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\x12\x16\n" +
+	"\x06paused\x18\x03 \x01(\bR\x06paused\x123\n" +
+	"\x15override_interactions\x18\x04 \x01(\bR\x14overrideInteractions\"3\n" +
 	"\x0eScheduleIDList\x12!\n" +
-	"\fschedule_ids\x18\x01 \x03(\x03R\vscheduleIds\"N\n" +
+	"\fschedule_ids\x18\x01 \x03(\x03R\vscheduleIds\"o\n" +
 	"\x06Taking\x12#\n" +
 	"\rmedicine_name\x18\x01 \x01(\tR\fmedicineName\x12\x1f\n" +
 	"\vtaking_time\x18\x02 \x01(\tR\n" +
-	"takingTime\"3\n" +
+	"takingTime\x12\x1f\n" +
+	"\vtaking_date\x18\x03 \x01(\tR\n" +
+	"takingDate\"[\n" +
+	"\x12NextTakingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06within\x18\x02 \x01(\tR\x06within\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"3\n" +
 	"\n" +
 	"TakingList\x12%\n" +
-	"\atakings\x18\x01 \x03(\v2\v.ptr.TakingR\atakings2\x86\x02\n" +
+	"\atakings\x18\x01 \x03(\v2\v.ptr.TakingR\atakings\"\x8d\x01\n" +
+	"\rRefillRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12&\n" +
+	"\x0fdose_per_taking\x18\x04 \x01(\x05R\rdosePerTaking\"\xf9\x01\n" +
+	"\x11InventoryResponse\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x03R\n" +
+	"scheduleId\x12#\n" +
+	"\rmedicine_name\x18\x02 \x01(\tR\fmedicineName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12&\n" +
+	"\x0fdose_per_taking\x18\x04 \x01(\x05R\rdosePerTaking\x12\x1c\n" +
+	"\tremaining\x18\x05 \x01(\x05R\tremaining\x12\x1b\n" +
+	"\tdays_left\x18\x06 \x01(\x01R\bdaysLeft\x12\x1f\n" +
+	"\vrefilled_at\x18\a \x01(\tR\n" +
+	"refilledAt\"h\n" +
+	"\rSnoozeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\x12\x1d\n" +
+	"\n" +
+	"planned_at\x18\x03 \x01(\tR\tplannedAt\"\xda\x01\n" +
+	"\x13TakingStateResponse\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x03R\n" +
+	"scheduleId\x12#\n" +
+	"\rmedicine_name\x18\x02 \x01(\tR\fmedicineName\x12\x1d\n" +
+	"\n" +
+	"planned_at\x18\x03 \x01(\tR\tplannedAt\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12!\n" +
+	"\fsnooze_count\x18\x05 \x01(\x05R\vsnoozeCount\x12#\n" +
+	"\rsnoozed_until\x18\x06 \x01(\tR\fsnoozedUntil\"\x83\x01\n" +
+	"\rIntakeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\x12\x1d\n" +
+	"\n" +
+	"planned_at\x18\x03 \x01(\tR\tplannedAt\x12\x19\n" +
+	"\btaken_at\x18\x04 \x01(\tR\atakenAt\"\xa0\x01\n" +
+	"\x0eIntakeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\x12#\n" +
+	"\rmedicine_name\x18\x03 \x01(\tR\fmedicineName\x12\x1d\n" +
+	"\n" +
+	"planned_at\x18\x04 \x01(\tR\tplannedAt\x12\x19\n" +
+	"\btaken_at\x18\x05 \x01(\tR\atakenAt\"\xe7\x01\n" +
+	"\x10CaregiverRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fcaregiver_id\x18\x02 \x01(\x03R\vcaregiverId\x12\x1e\n" +
+	"\bcan_view\x18\x03 \x01(\bH\x00R\acanView\x88\x01\x01\x12\x1e\n" +
+	"\bcan_edit\x18\x04 \x01(\bH\x01R\acanEdit\x88\x01\x01\x12*\n" +
+	"\x0ereceive_alerts\x18\x05 \x01(\bH\x02R\rreceiveAlerts\x88\x01\x01B\v\n" +
+	"\t_can_viewB\v\n" +
+	"\t_can_editB\x11\n" +
+	"\x0f_receive_alerts\"\xc9\x01\n" +
+	"\x11CaregiverResponse\x12!\n" +
+	"\fcaregiver_id\x18\x01 \x01(\x03R\vcaregiverId\x12\x1b\n" +
+	"\tlinked_at\x18\x02 \x01(\tR\blinkedAt\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bcan_view\x18\x04 \x01(\bR\acanView\x12\x19\n" +
+	"\bcan_edit\x18\x05 \x01(\bR\acanEdit\x12%\n" +
+	"\x0ereceive_alerts\x18\x06 \x01(\bR\rreceiveAlerts\"G\n" +
+	"\rCaregiverList\x126\n" +
+	"\n" +
+	"caregivers\x18\x01 \x03(\v2\x16.ptr.CaregiverResponseR\n" +
+	"caregivers\"\xd7\x01\n" +
+	"\x11EscalationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\x12#\n" +
+	"\rgrace_minutes\x18\x03 \x01(\x05R\fgraceMinutes\x126\n" +
+	"\x17caregiver_delay_minutes\x18\x04 \x01(\x05R\x15caregiverDelayMinutes\x12+\n" +
+	"\x11notify_caregivers\x18\x05 \x01(\bR\x10notifyCaregivers\"\xbf\x01\n" +
+	"\x12EscalationResponse\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x03R\n" +
+	"scheduleId\x12#\n" +
+	"\rgrace_minutes\x18\x02 \x01(\x05R\fgraceMinutes\x126\n" +
+	"\x17caregiver_delay_minutes\x18\x03 \x01(\x05R\x15caregiverDelayMinutes\x12+\n" +
+	"\x11notify_caregivers\x18\x04 \x01(\bR\x10notifyCaregivers\"T\n" +
+	"\rAPIKeyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\"E\n" +
+	"\x13RevokeAPIKeyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06key_id\x18\x02 \x01(\x03R\x05keyId\"\xcd\x01\n" +
+	"\x0eAPIKeyResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x05 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\a \x01(\tR\trevokedAt\x12\x10\n" +
+	"\x03key\x18\b \x01(\tR\x03key\"5\n" +
+	"\n" +
+	"APIKeyList\x12'\n" +
+	"\x04keys\x18\x01 \x03(\v2\x13.ptr.APIKeyResponseR\x04keys\":\n" +
+	"\vRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\";\n" +
+	"\fRoleResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"9\n" +
+	"\x16ScheduleHistoryRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x03R\n" +
+	"scheduleId\"\xf1\x01\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\x03R\aactorId\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x19\n" +
+	"\btrace_id\x18\x06 \x01(\tR\atraceId\x12\x16\n" +
+	"\x06before\x18\a \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\b \x01(\tR\x05after\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"<\n" +
+	"\x0fScheduleHistory\x12)\n" +
+	"\aentries\x18\x01 \x03(\v2\x0f.ptr.AuditEntryR\aentries\"\xf6\x01\n" +
+	"\x14ListSchedulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0fmedicine_prefix\x18\x03 \x01(\tR\x0emedicinePrefix\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\tR\x04sort\x12\x1e\n" +
+	"\n" +
+	"descending\x18\a \x01(\bR\n" +
+	"descending\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursor\"d\n" +
+	"\fScheduleList\x123\n" +
+	"\tschedules\x18\x01 \x03(\v2\x15.ptr.ScheduleResponseR\tschedules\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"N\n" +
+	"\x0fCalendarRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\xa8\x01\n" +
+	"\x0eCalendarTaking\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x03R\n" +
+	"scheduleId\x12#\n" +
+	"\rmedicine_name\x18\x02 \x01(\tR\fmedicineName\x12\x1d\n" +
+	"\n" +
+	"planned_at\x18\x03 \x01(\tR\tplannedAt\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x19\n" +
+	"\btaken_at\x18\x05 \x01(\tR\atakenAt\"9\n" +
+	"\bCalendar\x12-\n" +
+	"\atakings\x18\x01 \x03(\v2\x13.ptr.CalendarTakingR\atakings\"!\n" +
+	"\vICSCalendar\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\"Z\n" +
+	"\fCalendarFeed\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"X\n" +
+	"\x10ImportICSRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"R\n" +
+	"\fSkippedEvent\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x18\n" +
+	"\asummary\x18\x02 \x01(\tR\asummary\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x8c\x01\n" +
+	"\x0fICSImportReport\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x123\n" +
+	"\tschedules\x18\x02 \x03(\v2\x15.ptr.ScheduleResponseR\tschedules\x12+\n" +
+	"\askipped\x18\x03 \x03(\v2\x11.ptr.SkippedEventR\askipped\"@\n" +
+	"\rExportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"<\n" +
+	"\x0eUserDataExport\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"l\n" +
+	"\rImportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\tR\x06policy\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"\xb3\x01\n" +
+	"\x10ImportedSchedule\x12#\n" +
+	"\rmedicine_name\x18\x01 \x01(\tR\fmedicineName\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1f\n" +
+	"\vschedule_id\x18\x03 \x01(\x03R\n" +
+	"scheduleId\x12\x18\n" +
+	"\aintakes\x18\x04 \x01(\x05R\aintakes\x12'\n" +
+	"\x0fskipped_intakes\x18\x05 \x01(\x05R\x0eskippedIntakes\"C\n" +
+	"\fImportReport\x123\n" +
+	"\tschedules\x18\x01 \x03(\v2\x15.ptr.ImportedScheduleR\tschedules\";\n" +
+	"\fFHIRResource\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"^\n" +
+	"\x14BatchScheduleRequest\x122\n" +
+	"\tschedules\x18\x01 \x03(\v2\x14.ptr.ScheduleRequestR\tschedules\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\"p\n" +
+	"\x13BatchScheduleResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x123\n" +
+	"\bwarnings\x18\x03 \x03(\v2\x17.ptr.InteractionWarningR\bwarnings\"e\n" +
+	"\x15BatchScheduleResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x122\n" +
+	"\aresults\x18\x02 \x03(\v2\x18.ptr.BatchScheduleResultR\aresults\"A\n" +
+	"\x0eProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"B\n" +
+	"\x0fProfileResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\"C\n" +
+	"\x15MedicineSearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x90\x01\n" +
+	"\bMedicine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"ingredient\x18\x03 \x01(\tR\n" +
+	"ingredient\x12\x1a\n" +
+	"\bsynonyms\x18\x04 \x03(\tR\bsynonyms\x12$\n" +
+	"\x0emax_daily_dose\x18\x05 \x01(\x01R\fmaxDailyDose\";\n" +
+	"\fMedicineList\x12+\n" +
+	"\tmedicines\x18\x01 \x03(\v2\r.ptr.MedicineR\tmedicines\"\x97\x01\n" +
+	"\x10DoseLimitRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rmedicine_name\x18\x02 \x01(\tR\fmedicineName\x12\x1f\n" +
+	"\vmedicine_id\x18\x03 \x01(\tR\n" +
+	"medicineId\x12$\n" +
+	"\x0emax_daily_dose\x18\x04 \x01(\x01R\fmaxDailyDose\"Q\n" +
+	"\x16DeleteDoseLimitRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1e\n" +
+	"\n" +
+	"ingredient\x18\x02 \x01(\tR\n" +
+	"ingredient\"j\n" +
+	"\tDoseLimit\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1e\n" +
+	"\n" +
+	"ingredient\x18\x02 \x01(\tR\n" +
+	"ingredient\x12$\n" +
+	"\x0emax_daily_dose\x18\x03 \x01(\x01R\fmaxDailyDose\"@\n" +
+	"\rDoseLimitList\x12/\n" +
+	"\vdose_limits\x18\x01 \x03(\v2\x0e.ptr.DoseLimitR\n" +
+	"doseLimits2\xcd\x14\n" +
 	"\n" +
 	"PTRService\x12A\n" +
 	"\x0eCreateSchedule\x12\x14.ptr.ScheduleRequest\x1a\x17.ptr.ScheduleIDResponse\"\x00\x12>\n" +
 	"\vGetSchedule\x12\x16.ptr.ScheduleIDRequest\x1a\x15.ptr.ScheduleResponse\"\x00\x12<\n" +
-	"\x0fGetSchedulesIDs\x12\x12.ptr.UserIDRequest\x1a\x13.ptr.ScheduleIDList\"\x00\x127\n" +
-	"\x0eGetNextTakings\x12\x12.ptr.UserIDRequest\x1a\x0f.ptr.TakingList\"\x00B(Z&pills-taking-reminder/internal/grpc/pbb\x06proto3"
+	"\x0fGetSchedulesIDs\x12\x12.ptr.UserIDRequest\x1a\x13.ptr.ScheduleIDList\"\x00\x12<\n" +
+	"\x0eGetNextTakings\x12\x17.ptr.NextTakingsRequest\x1a\x0f.ptr.TakingList\"\x00\x12E\n" +
+	"\x0eUpdateSchedule\x12\x1a.ptr.UpdateScheduleRequest\x1a\x15.ptr.ScheduleResponse\"\x00\x12<\n" +
+	"\fRecordRefill\x12\x12.ptr.RefillRequest\x1a\x16.ptr.InventoryResponse\"\x00\x12@\n" +
+	"\fGetInventory\x12\x16.ptr.ScheduleIDRequest\x1a\x16.ptr.InventoryResponse\"\x00\x12>\n" +
+	"\fSnoozeTaking\x12\x12.ptr.SnoozeRequest\x1a\x18.ptr.TakingStateResponse\"\x00\x129\n" +
+	"\fRecordIntake\x12\x12.ptr.IntakeRequest\x1a\x13.ptr.IntakeResponse\"\x00\x12@\n" +
+	"\rLinkCaregiver\x12\x15.ptr.CaregiverRequest\x1a\x16.ptr.CaregiverResponse\"\x00\x12B\n" +
+	"\x0fUnlinkCaregiver\x12\x15.ptr.CaregiverRequest\x1a\x16.ptr.CaregiverResponse\"\x00\x129\n" +
+	"\rGetCaregivers\x12\x12.ptr.UserIDRequest\x1a\x12.ptr.CaregiverList\"\x00\x12B\n" +
+	"\x0fUpdateCaregiver\x12\x15.ptr.CaregiverRequest\x1a\x16.ptr.CaregiverResponse\"\x00\x129\n" +
+	"\rGetDependants\x12\x12.ptr.UserIDRequest\x1a\x12.ptr.CaregiverList\"\x00\x12F\n" +
+	"\x11SetEscalationRule\x12\x16.ptr.EscalationRequest\x1a\x17.ptr.EscalationResponse\"\x00\x12F\n" +
+	"\x11GetEscalationRule\x12\x16.ptr.ScheduleIDRequest\x1a\x17.ptr.EscalationResponse\"\x00\x129\n" +
+	"\fCreateAPIKey\x12\x12.ptr.APIKeyRequest\x1a\x13.ptr.APIKeyResponse\"\x00\x123\n" +
+	"\n" +
+	"GetAPIKeys\x12\x12.ptr.UserIDRequest\x1a\x0f.ptr.APIKeyList\"\x00\x12?\n" +
+	"\fRevokeAPIKey\x12\x18.ptr.RevokeAPIKeyRequest\x1a\x13.ptr.APIKeyResponse\"\x00\x120\n" +
+	"\aSetRole\x12\x10.ptr.RoleRequest\x1a\x11.ptr.RoleResponse\"\x00\x122\n" +
+	"\aGetRole\x12\x12.ptr.UserIDRequest\x1a\x11.ptr.RoleResponse\"\x00\x12I\n" +
+	"\x12GetScheduleHistory\x12\x1b.ptr.ScheduleHistoryRequest\x1a\x14.ptr.ScheduleHistory\"\x00\x12?\n" +
+	"\rListSchedules\x12\x19.ptr.ListSchedulesRequest\x1a\x11.ptr.ScheduleList\"\x00\x124\n" +
+	"\vGetCalendar\x12\x14.ptr.CalendarRequest\x1a\r.ptr.Calendar\"\x00\x12<\n" +
+	"\x12ExportSchedulesICS\x12\x12.ptr.UserIDRequest\x1a\x10.ptr.ICSCalendar\"\x00\x12C\n" +
+	"\x12ImportSchedulesICS\x12\x15.ptr.ImportICSRequest\x1a\x14.ptr.ICSImportReport\"\x00\x12=\n" +
+	"\x12CreateCalendarFeed\x12\x12.ptr.UserIDRequest\x1a\x11.ptr.CalendarFeed\"\x00\x12=\n" +
+	"\x12RevokeCalendarFeed\x12\x12.ptr.UserIDRequest\x1a\x11.ptr.CalendarFeed\"\x00\x12;\n" +
+	"\x0eExportUserData\x12\x12.ptr.ExportRequest\x1a\x13.ptr.UserDataExport\"\x00\x129\n" +
+	"\x0eImportUserData\x12\x12.ptr.ImportRequest\x1a\x11.ptr.ImportReport\"\x00\x12E\n" +
+	"\x17ImportMedicationRequest\x12\x11.ptr.FHIRResource\x1a\x15.ptr.ScheduleResponse\"\x00\x12E\n" +
+	"\x1aExportMedicationStatements\x12\x12.ptr.UserIDRequest\x1a\x11.ptr.FHIRResource\"\x00\x12J\n" +
+	"\x1fExportMedicationAdministrations\x12\x12.ptr.UserIDRequest\x1a\x11.ptr.FHIRResource\"\x00\x12J\n" +
+	"\x0fCreateSchedules\x12\x19.ptr.BatchScheduleRequest\x1a\x1a.ptr.BatchScheduleResponse\"\x00\x129\n" +
+	"\n" +
+	"SetProfile\x12\x13.ptr.ProfileRequest\x1a\x14.ptr.ProfileResponse\"\x00\x128\n" +
+	"\n" +
+	"GetProfile\x12\x12.ptr.UserIDRequest\x1a\x14.ptr.ProfileResponse\"\x00\x12B\n" +
+	"\x0fSearchMedicines\x12\x1a.ptr.MedicineSearchRequest\x1a\x11.ptr.MedicineList\"\x00\x127\n" +
+	"\fSetDoseLimit\x12\x15.ptr.DoseLimitRequest\x1a\x0e.ptr.DoseLimit\"\x00\x129\n" +
+	"\rGetDoseLimits\x12\x12.ptr.UserIDRequest\x1a\x12.ptr.DoseLimitList\"\x00\x12@\n" +
+	"\x0fDeleteDoseLimit\x12\x1b.ptr.DeleteDoseLimitRequest\x1a\x0e.ptr.DoseLimit\"\x00\x12G\n" +
+	"\x11SetSchedulePaused\x12\x19.ptr.SchedulePauseRequest\x1a\x15.ptr.ScheduleResponse\"\x00B(Z&pills-taking-reminder/internal/grpc/pbb\x06proto3"
 
 var (
 	file_api_proto_pills_proto_rawDescOnce sync.Once
@@ -509,32 +4273,173 @@ func file_api_proto_pills_proto_rawDescGZIP() []byte {
 	return file_api_proto_pills_proto_rawDescData
 }
 
-var file_api_proto_pills_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_proto_pills_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_api_proto_pills_proto_goTypes = []any{
-	(*ScheduleRequest)(nil),    // 0: ptr.ScheduleRequest
-	(*ScheduleIDResponse)(nil), // 1: ptr.ScheduleIDResponse
-	(*ScheduleIDRequest)(nil),  // 2: ptr.ScheduleIDRequest
-	(*UserIDRequest)(nil),      // 3: ptr.UserIDRequest
-	(*ScheduleResponse)(nil),   // 4: ptr.ScheduleResponse
-	(*ScheduleIDList)(nil),     // 5: ptr.ScheduleIDList
-	(*Taking)(nil),             // 6: ptr.Taking
-	(*TakingList)(nil),         // 7: ptr.TakingList
+	(*ScheduleRequest)(nil),        // 0: ptr.ScheduleRequest
+	(*UpdateScheduleRequest)(nil),  // 1: ptr.UpdateScheduleRequest
+	(*ScheduleIDResponse)(nil),     // 2: ptr.ScheduleIDResponse
+	(*InteractionWarning)(nil),     // 3: ptr.InteractionWarning
+	(*ScheduleIDRequest)(nil),      // 4: ptr.ScheduleIDRequest
+	(*UserIDRequest)(nil),          // 5: ptr.UserIDRequest
+	(*ScheduleResponse)(nil),       // 6: ptr.ScheduleResponse
+	(*SchedulePauseRequest)(nil),   // 7: ptr.SchedulePauseRequest
+	(*ScheduleIDList)(nil),         // 8: ptr.ScheduleIDList
+	(*Taking)(nil),                 // 9: ptr.Taking
+	(*NextTakingsRequest)(nil),     // 10: ptr.NextTakingsRequest
+	(*TakingList)(nil),             // 11: ptr.TakingList
+	(*RefillRequest)(nil),          // 12: ptr.RefillRequest
+	(*InventoryResponse)(nil),      // 13: ptr.InventoryResponse
+	(*SnoozeRequest)(nil),          // 14: ptr.SnoozeRequest
+	(*TakingStateResponse)(nil),    // 15: ptr.TakingStateResponse
+	(*IntakeRequest)(nil),          // 16: ptr.IntakeRequest
+	(*IntakeResponse)(nil),         // 17: ptr.IntakeResponse
+	(*CaregiverRequest)(nil),       // 18: ptr.CaregiverRequest
+	(*CaregiverResponse)(nil),      // 19: ptr.CaregiverResponse
+	(*CaregiverList)(nil),          // 20: ptr.CaregiverList
+	(*EscalationRequest)(nil),      // 21: ptr.EscalationRequest
+	(*EscalationResponse)(nil),     // 22: ptr.EscalationResponse
+	(*APIKeyRequest)(nil),          // 23: ptr.APIKeyRequest
+	(*RevokeAPIKeyRequest)(nil),    // 24: ptr.RevokeAPIKeyRequest
+	(*APIKeyResponse)(nil),         // 25: ptr.APIKeyResponse
+	(*APIKeyList)(nil),             // 26: ptr.APIKeyList
+	(*RoleRequest)(nil),            // 27: ptr.RoleRequest
+	(*RoleResponse)(nil),           // 28: ptr.RoleResponse
+	(*ScheduleHistoryRequest)(nil), // 29: ptr.ScheduleHistoryRequest
+	(*AuditEntry)(nil),             // 30: ptr.AuditEntry
+	(*ScheduleHistory)(nil),        // 31: ptr.ScheduleHistory
+	(*ListSchedulesRequest)(nil),   // 32: ptr.ListSchedulesRequest
+	(*ScheduleList)(nil),           // 33: ptr.ScheduleList
+	(*CalendarRequest)(nil),        // 34: ptr.CalendarRequest
+	(*CalendarTaking)(nil),         // 35: ptr.CalendarTaking
+	(*Calendar)(nil),               // 36: ptr.Calendar
+	(*ICSCalendar)(nil),            // 37: ptr.ICSCalendar
+	(*CalendarFeed)(nil),           // 38: ptr.CalendarFeed
+	(*ImportICSRequest)(nil),       // 39: ptr.ImportICSRequest
+	(*SkippedEvent)(nil),           // 40: ptr.SkippedEvent
+	(*ICSImportReport)(nil),        // 41: ptr.ICSImportReport
+	(*ExportRequest)(nil),          // 42: ptr.ExportRequest
+	(*UserDataExport)(nil),         // 43: ptr.UserDataExport
+	(*ImportRequest)(nil),          // 44: ptr.ImportRequest
+	(*ImportedSchedule)(nil),       // 45: ptr.ImportedSchedule
+	(*ImportReport)(nil),           // 46: ptr.ImportReport
+	(*FHIRResource)(nil),           // 47: ptr.FHIRResource
+	(*BatchScheduleRequest)(nil),   // 48: ptr.BatchScheduleRequest
+	(*BatchScheduleResult)(nil),    // 49: ptr.BatchScheduleResult
+	(*BatchScheduleResponse)(nil),  // 50: ptr.BatchScheduleResponse
+	(*ProfileRequest)(nil),         // 51: ptr.ProfileRequest
+	(*ProfileResponse)(nil),        // 52: ptr.ProfileResponse
+	(*MedicineSearchRequest)(nil),  // 53: ptr.MedicineSearchRequest
+	(*Medicine)(nil),               // 54: ptr.Medicine
+	(*MedicineList)(nil),           // 55: ptr.MedicineList
+	(*DoseLimitRequest)(nil),       // 56: ptr.DoseLimitRequest
+	(*DeleteDoseLimitRequest)(nil), // 57: ptr.DeleteDoseLimitRequest
+	(*DoseLimit)(nil),              // 58: ptr.DoseLimit
+	(*DoseLimitList)(nil),          // 59: ptr.DoseLimitList
 }
 var file_api_proto_pills_proto_depIdxs = []int32{
-	6, // 0: ptr.TakingList.takings:type_name -> ptr.Taking
-	0, // 1: ptr.PTRService.CreateSchedule:input_type -> ptr.ScheduleRequest
-	2, // 2: ptr.PTRService.GetSchedule:input_type -> ptr.ScheduleIDRequest
-	3, // 3: ptr.PTRService.GetSchedulesIDs:input_type -> ptr.UserIDRequest
-	3, // 4: ptr.PTRService.GetNextTakings:input_type -> ptr.UserIDRequest
-	1, // 5: ptr.PTRService.CreateSchedule:output_type -> ptr.ScheduleIDResponse
-	4, // 6: ptr.PTRService.GetSchedule:output_type -> ptr.ScheduleResponse
-	5, // 7: ptr.PTRService.GetSchedulesIDs:output_type -> ptr.ScheduleIDList
-	7, // 8: ptr.PTRService.GetNextTakings:output_type -> ptr.TakingList
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3,  // 0: ptr.ScheduleIDResponse.warnings:type_name -> ptr.InteractionWarning
+	3,  // 1: ptr.ScheduleResponse.warnings:type_name -> ptr.InteractionWarning
+	9,  // 2: ptr.TakingList.takings:type_name -> ptr.Taking
+	19, // 3: ptr.CaregiverList.caregivers:type_name -> ptr.CaregiverResponse
+	25, // 4: ptr.APIKeyList.keys:type_name -> ptr.APIKeyResponse
+	30, // 5: ptr.ScheduleHistory.entries:type_name -> ptr.AuditEntry
+	6,  // 6: ptr.ScheduleList.schedules:type_name -> ptr.ScheduleResponse
+	35, // 7: ptr.Calendar.takings:type_name -> ptr.CalendarTaking
+	6,  // 8: ptr.ICSImportReport.schedules:type_name -> ptr.ScheduleResponse
+	40, // 9: ptr.ICSImportReport.skipped:type_name -> ptr.SkippedEvent
+	45, // 10: ptr.ImportReport.schedules:type_name -> ptr.ImportedSchedule
+	0,  // 11: ptr.BatchScheduleRequest.schedules:type_name -> ptr.ScheduleRequest
+	3,  // 12: ptr.BatchScheduleResult.warnings:type_name -> ptr.InteractionWarning
+	49, // 13: ptr.BatchScheduleResponse.results:type_name -> ptr.BatchScheduleResult
+	54, // 14: ptr.MedicineList.medicines:type_name -> ptr.Medicine
+	58, // 15: ptr.DoseLimitList.dose_limits:type_name -> ptr.DoseLimit
+	0,  // 16: ptr.PTRService.CreateSchedule:input_type -> ptr.ScheduleRequest
+	4,  // 17: ptr.PTRService.GetSchedule:input_type -> ptr.ScheduleIDRequest
+	5,  // 18: ptr.PTRService.GetSchedulesIDs:input_type -> ptr.UserIDRequest
+	10, // 19: ptr.PTRService.GetNextTakings:input_type -> ptr.NextTakingsRequest
+	1,  // 20: ptr.PTRService.UpdateSchedule:input_type -> ptr.UpdateScheduleRequest
+	12, // 21: ptr.PTRService.RecordRefill:input_type -> ptr.RefillRequest
+	4,  // 22: ptr.PTRService.GetInventory:input_type -> ptr.ScheduleIDRequest
+	14, // 23: ptr.PTRService.SnoozeTaking:input_type -> ptr.SnoozeRequest
+	16, // 24: ptr.PTRService.RecordIntake:input_type -> ptr.IntakeRequest
+	18, // 25: ptr.PTRService.LinkCaregiver:input_type -> ptr.CaregiverRequest
+	18, // 26: ptr.PTRService.UnlinkCaregiver:input_type -> ptr.CaregiverRequest
+	5,  // 27: ptr.PTRService.GetCaregivers:input_type -> ptr.UserIDRequest
+	18, // 28: ptr.PTRService.UpdateCaregiver:input_type -> ptr.CaregiverRequest
+	5,  // 29: ptr.PTRService.GetDependants:input_type -> ptr.UserIDRequest
+	21, // 30: ptr.PTRService.SetEscalationRule:input_type -> ptr.EscalationRequest
+	4,  // 31: ptr.PTRService.GetEscalationRule:input_type -> ptr.ScheduleIDRequest
+	23, // 32: ptr.PTRService.CreateAPIKey:input_type -> ptr.APIKeyRequest
+	5,  // 33: ptr.PTRService.GetAPIKeys:input_type -> ptr.UserIDRequest
+	24, // 34: ptr.PTRService.RevokeAPIKey:input_type -> ptr.RevokeAPIKeyRequest
+	27, // 35: ptr.PTRService.SetRole:input_type -> ptr.RoleRequest
+	5,  // 36: ptr.PTRService.GetRole:input_type -> ptr.UserIDRequest
+	29, // 37: ptr.PTRService.GetScheduleHistory:input_type -> ptr.ScheduleHistoryRequest
+	32, // 38: ptr.PTRService.ListSchedules:input_type -> ptr.ListSchedulesRequest
+	34, // 39: ptr.PTRService.GetCalendar:input_type -> ptr.CalendarRequest
+	5,  // 40: ptr.PTRService.ExportSchedulesICS:input_type -> ptr.UserIDRequest
+	39, // 41: ptr.PTRService.ImportSchedulesICS:input_type -> ptr.ImportICSRequest
+	5,  // 42: ptr.PTRService.CreateCalendarFeed:input_type -> ptr.UserIDRequest
+	5,  // 43: ptr.PTRService.RevokeCalendarFeed:input_type -> ptr.UserIDRequest
+	42, // 44: ptr.PTRService.ExportUserData:input_type -> ptr.ExportRequest
+	44, // 45: ptr.PTRService.ImportUserData:input_type -> ptr.ImportRequest
+	47, // 46: ptr.PTRService.ImportMedicationRequest:input_type -> ptr.FHIRResource
+	5,  // 47: ptr.PTRService.ExportMedicationStatements:input_type -> ptr.UserIDRequest
+	5,  // 48: ptr.PTRService.ExportMedicationAdministrations:input_type -> ptr.UserIDRequest
+	48, // 49: ptr.PTRService.CreateSchedules:input_type -> ptr.BatchScheduleRequest
+	51, // 50: ptr.PTRService.SetProfile:input_type -> ptr.ProfileRequest
+	5,  // 51: ptr.PTRService.GetProfile:input_type -> ptr.UserIDRequest
+	53, // 52: ptr.PTRService.SearchMedicines:input_type -> ptr.MedicineSearchRequest
+	56, // 53: ptr.PTRService.SetDoseLimit:input_type -> ptr.DoseLimitRequest
+	5,  // 54: ptr.PTRService.GetDoseLimits:input_type -> ptr.UserIDRequest
+	57, // 55: ptr.PTRService.DeleteDoseLimit:input_type -> ptr.DeleteDoseLimitRequest
+	7,  // 56: ptr.PTRService.SetSchedulePaused:input_type -> ptr.SchedulePauseRequest
+	2,  // 57: ptr.PTRService.CreateSchedule:output_type -> ptr.ScheduleIDResponse
+	6,  // 58: ptr.PTRService.GetSchedule:output_type -> ptr.ScheduleResponse
+	8,  // 59: ptr.PTRService.GetSchedulesIDs:output_type -> ptr.ScheduleIDList
+	11, // 60: ptr.PTRService.GetNextTakings:output_type -> ptr.TakingList
+	6,  // 61: ptr.PTRService.UpdateSchedule:output_type -> ptr.ScheduleResponse
+	13, // 62: ptr.PTRService.RecordRefill:output_type -> ptr.InventoryResponse
+	13, // 63: ptr.PTRService.GetInventory:output_type -> ptr.InventoryResponse
+	15, // 64: ptr.PTRService.SnoozeTaking:output_type -> ptr.TakingStateResponse
+	17, // 65: ptr.PTRService.RecordIntake:output_type -> ptr.IntakeResponse
+	19, // 66: ptr.PTRService.LinkCaregiver:output_type -> ptr.CaregiverResponse
+	19, // 67: ptr.PTRService.UnlinkCaregiver:output_type -> ptr.CaregiverResponse
+	20, // 68: ptr.PTRService.GetCaregivers:output_type -> ptr.CaregiverList
+	19, // 69: ptr.PTRService.UpdateCaregiver:output_type -> ptr.CaregiverResponse
+	20, // 70: ptr.PTRService.GetDependants:output_type -> ptr.CaregiverList
+	22, // 71: ptr.PTRService.SetEscalationRule:output_type -> ptr.EscalationResponse
+	22, // 72: ptr.PTRService.GetEscalationRule:output_type -> ptr.EscalationResponse
+	25, // 73: ptr.PTRService.CreateAPIKey:output_type -> ptr.APIKeyResponse
+	26, // 74: ptr.PTRService.GetAPIKeys:output_type -> ptr.APIKeyList
+	25, // 75: ptr.PTRService.RevokeAPIKey:output_type -> ptr.APIKeyResponse
+	28, // 76: ptr.PTRService.SetRole:output_type -> ptr.RoleResponse
+	28, // 77: ptr.PTRService.GetRole:output_type -> ptr.RoleResponse
+	31, // 78: ptr.PTRService.GetScheduleHistory:output_type -> ptr.ScheduleHistory
+	33, // 79: ptr.PTRService.ListSchedules:output_type -> ptr.ScheduleList
+	36, // 80: ptr.PTRService.GetCalendar:output_type -> ptr.Calendar
+	37, // 81: ptr.PTRService.ExportSchedulesICS:output_type -> ptr.ICSCalendar
+	41, // 82: ptr.PTRService.ImportSchedulesICS:output_type -> ptr.ICSImportReport
+	38, // 83: ptr.PTRService.CreateCalendarFeed:output_type -> ptr.CalendarFeed
+	38, // 84: ptr.PTRService.RevokeCalendarFeed:output_type -> ptr.CalendarFeed
+	43, // 85: ptr.PTRService.ExportUserData:output_type -> ptr.UserDataExport
+	46, // 86: ptr.PTRService.ImportUserData:output_type -> ptr.ImportReport
+	6,  // 87: ptr.PTRService.ImportMedicationRequest:output_type -> ptr.ScheduleResponse
+	47, // 88: ptr.PTRService.ExportMedicationStatements:output_type -> ptr.FHIRResource
+	47, // 89: ptr.PTRService.ExportMedicationAdministrations:output_type -> ptr.FHIRResource
+	50, // 90: ptr.PTRService.CreateSchedules:output_type -> ptr.BatchScheduleResponse
+	52, // 91: ptr.PTRService.SetProfile:output_type -> ptr.ProfileResponse
+	52, // 92: ptr.PTRService.GetProfile:output_type -> ptr.ProfileResponse
+	55, // 93: ptr.PTRService.SearchMedicines:output_type -> ptr.MedicineList
+	58, // 94: ptr.PTRService.SetDoseLimit:output_type -> ptr.DoseLimit
+	59, // 95: ptr.PTRService.GetDoseLimits:output_type -> ptr.DoseLimitList
+	58, // 96: ptr.PTRService.DeleteDoseLimit:output_type -> ptr.DoseLimit
+	6,  // 97: ptr.PTRService.SetSchedulePaused:output_type -> ptr.ScheduleResponse
+	57, // [57:98] is the sub-list for method output_type
+	16, // [16:57] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_pills_proto_init() }
@@ -542,13 +4447,14 @@ func file_api_proto_pills_proto_init() {
 	if File_api_proto_pills_proto != nil {
 		return
 	}
+	file_api_proto_pills_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_pills_proto_rawDesc), len(file_api_proto_pills_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
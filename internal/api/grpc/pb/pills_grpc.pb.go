@@ -19,10 +19,47 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PTRService_CreateSchedule_FullMethodName  = "/ptr.PTRService/CreateSchedule"
-	PTRService_GetSchedule_FullMethodName     = "/ptr.PTRService/GetSchedule"
-	PTRService_GetSchedulesIDs_FullMethodName = "/ptr.PTRService/GetSchedulesIDs"
-	PTRService_GetNextTakings_FullMethodName  = "/ptr.PTRService/GetNextTakings"
+	PTRService_CreateSchedule_FullMethodName                  = "/ptr.PTRService/CreateSchedule"
+	PTRService_GetSchedule_FullMethodName                     = "/ptr.PTRService/GetSchedule"
+	PTRService_GetSchedulesIDs_FullMethodName                 = "/ptr.PTRService/GetSchedulesIDs"
+	PTRService_GetNextTakings_FullMethodName                  = "/ptr.PTRService/GetNextTakings"
+	PTRService_UpdateSchedule_FullMethodName                  = "/ptr.PTRService/UpdateSchedule"
+	PTRService_RecordRefill_FullMethodName                    = "/ptr.PTRService/RecordRefill"
+	PTRService_GetInventory_FullMethodName                    = "/ptr.PTRService/GetInventory"
+	PTRService_SnoozeTaking_FullMethodName                    = "/ptr.PTRService/SnoozeTaking"
+	PTRService_RecordIntake_FullMethodName                    = "/ptr.PTRService/RecordIntake"
+	PTRService_LinkCaregiver_FullMethodName                   = "/ptr.PTRService/LinkCaregiver"
+	PTRService_UnlinkCaregiver_FullMethodName                 = "/ptr.PTRService/UnlinkCaregiver"
+	PTRService_GetCaregivers_FullMethodName                   = "/ptr.PTRService/GetCaregivers"
+	PTRService_UpdateCaregiver_FullMethodName                 = "/ptr.PTRService/UpdateCaregiver"
+	PTRService_GetDependants_FullMethodName                   = "/ptr.PTRService/GetDependants"
+	PTRService_SetEscalationRule_FullMethodName               = "/ptr.PTRService/SetEscalationRule"
+	PTRService_GetEscalationRule_FullMethodName               = "/ptr.PTRService/GetEscalationRule"
+	PTRService_CreateAPIKey_FullMethodName                    = "/ptr.PTRService/CreateAPIKey"
+	PTRService_GetAPIKeys_FullMethodName                      = "/ptr.PTRService/GetAPIKeys"
+	PTRService_RevokeAPIKey_FullMethodName                    = "/ptr.PTRService/RevokeAPIKey"
+	PTRService_SetRole_FullMethodName                         = "/ptr.PTRService/SetRole"
+	PTRService_GetRole_FullMethodName                         = "/ptr.PTRService/GetRole"
+	PTRService_GetScheduleHistory_FullMethodName              = "/ptr.PTRService/GetScheduleHistory"
+	PTRService_ListSchedules_FullMethodName                   = "/ptr.PTRService/ListSchedules"
+	PTRService_GetCalendar_FullMethodName                     = "/ptr.PTRService/GetCalendar"
+	PTRService_ExportSchedulesICS_FullMethodName              = "/ptr.PTRService/ExportSchedulesICS"
+	PTRService_ImportSchedulesICS_FullMethodName              = "/ptr.PTRService/ImportSchedulesICS"
+	PTRService_CreateCalendarFeed_FullMethodName              = "/ptr.PTRService/CreateCalendarFeed"
+	PTRService_RevokeCalendarFeed_FullMethodName              = "/ptr.PTRService/RevokeCalendarFeed"
+	PTRService_ExportUserData_FullMethodName                  = "/ptr.PTRService/ExportUserData"
+	PTRService_ImportUserData_FullMethodName                  = "/ptr.PTRService/ImportUserData"
+	PTRService_ImportMedicationRequest_FullMethodName         = "/ptr.PTRService/ImportMedicationRequest"
+	PTRService_ExportMedicationStatements_FullMethodName      = "/ptr.PTRService/ExportMedicationStatements"
+	PTRService_ExportMedicationAdministrations_FullMethodName = "/ptr.PTRService/ExportMedicationAdministrations"
+	PTRService_CreateSchedules_FullMethodName                 = "/ptr.PTRService/CreateSchedules"
+	PTRService_SetProfile_FullMethodName                      = "/ptr.PTRService/SetProfile"
+	PTRService_GetProfile_FullMethodName                      = "/ptr.PTRService/GetProfile"
+	PTRService_SearchMedicines_FullMethodName                 = "/ptr.PTRService/SearchMedicines"
+	PTRService_SetDoseLimit_FullMethodName                    = "/ptr.PTRService/SetDoseLimit"
+	PTRService_GetDoseLimits_FullMethodName                   = "/ptr.PTRService/GetDoseLimits"
+	PTRService_DeleteDoseLimit_FullMethodName                 = "/ptr.PTRService/DeleteDoseLimit"
+	PTRService_SetSchedulePaused_FullMethodName               = "/ptr.PTRService/SetSchedulePaused"
 )
 
 // PTRServiceClient is the client API for PTRService service.
@@ -32,7 +69,44 @@ type PTRServiceClient interface {
 	CreateSchedule(ctx context.Context, in *ScheduleRequest, opts ...grpc.CallOption) (*ScheduleIDResponse, error)
 	GetSchedule(ctx context.Context, in *ScheduleIDRequest, opts ...grpc.CallOption) (*ScheduleResponse, error)
 	GetSchedulesIDs(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*ScheduleIDList, error)
-	GetNextTakings(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*TakingList, error)
+	GetNextTakings(ctx context.Context, in *NextTakingsRequest, opts ...grpc.CallOption) (*TakingList, error)
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*ScheduleResponse, error)
+	RecordRefill(ctx context.Context, in *RefillRequest, opts ...grpc.CallOption) (*InventoryResponse, error)
+	GetInventory(ctx context.Context, in *ScheduleIDRequest, opts ...grpc.CallOption) (*InventoryResponse, error)
+	SnoozeTaking(ctx context.Context, in *SnoozeRequest, opts ...grpc.CallOption) (*TakingStateResponse, error)
+	RecordIntake(ctx context.Context, in *IntakeRequest, opts ...grpc.CallOption) (*IntakeResponse, error)
+	LinkCaregiver(ctx context.Context, in *CaregiverRequest, opts ...grpc.CallOption) (*CaregiverResponse, error)
+	UnlinkCaregiver(ctx context.Context, in *CaregiverRequest, opts ...grpc.CallOption) (*CaregiverResponse, error)
+	GetCaregivers(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*CaregiverList, error)
+	UpdateCaregiver(ctx context.Context, in *CaregiverRequest, opts ...grpc.CallOption) (*CaregiverResponse, error)
+	GetDependants(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*CaregiverList, error)
+	SetEscalationRule(ctx context.Context, in *EscalationRequest, opts ...grpc.CallOption) (*EscalationResponse, error)
+	GetEscalationRule(ctx context.Context, in *ScheduleIDRequest, opts ...grpc.CallOption) (*EscalationResponse, error)
+	CreateAPIKey(ctx context.Context, in *APIKeyRequest, opts ...grpc.CallOption) (*APIKeyResponse, error)
+	GetAPIKeys(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*APIKeyList, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*APIKeyResponse, error)
+	SetRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*RoleResponse, error)
+	GetRole(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*RoleResponse, error)
+	GetScheduleHistory(ctx context.Context, in *ScheduleHistoryRequest, opts ...grpc.CallOption) (*ScheduleHistory, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ScheduleList, error)
+	GetCalendar(ctx context.Context, in *CalendarRequest, opts ...grpc.CallOption) (*Calendar, error)
+	ExportSchedulesICS(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*ICSCalendar, error)
+	ImportSchedulesICS(ctx context.Context, in *ImportICSRequest, opts ...grpc.CallOption) (*ICSImportReport, error)
+	CreateCalendarFeed(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*CalendarFeed, error)
+	RevokeCalendarFeed(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*CalendarFeed, error)
+	ExportUserData(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*UserDataExport, error)
+	ImportUserData(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportReport, error)
+	ImportMedicationRequest(ctx context.Context, in *FHIRResource, opts ...grpc.CallOption) (*ScheduleResponse, error)
+	ExportMedicationStatements(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*FHIRResource, error)
+	ExportMedicationAdministrations(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*FHIRResource, error)
+	CreateSchedules(ctx context.Context, in *BatchScheduleRequest, opts ...grpc.CallOption) (*BatchScheduleResponse, error)
+	SetProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	GetProfile(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	SearchMedicines(ctx context.Context, in *MedicineSearchRequest, opts ...grpc.CallOption) (*MedicineList, error)
+	SetDoseLimit(ctx context.Context, in *DoseLimitRequest, opts ...grpc.CallOption) (*DoseLimit, error)
+	GetDoseLimits(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*DoseLimitList, error)
+	DeleteDoseLimit(ctx context.Context, in *DeleteDoseLimitRequest, opts ...grpc.CallOption) (*DoseLimit, error)
+	SetSchedulePaused(ctx context.Context, in *SchedulePauseRequest, opts ...grpc.CallOption) (*ScheduleResponse, error)
 }
 
 type pTRServiceClient struct {
@@ -73,7 +147,7 @@ func (c *pTRServiceClient) GetSchedulesIDs(ctx context.Context, in *UserIDReques
 	return out, nil
 }
 
-func (c *pTRServiceClient) GetNextTakings(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*TakingList, error) {
+func (c *pTRServiceClient) GetNextTakings(ctx context.Context, in *NextTakingsRequest, opts ...grpc.CallOption) (*TakingList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TakingList)
 	err := c.cc.Invoke(ctx, PTRService_GetNextTakings_FullMethodName, in, out, cOpts...)
@@ -83,6 +157,376 @@ func (c *pTRServiceClient) GetNextTakings(ctx context.Context, in *UserIDRequest
 	return out, nil
 }
 
+func (c *pTRServiceClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*ScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleResponse)
+	err := c.cc.Invoke(ctx, PTRService_UpdateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) RecordRefill(ctx context.Context, in *RefillRequest, opts ...grpc.CallOption) (*InventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InventoryResponse)
+	err := c.cc.Invoke(ctx, PTRService_RecordRefill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetInventory(ctx context.Context, in *ScheduleIDRequest, opts ...grpc.CallOption) (*InventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InventoryResponse)
+	err := c.cc.Invoke(ctx, PTRService_GetInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) SnoozeTaking(ctx context.Context, in *SnoozeRequest, opts ...grpc.CallOption) (*TakingStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TakingStateResponse)
+	err := c.cc.Invoke(ctx, PTRService_SnoozeTaking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) RecordIntake(ctx context.Context, in *IntakeRequest, opts ...grpc.CallOption) (*IntakeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntakeResponse)
+	err := c.cc.Invoke(ctx, PTRService_RecordIntake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) LinkCaregiver(ctx context.Context, in *CaregiverRequest, opts ...grpc.CallOption) (*CaregiverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaregiverResponse)
+	err := c.cc.Invoke(ctx, PTRService_LinkCaregiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) UnlinkCaregiver(ctx context.Context, in *CaregiverRequest, opts ...grpc.CallOption) (*CaregiverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaregiverResponse)
+	err := c.cc.Invoke(ctx, PTRService_UnlinkCaregiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetCaregivers(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*CaregiverList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaregiverList)
+	err := c.cc.Invoke(ctx, PTRService_GetCaregivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) UpdateCaregiver(ctx context.Context, in *CaregiverRequest, opts ...grpc.CallOption) (*CaregiverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaregiverResponse)
+	err := c.cc.Invoke(ctx, PTRService_UpdateCaregiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetDependants(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*CaregiverList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaregiverList)
+	err := c.cc.Invoke(ctx, PTRService_GetDependants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) SetEscalationRule(ctx context.Context, in *EscalationRequest, opts ...grpc.CallOption) (*EscalationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationResponse)
+	err := c.cc.Invoke(ctx, PTRService_SetEscalationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetEscalationRule(ctx context.Context, in *ScheduleIDRequest, opts ...grpc.CallOption) (*EscalationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationResponse)
+	err := c.cc.Invoke(ctx, PTRService_GetEscalationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) CreateAPIKey(ctx context.Context, in *APIKeyRequest, opts ...grpc.CallOption) (*APIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeyResponse)
+	err := c.cc.Invoke(ctx, PTRService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetAPIKeys(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*APIKeyList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeyList)
+	err := c.cc.Invoke(ctx, PTRService_GetAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*APIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeyResponse)
+	err := c.cc.Invoke(ctx, PTRService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) SetRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*RoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleResponse)
+	err := c.cc.Invoke(ctx, PTRService_SetRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetRole(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*RoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoleResponse)
+	err := c.cc.Invoke(ctx, PTRService_GetRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetScheduleHistory(ctx context.Context, in *ScheduleHistoryRequest, opts ...grpc.CallOption) (*ScheduleHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleHistory)
+	err := c.cc.Invoke(ctx, PTRService_GetScheduleHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ScheduleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleList)
+	err := c.cc.Invoke(ctx, PTRService_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetCalendar(ctx context.Context, in *CalendarRequest, opts ...grpc.CallOption) (*Calendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Calendar)
+	err := c.cc.Invoke(ctx, PTRService_GetCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) ExportSchedulesICS(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*ICSCalendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ICSCalendar)
+	err := c.cc.Invoke(ctx, PTRService_ExportSchedulesICS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) ImportSchedulesICS(ctx context.Context, in *ImportICSRequest, opts ...grpc.CallOption) (*ICSImportReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ICSImportReport)
+	err := c.cc.Invoke(ctx, PTRService_ImportSchedulesICS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) CreateCalendarFeed(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*CalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeed)
+	err := c.cc.Invoke(ctx, PTRService_CreateCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) RevokeCalendarFeed(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*CalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeed)
+	err := c.cc.Invoke(ctx, PTRService_RevokeCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) ExportUserData(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*UserDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataExport)
+	err := c.cc.Invoke(ctx, PTRService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) ImportUserData(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportReport)
+	err := c.cc.Invoke(ctx, PTRService_ImportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) ImportMedicationRequest(ctx context.Context, in *FHIRResource, opts ...grpc.CallOption) (*ScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleResponse)
+	err := c.cc.Invoke(ctx, PTRService_ImportMedicationRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) ExportMedicationStatements(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*FHIRResource, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FHIRResource)
+	err := c.cc.Invoke(ctx, PTRService_ExportMedicationStatements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) ExportMedicationAdministrations(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*FHIRResource, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FHIRResource)
+	err := c.cc.Invoke(ctx, PTRService_ExportMedicationAdministrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) CreateSchedules(ctx context.Context, in *BatchScheduleRequest, opts ...grpc.CallOption) (*BatchScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchScheduleResponse)
+	err := c.cc.Invoke(ctx, PTRService_CreateSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) SetProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, PTRService_SetProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetProfile(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, PTRService_GetProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) SearchMedicines(ctx context.Context, in *MedicineSearchRequest, opts ...grpc.CallOption) (*MedicineList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MedicineList)
+	err := c.cc.Invoke(ctx, PTRService_SearchMedicines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) SetDoseLimit(ctx context.Context, in *DoseLimitRequest, opts ...grpc.CallOption) (*DoseLimit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoseLimit)
+	err := c.cc.Invoke(ctx, PTRService_SetDoseLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetDoseLimits(ctx context.Context, in *UserIDRequest, opts ...grpc.CallOption) (*DoseLimitList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoseLimitList)
+	err := c.cc.Invoke(ctx, PTRService_GetDoseLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) DeleteDoseLimit(ctx context.Context, in *DeleteDoseLimitRequest, opts ...grpc.CallOption) (*DoseLimit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoseLimit)
+	err := c.cc.Invoke(ctx, PTRService_DeleteDoseLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) SetSchedulePaused(ctx context.Context, in *SchedulePauseRequest, opts ...grpc.CallOption) (*ScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleResponse)
+	err := c.cc.Invoke(ctx, PTRService_SetSchedulePaused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PTRServiceServer is the server API for PTRService service.
 // All implementations must embed UnimplementedPTRServiceServer
 // for forward compatibility.
@@ -90,118 +534,932 @@ type PTRServiceServer interface {
 	CreateSchedule(context.Context, *ScheduleRequest) (*ScheduleIDResponse, error)
 	GetSchedule(context.Context, *ScheduleIDRequest) (*ScheduleResponse, error)
 	GetSchedulesIDs(context.Context, *UserIDRequest) (*ScheduleIDList, error)
-	GetNextTakings(context.Context, *UserIDRequest) (*TakingList, error)
+	GetNextTakings(context.Context, *NextTakingsRequest) (*TakingList, error)
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*ScheduleResponse, error)
+	RecordRefill(context.Context, *RefillRequest) (*InventoryResponse, error)
+	GetInventory(context.Context, *ScheduleIDRequest) (*InventoryResponse, error)
+	SnoozeTaking(context.Context, *SnoozeRequest) (*TakingStateResponse, error)
+	RecordIntake(context.Context, *IntakeRequest) (*IntakeResponse, error)
+	LinkCaregiver(context.Context, *CaregiverRequest) (*CaregiverResponse, error)
+	UnlinkCaregiver(context.Context, *CaregiverRequest) (*CaregiverResponse, error)
+	GetCaregivers(context.Context, *UserIDRequest) (*CaregiverList, error)
+	UpdateCaregiver(context.Context, *CaregiverRequest) (*CaregiverResponse, error)
+	GetDependants(context.Context, *UserIDRequest) (*CaregiverList, error)
+	SetEscalationRule(context.Context, *EscalationRequest) (*EscalationResponse, error)
+	GetEscalationRule(context.Context, *ScheduleIDRequest) (*EscalationResponse, error)
+	CreateAPIKey(context.Context, *APIKeyRequest) (*APIKeyResponse, error)
+	GetAPIKeys(context.Context, *UserIDRequest) (*APIKeyList, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKeyResponse, error)
+	SetRole(context.Context, *RoleRequest) (*RoleResponse, error)
+	GetRole(context.Context, *UserIDRequest) (*RoleResponse, error)
+	GetScheduleHistory(context.Context, *ScheduleHistoryRequest) (*ScheduleHistory, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ScheduleList, error)
+	GetCalendar(context.Context, *CalendarRequest) (*Calendar, error)
+	ExportSchedulesICS(context.Context, *UserIDRequest) (*ICSCalendar, error)
+	ImportSchedulesICS(context.Context, *ImportICSRequest) (*ICSImportReport, error)
+	CreateCalendarFeed(context.Context, *UserIDRequest) (*CalendarFeed, error)
+	RevokeCalendarFeed(context.Context, *UserIDRequest) (*CalendarFeed, error)
+	ExportUserData(context.Context, *ExportRequest) (*UserDataExport, error)
+	ImportUserData(context.Context, *ImportRequest) (*ImportReport, error)
+	ImportMedicationRequest(context.Context, *FHIRResource) (*ScheduleResponse, error)
+	ExportMedicationStatements(context.Context, *UserIDRequest) (*FHIRResource, error)
+	ExportMedicationAdministrations(context.Context, *UserIDRequest) (*FHIRResource, error)
+	CreateSchedules(context.Context, *BatchScheduleRequest) (*BatchScheduleResponse, error)
+	SetProfile(context.Context, *ProfileRequest) (*ProfileResponse, error)
+	GetProfile(context.Context, *UserIDRequest) (*ProfileResponse, error)
+	SearchMedicines(context.Context, *MedicineSearchRequest) (*MedicineList, error)
+	SetDoseLimit(context.Context, *DoseLimitRequest) (*DoseLimit, error)
+	GetDoseLimits(context.Context, *UserIDRequest) (*DoseLimitList, error)
+	DeleteDoseLimit(context.Context, *DeleteDoseLimitRequest) (*DoseLimit, error)
+	SetSchedulePaused(context.Context, *SchedulePauseRequest) (*ScheduleResponse, error)
 	mustEmbedUnimplementedPTRServiceServer()
 }
 
-// UnimplementedPTRServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedPTRServiceServer struct{}
+// UnimplementedPTRServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPTRServiceServer struct{}
+
+func (UnimplementedPTRServiceServer) CreateSchedule(context.Context, *ScheduleRequest) (*ScheduleIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedPTRServiceServer) GetSchedule(context.Context, *ScheduleIDRequest) (*ScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedule not implemented")
+}
+func (UnimplementedPTRServiceServer) GetSchedulesIDs(context.Context, *UserIDRequest) (*ScheduleIDList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulesIDs not implemented")
+}
+func (UnimplementedPTRServiceServer) GetNextTakings(context.Context, *NextTakingsRequest) (*TakingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextTakings not implemented")
+}
+func (UnimplementedPTRServiceServer) UpdateSchedule(context.Context, *UpdateScheduleRequest) (*ScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (UnimplementedPTRServiceServer) RecordRefill(context.Context, *RefillRequest) (*InventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordRefill not implemented")
+}
+func (UnimplementedPTRServiceServer) GetInventory(context.Context, *ScheduleIDRequest) (*InventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventory not implemented")
+}
+func (UnimplementedPTRServiceServer) SnoozeTaking(context.Context, *SnoozeRequest) (*TakingStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeTaking not implemented")
+}
+func (UnimplementedPTRServiceServer) RecordIntake(context.Context, *IntakeRequest) (*IntakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordIntake not implemented")
+}
+func (UnimplementedPTRServiceServer) LinkCaregiver(context.Context, *CaregiverRequest) (*CaregiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkCaregiver not implemented")
+}
+func (UnimplementedPTRServiceServer) UnlinkCaregiver(context.Context, *CaregiverRequest) (*CaregiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkCaregiver not implemented")
+}
+func (UnimplementedPTRServiceServer) GetCaregivers(context.Context, *UserIDRequest) (*CaregiverList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCaregivers not implemented")
+}
+func (UnimplementedPTRServiceServer) UpdateCaregiver(context.Context, *CaregiverRequest) (*CaregiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCaregiver not implemented")
+}
+func (UnimplementedPTRServiceServer) GetDependants(context.Context, *UserIDRequest) (*CaregiverList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDependants not implemented")
+}
+func (UnimplementedPTRServiceServer) SetEscalationRule(context.Context, *EscalationRequest) (*EscalationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEscalationRule not implemented")
+}
+func (UnimplementedPTRServiceServer) GetEscalationRule(context.Context, *ScheduleIDRequest) (*EscalationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEscalationRule not implemented")
+}
+func (UnimplementedPTRServiceServer) CreateAPIKey(context.Context, *APIKeyRequest) (*APIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedPTRServiceServer) GetAPIKeys(context.Context, *UserIDRequest) (*APIKeyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPIKeys not implemented")
+}
+func (UnimplementedPTRServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedPTRServiceServer) SetRole(context.Context, *RoleRequest) (*RoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRole not implemented")
+}
+func (UnimplementedPTRServiceServer) GetRole(context.Context, *UserIDRequest) (*RoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
+}
+func (UnimplementedPTRServiceServer) GetScheduleHistory(context.Context, *ScheduleHistoryRequest) (*ScheduleHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduleHistory not implemented")
+}
+func (UnimplementedPTRServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ScheduleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedPTRServiceServer) GetCalendar(context.Context, *CalendarRequest) (*Calendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendar not implemented")
+}
+func (UnimplementedPTRServiceServer) ExportSchedulesICS(context.Context, *UserIDRequest) (*ICSCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSchedulesICS not implemented")
+}
+func (UnimplementedPTRServiceServer) ImportSchedulesICS(context.Context, *ImportICSRequest) (*ICSImportReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSchedulesICS not implemented")
+}
+func (UnimplementedPTRServiceServer) CreateCalendarFeed(context.Context, *UserIDRequest) (*CalendarFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendarFeed not implemented")
+}
+func (UnimplementedPTRServiceServer) RevokeCalendarFeed(context.Context, *UserIDRequest) (*CalendarFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCalendarFeed not implemented")
+}
+func (UnimplementedPTRServiceServer) ExportUserData(context.Context, *ExportRequest) (*UserDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedPTRServiceServer) ImportUserData(context.Context, *ImportRequest) (*ImportReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportUserData not implemented")
+}
+func (UnimplementedPTRServiceServer) ImportMedicationRequest(context.Context, *FHIRResource) (*ScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMedicationRequest not implemented")
+}
+func (UnimplementedPTRServiceServer) ExportMedicationStatements(context.Context, *UserIDRequest) (*FHIRResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMedicationStatements not implemented")
+}
+func (UnimplementedPTRServiceServer) ExportMedicationAdministrations(context.Context, *UserIDRequest) (*FHIRResource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMedicationAdministrations not implemented")
+}
+func (UnimplementedPTRServiceServer) CreateSchedules(context.Context, *BatchScheduleRequest) (*BatchScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedules not implemented")
+}
+func (UnimplementedPTRServiceServer) SetProfile(context.Context, *ProfileRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfile not implemented")
+}
+func (UnimplementedPTRServiceServer) GetProfile(context.Context, *UserIDRequest) (*ProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedPTRServiceServer) SearchMedicines(context.Context, *MedicineSearchRequest) (*MedicineList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMedicines not implemented")
+}
+func (UnimplementedPTRServiceServer) SetDoseLimit(context.Context, *DoseLimitRequest) (*DoseLimit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDoseLimit not implemented")
+}
+func (UnimplementedPTRServiceServer) GetDoseLimits(context.Context, *UserIDRequest) (*DoseLimitList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoseLimits not implemented")
+}
+func (UnimplementedPTRServiceServer) DeleteDoseLimit(context.Context, *DeleteDoseLimitRequest) (*DoseLimit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDoseLimit not implemented")
+}
+func (UnimplementedPTRServiceServer) SetSchedulePaused(context.Context, *SchedulePauseRequest) (*ScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchedulePaused not implemented")
+}
+func (UnimplementedPTRServiceServer) mustEmbedUnimplementedPTRServiceServer() {}
+func (UnimplementedPTRServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafePTRServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PTRServiceServer will
+// result in compilation errors.
+type UnsafePTRServiceServer interface {
+	mustEmbedUnimplementedPTRServiceServer()
+}
+
+func RegisterPTRServiceServer(s grpc.ServiceRegistrar, srv PTRServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPTRServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PTRService_ServiceDesc, srv)
+}
+
+func _PTRService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).CreateSchedule(ctx, req.(*ScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetSchedule(ctx, req.(*ScheduleIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetSchedulesIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetSchedulesIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetSchedulesIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetSchedulesIDs(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetNextTakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextTakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetNextTakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetNextTakings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetNextTakings(ctx, req.(*NextTakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_UpdateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_RecordRefill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).RecordRefill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_RecordRefill_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).RecordRefill(ctx, req.(*RefillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetInventory(ctx, req.(*ScheduleIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_SnoozeTaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).SnoozeTaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_SnoozeTaking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).SnoozeTaking(ctx, req.(*SnoozeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_RecordIntake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).RecordIntake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_RecordIntake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).RecordIntake(ctx, req.(*IntakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_LinkCaregiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaregiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).LinkCaregiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_LinkCaregiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).LinkCaregiver(ctx, req.(*CaregiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_UnlinkCaregiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaregiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).UnlinkCaregiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_UnlinkCaregiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).UnlinkCaregiver(ctx, req.(*CaregiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetCaregivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetCaregivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetCaregivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetCaregivers(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_UpdateCaregiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaregiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).UpdateCaregiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_UpdateCaregiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).UpdateCaregiver(ctx, req.(*CaregiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetDependants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetDependants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetDependants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetDependants(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_SetEscalationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EscalationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).SetEscalationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_SetEscalationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).SetEscalationRule(ctx, req.(*EscalationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetEscalationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetEscalationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetEscalationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetEscalationRule(ctx, req.(*ScheduleIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).CreateAPIKey(ctx, req.(*APIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetAPIKeys(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_SetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).SetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_SetRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).SetRole(ctx, req.(*RoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetRole(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetScheduleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetScheduleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetScheduleHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetScheduleHistory(ctx, req.(*ScheduleHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetCalendar(ctx, req.(*CalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_ExportSchedulesICS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).ExportSchedulesICS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_ExportSchedulesICS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).ExportSchedulesICS(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_ImportSchedulesICS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportICSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).ImportSchedulesICS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_ImportSchedulesICS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).ImportSchedulesICS(ctx, req.(*ImportICSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_CreateCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).CreateCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_CreateCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).CreateCalendarFeed(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedPTRServiceServer) CreateSchedule(context.Context, *ScheduleRequest) (*ScheduleIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+func _PTRService_RevokeCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).RevokeCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_RevokeCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).RevokeCalendarFeed(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPTRServiceServer) GetSchedule(context.Context, *ScheduleIDRequest) (*ScheduleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSchedule not implemented")
+
+func _PTRService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).ExportUserData(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPTRServiceServer) GetSchedulesIDs(context.Context, *UserIDRequest) (*ScheduleIDList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSchedulesIDs not implemented")
+
+func _PTRService_ImportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).ImportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_ImportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).ImportUserData(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPTRServiceServer) GetNextTakings(context.Context, *UserIDRequest) (*TakingList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNextTakings not implemented")
+
+func _PTRService_ImportMedicationRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FHIRResource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).ImportMedicationRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_ImportMedicationRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).ImportMedicationRequest(ctx, req.(*FHIRResource))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPTRServiceServer) mustEmbedUnimplementedPTRServiceServer() {}
-func (UnimplementedPTRServiceServer) testEmbeddedByValue()                    {}
 
-// UnsafePTRServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PTRServiceServer will
-// result in compilation errors.
-type UnsafePTRServiceServer interface {
-	mustEmbedUnimplementedPTRServiceServer()
+func _PTRService_ExportMedicationStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).ExportMedicationStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_ExportMedicationStatements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).ExportMedicationStatements(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPTRServiceServer(s grpc.ServiceRegistrar, srv PTRServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPTRServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _PTRService_ExportMedicationAdministrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&PTRService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(PTRServiceServer).ExportMedicationAdministrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_ExportMedicationAdministrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).ExportMedicationAdministrations(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PTRService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScheduleRequest)
+func _PTRService_CreateSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PTRServiceServer).CreateSchedule(ctx, in)
+		return srv.(PTRServiceServer).CreateSchedules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PTRService_CreateSchedule_FullMethodName,
+		FullMethod: PTRService_CreateSchedules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PTRServiceServer).CreateSchedule(ctx, req.(*ScheduleRequest))
+		return srv.(PTRServiceServer).CreateSchedules(ctx, req.(*BatchScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PTRService_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScheduleIDRequest)
+func _PTRService_SetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PTRServiceServer).GetSchedule(ctx, in)
+		return srv.(PTRServiceServer).SetProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PTRService_GetSchedule_FullMethodName,
+		FullMethod: PTRService_SetProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PTRServiceServer).GetSchedule(ctx, req.(*ScheduleIDRequest))
+		return srv.(PTRServiceServer).SetProfile(ctx, req.(*ProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PTRService_GetSchedulesIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _PTRService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PTRServiceServer).GetSchedulesIDs(ctx, in)
+		return srv.(PTRServiceServer).GetProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PTRService_GetSchedulesIDs_FullMethodName,
+		FullMethod: PTRService_GetProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PTRServiceServer).GetSchedulesIDs(ctx, req.(*UserIDRequest))
+		return srv.(PTRServiceServer).GetProfile(ctx, req.(*UserIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PTRService_GetNextTakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _PTRService_SearchMedicines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MedicineSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).SearchMedicines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_SearchMedicines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).SearchMedicines(ctx, req.(*MedicineSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_SetDoseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoseLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).SetDoseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_SetDoseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).SetDoseLimit(ctx, req.(*DoseLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetDoseLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PTRServiceServer).GetNextTakings(ctx, in)
+		return srv.(PTRServiceServer).GetDoseLimits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PTRService_GetNextTakings_FullMethodName,
+		FullMethod: PTRService_GetDoseLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetDoseLimits(ctx, req.(*UserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_DeleteDoseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDoseLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).DeleteDoseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_DeleteDoseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).DeleteDoseLimit(ctx, req.(*DeleteDoseLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_SetSchedulePaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).SetSchedulePaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_SetSchedulePaused_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PTRServiceServer).GetNextTakings(ctx, req.(*UserIDRequest))
+		return srv.(PTRServiceServer).SetSchedulePaused(ctx, req.(*SchedulePauseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -229,6 +1487,154 @@ var PTRService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNextTakings",
 			Handler:    _PTRService_GetNextTakings_Handler,
 		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _PTRService_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "RecordRefill",
+			Handler:    _PTRService_RecordRefill_Handler,
+		},
+		{
+			MethodName: "GetInventory",
+			Handler:    _PTRService_GetInventory_Handler,
+		},
+		{
+			MethodName: "SnoozeTaking",
+			Handler:    _PTRService_SnoozeTaking_Handler,
+		},
+		{
+			MethodName: "RecordIntake",
+			Handler:    _PTRService_RecordIntake_Handler,
+		},
+		{
+			MethodName: "LinkCaregiver",
+			Handler:    _PTRService_LinkCaregiver_Handler,
+		},
+		{
+			MethodName: "UnlinkCaregiver",
+			Handler:    _PTRService_UnlinkCaregiver_Handler,
+		},
+		{
+			MethodName: "GetCaregivers",
+			Handler:    _PTRService_GetCaregivers_Handler,
+		},
+		{
+			MethodName: "UpdateCaregiver",
+			Handler:    _PTRService_UpdateCaregiver_Handler,
+		},
+		{
+			MethodName: "GetDependants",
+			Handler:    _PTRService_GetDependants_Handler,
+		},
+		{
+			MethodName: "SetEscalationRule",
+			Handler:    _PTRService_SetEscalationRule_Handler,
+		},
+		{
+			MethodName: "GetEscalationRule",
+			Handler:    _PTRService_GetEscalationRule_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _PTRService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "GetAPIKeys",
+			Handler:    _PTRService_GetAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _PTRService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "SetRole",
+			Handler:    _PTRService_SetRole_Handler,
+		},
+		{
+			MethodName: "GetRole",
+			Handler:    _PTRService_GetRole_Handler,
+		},
+		{
+			MethodName: "GetScheduleHistory",
+			Handler:    _PTRService_GetScheduleHistory_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _PTRService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _PTRService_GetCalendar_Handler,
+		},
+		{
+			MethodName: "ExportSchedulesICS",
+			Handler:    _PTRService_ExportSchedulesICS_Handler,
+		},
+		{
+			MethodName: "ImportSchedulesICS",
+			Handler:    _PTRService_ImportSchedulesICS_Handler,
+		},
+		{
+			MethodName: "CreateCalendarFeed",
+			Handler:    _PTRService_CreateCalendarFeed_Handler,
+		},
+		{
+			MethodName: "RevokeCalendarFeed",
+			Handler:    _PTRService_RevokeCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _PTRService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "ImportUserData",
+			Handler:    _PTRService_ImportUserData_Handler,
+		},
+		{
+			MethodName: "ImportMedicationRequest",
+			Handler:    _PTRService_ImportMedicationRequest_Handler,
+		},
+		{
+			MethodName: "ExportMedicationStatements",
+			Handler:    _PTRService_ExportMedicationStatements_Handler,
+		},
+		{
+			MethodName: "ExportMedicationAdministrations",
+			Handler:    _PTRService_ExportMedicationAdministrations_Handler,
+		},
+		{
+			MethodName: "CreateSchedules",
+			Handler:    _PTRService_CreateSchedules_Handler,
+		},
+		{
+			MethodName: "SetProfile",
+			Handler:    _PTRService_SetProfile_Handler,
+		},
+		{
+			MethodName: "GetProfile",
+			Handler:    _PTRService_GetProfile_Handler,
+		},
+		{
+			MethodName: "SearchMedicines",
+			Handler:    _PTRService_SearchMedicines_Handler,
+		},
+		{
+			MethodName: "SetDoseLimit",
+			Handler:    _PTRService_SetDoseLimit_Handler,
+		},
+		{
+			MethodName: "GetDoseLimits",
+			Handler:    _PTRService_GetDoseLimits_Handler,
+		},
+		{
+			MethodName: "DeleteDoseLimit",
+			Handler:    _PTRService_DeleteDoseLimit_Handler,
+		},
+		{
+			MethodName: "SetSchedulePaused",
+			Handler:    _PTRService_SetSchedulePaused_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/pills.proto",
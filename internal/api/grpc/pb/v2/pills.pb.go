@@ -0,0 +1,933 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: api/proto/v2/pills.proto
+
+// ptr.v2 serves the schedules and the takings with typed dates instead of the
+// formatted strings of ptr.
+
+package pbv2
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TimeOfDay is a time of the day in the time zone of the service.
+type TimeOfDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         int32                  `protobuf:"varint,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	Minutes       int32                  `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeOfDay) Reset() {
+	*x = TimeOfDay{}
+	mi := &file_api_proto_v2_pills_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeOfDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeOfDay) ProtoMessage() {}
+
+func (x *TimeOfDay) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v2_pills_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeOfDay.ProtoReflect.Descriptor instead.
+func (*TimeOfDay) Descriptor() ([]byte, []int) {
+	return file_api_proto_v2_pills_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimeOfDay) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *TimeOfDay) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+type ScheduleIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId    int64                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleIDRequest) Reset() {
+	*x = ScheduleIDRequest{}
+	mi := &file_api_proto_v2_pills_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleIDRequest) ProtoMessage() {}
+
+func (x *ScheduleIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v2_pills_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleIDRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleIDRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v2_pills_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduleIDRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ScheduleIDRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+// NextTakingsRequest selects the takings in the next within, at most limit
+// of them. Without within the configured window is used, or up to a week when
+// only a limit is given.
+type NextTakingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Within        *durationpb.Duration   `protobuf:"bytes,2,opt,name=within,proto3" json:"within,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextTakingsRequest) Reset() {
+	*x = NextTakingsRequest{}
+	mi := &file_api_proto_v2_pills_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextTakingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextTakingsRequest) ProtoMessage() {}
+
+func (x *NextTakingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v2_pills_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextTakingsRequest.ProtoReflect.Descriptor instead.
+func (*NextTakingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v2_pills_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NextTakingsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NextTakingsRequest) GetWithin() *durationpb.Duration {
+	if x != nil {
+		return x.Within
+	}
+	return nil
+}
+
+func (x *NextTakingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type UpdateScheduleRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId   int64                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MedicineName string                 `protobuf:"bytes,3,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	Frequency    int32                  `protobuf:"varint,4,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Duration     int32                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// version is the version of the schedule the update is based on.
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// medicine_id is the catalog medicine, see ptr.ScheduleRequest.
+	MedicineId string `protobuf:"bytes,7,opt,name=medicine_id,json=medicineId,proto3" json:"medicine_id,omitempty"`
+	// dose is the dose of one taking in mg, 0 when unknown.
+	Dose float64 `protobuf:"fixed64,8,opt,name=dose,proto3" json:"dose,omitempty"`
+	// override_interactions updates the schedule even if its new medicine is
+	// contraindicated with the medicine of an active schedule.
+	OverrideInteractions bool `protobuf:"varint,9,opt,name=override_interactions,json=overrideInteractions,proto3" json:"override_interactions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleRequest) Reset() {
+	*x = UpdateScheduleRequest{}
+	mi := &file_api_proto_v2_pills_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v2_pills_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v2_pills_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateScheduleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetFrequency() int32 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetMedicineId() string {
+	if x != nil {
+		return x.MedicineId
+	}
+	return ""
+}
+
+func (x *UpdateScheduleRequest) GetDose() float64 {
+	if x != nil {
+		return x.Dose
+	}
+	return 0
+}
+
+func (x *UpdateScheduleRequest) GetOverrideInteractions() bool {
+	if x != nil {
+		return x.OverrideInteractions
+	}
+	return false
+}
+
+type Schedule struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MedicineName string                 `protobuf:"bytes,2,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	StartDate    *date.Date             `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is unset for the schedules taken with no end.
+	EndDate     *date.Date   `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	UserId      int64        `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TakingTimes []*TimeOfDay `protobuf:"bytes,6,rep,name=taking_times,json=takingTimes,proto3" json:"taking_times,omitempty"`
+	Version     int64        `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// medicine_id is empty for the custom medicines.
+	MedicineId string  `protobuf:"bytes,8,opt,name=medicine_id,json=medicineId,proto3" json:"medicine_id,omitempty"`
+	Dose       float64 `protobuf:"fixed64,9,opt,name=dose,proto3" json:"dose,omitempty"`
+	// warnings are the interactions of a medicine changed by an update with
+	// the active schedules, the most severe first. Empty in the other replies.
+	Warnings []*InteractionWarning `protobuf:"bytes,10,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// paused schedules have no takings and send no reminders.
+	Paused        bool `protobuf:"varint,11,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_api_proto_v2_pills_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v2_pills_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_api_proto_v2_pills_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Schedule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Schedule) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *Schedule) GetStartDate() *date.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Schedule) GetEndDate() *date.Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Schedule) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Schedule) GetTakingTimes() []*TimeOfDay {
+	if x != nil {
+		return x.TakingTimes
+	}
+	return nil
+}
+
+func (x *Schedule) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Schedule) GetMedicineId() string {
+	if x != nil {
+		return x.MedicineId
+	}
+	return ""
+}
+
+func (x *Schedule) GetDose() float64 {
+	if x != nil {
+		return x.Dose
+	}
+	return 0
+}
+
+func (x *Schedule) GetWarnings() []*InteractionWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *Schedule) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// InteractionWarning is an interaction with the medicine of an active
+// schedule, see ptr.InteractionWarning.
+type InteractionWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	MedicineId    string                 `protobuf:"bytes,2,opt,name=medicine_id,json=medicineId,proto3" json:"medicine_id,omitempty"`
+	MedicineName  string                 `protobuf:"bytes,3,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractionWarning) Reset() {
+	*x = InteractionWarning{}
+	mi := &file_api_proto_v2_pills_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractionWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractionWarning) ProtoMessage() {}
+
+func (x *InteractionWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v2_pills_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractionWarning.ProtoReflect.Descriptor instead.
+func (*InteractionWarning) Descriptor() ([]byte, []int) {
+	return file_api_proto_v2_pills_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InteractionWarning) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *InteractionWarning) GetMedicineId() string {
+	if x != nil {
+		return x.MedicineId
+	}
+	return ""
+}
+
+func (x *InteractionWarning) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *InteractionWarning) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *InteractionWarning) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListSchedulesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// status is one of all, active, paused, finished.
+	Status         string     `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	MedicinePrefix string     `protobuf:"bytes,3,opt,name=medicine_prefix,json=medicinePrefix,proto3" json:"medicine_prefix,omitempty"`
+	From           *date.Date `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To             *date.Date `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// sort is one of id, medicine_name, start_date.
+	Sort          string `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	Descending    bool   `protobuf:"varint,7,opt,name=descending,proto3" json:"descending,omitempty"`
+	Limit         int32  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_api_proto_v2_pills_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v2_pills_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_v2_pills_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSchedulesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetMedicinePrefix() string {
+	if x != nil {
+		return x.MedicinePrefix
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetFrom() *date.Date {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListSchedulesRequest) GetTo() *date.Date {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListSchedulesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListSchedulesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ScheduleList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleList) Reset() {
+	*x = ScheduleList{}
+	mi := &file_api_proto_v2_pills_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleList) ProtoMessage() {}
+
+func (x *ScheduleList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v2_pills_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleList.ProtoReflect.Descriptor instead.
+func (*ScheduleList) Descriptor() ([]byte, []int) {
+	return file_api_proto_v2_pills_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScheduleList) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *ScheduleList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type Taking struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MedicineName  string                 `protobuf:"bytes,1,opt,name=medicine_name,json=medicineName,proto3" json:"medicine_name,omitempty"`
+	TakingTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=taking_time,json=takingTime,proto3" json:"taking_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Taking) Reset() {
+	*x = Taking{}
+	mi := &file_api_proto_v2_pills_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Taking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Taking) ProtoMessage() {}
+
+func (x *Taking) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v2_pills_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Taking.ProtoReflect.Descriptor instead.
+func (*Taking) Descriptor() ([]byte, []int) {
+	return file_api_proto_v2_pills_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Taking) GetMedicineName() string {
+	if x != nil {
+		return x.MedicineName
+	}
+	return ""
+}
+
+func (x *Taking) GetTakingTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TakingTime
+	}
+	return nil
+}
+
+type TakingList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Takings       []*Taking              `protobuf:"bytes,1,rep,name=takings,proto3" json:"takings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakingList) Reset() {
+	*x = TakingList{}
+	mi := &file_api_proto_v2_pills_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakingList) ProtoMessage() {}
+
+func (x *TakingList) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_v2_pills_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakingList.ProtoReflect.Descriptor instead.
+func (*TakingList) Descriptor() ([]byte, []int) {
+	return file_api_proto_v2_pills_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TakingList) GetTakings() []*Taking {
+	if x != nil {
+		return x.Takings
+	}
+	return nil
+}
+
+var File_api_proto_v2_pills_proto protoreflect.FileDescriptor
+
+const file_api_proto_v2_pills_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/proto/v2/pills.proto\x12\x06ptr.v2\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\";\n" +
+	"\tTimeOfDay\x12\x14\n" +
+	"\x05hours\x18\x01 \x01(\x05R\x05hours\x12\x18\n" +
+	"\aminutes\x18\x02 \x01(\x05R\aminutes\"M\n" +
+	"\x11ScheduleIDRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\"v\n" +
+	"\x12NextTakingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x121\n" +
+	"\x06within\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06within\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xb4\x02\n" +
+	"\x15UpdateScheduleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x03R\n" +
+	"scheduleId\x12#\n" +
+	"\rmedicine_name\x18\x03 \x01(\tR\fmedicineName\x12\x1c\n" +
+	"\tfrequency\x18\x04 \x01(\x05R\tfrequency\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\x05R\bduration\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12\x1f\n" +
+	"\vmedicine_id\x18\a \x01(\tR\n" +
+	"medicineId\x12\x12\n" +
+	"\x04dose\x18\b \x01(\x01R\x04dose\x123\n" +
+	"\x15override_interactions\x18\t \x01(\bR\x14overrideInteractions\"\x8d\x03\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rmedicine_name\x18\x02 \x01(\tR\fmedicineName\x120\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x11.google.type.DateR\tstartDate\x12,\n" +
+	"\bend_date\x18\x04 \x01(\v2\x11.google.type.DateR\aendDate\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\x124\n" +
+	"\ftaking_times\x18\x06 \x03(\v2\x11.ptr.v2.TimeOfDayR\vtakingTimes\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\x12\x1f\n" +
+	"\vmedicine_id\x18\b \x01(\tR\n" +
+	"medicineId\x12\x12\n" +
+	"\x04dose\x18\t \x01(\x01R\x04dose\x126\n" +
+	"\bwarnings\x18\n" +
+	" \x03(\v2\x1a.ptr.v2.InteractionWarningR\bwarnings\x12\x16\n" +
+	"\x06paused\x18\v \x01(\bR\x06paused\"\xb9\x01\n" +
+	"\x12InteractionWarning\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x03R\n" +
+	"scheduleId\x12\x1f\n" +
+	"\vmedicine_id\x18\x02 \x01(\tR\n" +
+	"medicineId\x12#\n" +
+	"\rmedicine_name\x18\x03 \x01(\tR\fmedicineName\x12\x1a\n" +
+	"\bseverity\x18\x04 \x01(\tR\bseverity\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x9c\x02\n" +
+	"\x14ListSchedulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0fmedicine_prefix\x18\x03 \x01(\tR\x0emedicinePrefix\x12%\n" +
+	"\x04from\x18\x04 \x01(\v2\x11.google.type.DateR\x04from\x12!\n" +
+	"\x02to\x18\x05 \x01(\v2\x11.google.type.DateR\x02to\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\tR\x04sort\x12\x1e\n" +
+	"\n" +
+	"descending\x18\a \x01(\bR\n" +
+	"descending\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursor\"_\n" +
+	"\fScheduleList\x12.\n" +
+	"\tschedules\x18\x01 \x03(\v2\x10.ptr.v2.ScheduleR\tschedules\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"j\n" +
+	"\x06Taking\x12#\n" +
+	"\rmedicine_name\x18\x01 \x01(\tR\fmedicineName\x12;\n" +
+	"\vtaking_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"takingTime\"6\n" +
+	"\n" +
+	"TakingList\x12(\n" +
+	"\atakings\x18\x01 \x03(\v2\x0e.ptr.v2.TakingR\atakings2\x9a\x02\n" +
+	"\n" +
+	"PTRService\x12<\n" +
+	"\vGetSchedule\x12\x19.ptr.v2.ScheduleIDRequest\x1a\x10.ptr.v2.Schedule\"\x00\x12C\n" +
+	"\x0eUpdateSchedule\x12\x1d.ptr.v2.UpdateScheduleRequest\x1a\x10.ptr.v2.Schedule\"\x00\x12E\n" +
+	"\rListSchedules\x12\x1c.ptr.v2.ListSchedulesRequest\x1a\x14.ptr.v2.ScheduleList\"\x00\x12B\n" +
+	"\x0eGetNextTakings\x12\x1a.ptr.v2.NextTakingsRequest\x1a\x12.ptr.v2.TakingList\"\x00B4Z2pills-taking-reminder/internal/api/grpc/pb/v2;pbv2b\x06proto3"
+
+var (
+	file_api_proto_v2_pills_proto_rawDescOnce sync.Once
+	file_api_proto_v2_pills_proto_rawDescData []byte
+)
+
+func file_api_proto_v2_pills_proto_rawDescGZIP() []byte {
+	file_api_proto_v2_pills_proto_rawDescOnce.Do(func() {
+		file_api_proto_v2_pills_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_v2_pills_proto_rawDesc), len(file_api_proto_v2_pills_proto_rawDesc)))
+	})
+	return file_api_proto_v2_pills_proto_rawDescData
+}
+
+var file_api_proto_v2_pills_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_proto_v2_pills_proto_goTypes = []any{
+	(*TimeOfDay)(nil),             // 0: ptr.v2.TimeOfDay
+	(*ScheduleIDRequest)(nil),     // 1: ptr.v2.ScheduleIDRequest
+	(*NextTakingsRequest)(nil),    // 2: ptr.v2.NextTakingsRequest
+	(*UpdateScheduleRequest)(nil), // 3: ptr.v2.UpdateScheduleRequest
+	(*Schedule)(nil),              // 4: ptr.v2.Schedule
+	(*InteractionWarning)(nil),    // 5: ptr.v2.InteractionWarning
+	(*ListSchedulesRequest)(nil),  // 6: ptr.v2.ListSchedulesRequest
+	(*ScheduleList)(nil),          // 7: ptr.v2.ScheduleList
+	(*Taking)(nil),                // 8: ptr.v2.Taking
+	(*TakingList)(nil),            // 9: ptr.v2.TakingList
+	(*durationpb.Duration)(nil),   // 10: google.protobuf.Duration
+	(*date.Date)(nil),             // 11: google.type.Date
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+}
+var file_api_proto_v2_pills_proto_depIdxs = []int32{
+	10, // 0: ptr.v2.NextTakingsRequest.within:type_name -> google.protobuf.Duration
+	11, // 1: ptr.v2.Schedule.start_date:type_name -> google.type.Date
+	11, // 2: ptr.v2.Schedule.end_date:type_name -> google.type.Date
+	0,  // 3: ptr.v2.Schedule.taking_times:type_name -> ptr.v2.TimeOfDay
+	5,  // 4: ptr.v2.Schedule.warnings:type_name -> ptr.v2.InteractionWarning
+	11, // 5: ptr.v2.ListSchedulesRequest.from:type_name -> google.type.Date
+	11, // 6: ptr.v2.ListSchedulesRequest.to:type_name -> google.type.Date
+	4,  // 7: ptr.v2.ScheduleList.schedules:type_name -> ptr.v2.Schedule
+	12, // 8: ptr.v2.Taking.taking_time:type_name -> google.protobuf.Timestamp
+	8,  // 9: ptr.v2.TakingList.takings:type_name -> ptr.v2.Taking
+	1,  // 10: ptr.v2.PTRService.GetSchedule:input_type -> ptr.v2.ScheduleIDRequest
+	3,  // 11: ptr.v2.PTRService.UpdateSchedule:input_type -> ptr.v2.UpdateScheduleRequest
+	6,  // 12: ptr.v2.PTRService.ListSchedules:input_type -> ptr.v2.ListSchedulesRequest
+	2,  // 13: ptr.v2.PTRService.GetNextTakings:input_type -> ptr.v2.NextTakingsRequest
+	4,  // 14: ptr.v2.PTRService.GetSchedule:output_type -> ptr.v2.Schedule
+	4,  // 15: ptr.v2.PTRService.UpdateSchedule:output_type -> ptr.v2.Schedule
+	7,  // 16: ptr.v2.PTRService.ListSchedules:output_type -> ptr.v2.ScheduleList
+	9,  // 17: ptr.v2.PTRService.GetNextTakings:output_type -> ptr.v2.TakingList
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_v2_pills_proto_init() }
+func file_api_proto_v2_pills_proto_init() {
+	if File_api_proto_v2_pills_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_v2_pills_proto_rawDesc), len(file_api_proto_v2_pills_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_v2_pills_proto_goTypes,
+		DependencyIndexes: file_api_proto_v2_pills_proto_depIdxs,
+		MessageInfos:      file_api_proto_v2_pills_proto_msgTypes,
+	}.Build()
+	File_api_proto_v2_pills_proto = out.File
+	file_api_proto_v2_pills_proto_goTypes = nil
+	file_api_proto_v2_pills_proto_depIdxs = nil
+}
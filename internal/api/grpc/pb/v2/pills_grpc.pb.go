@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: api/proto/v2/pills.proto
+
+// ptr.v2 serves the schedules and the takings with typed dates instead of the
+// formatted strings of ptr.
+
+package pbv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PTRService_GetSchedule_FullMethodName    = "/ptr.v2.PTRService/GetSchedule"
+	PTRService_UpdateSchedule_FullMethodName = "/ptr.v2.PTRService/UpdateSchedule"
+	PTRService_ListSchedules_FullMethodName  = "/ptr.v2.PTRService/ListSchedules"
+	PTRService_GetNextTakings_FullMethodName = "/ptr.v2.PTRService/GetNextTakings"
+)
+
+// PTRServiceClient is the client API for PTRService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PTRServiceClient interface {
+	GetSchedule(ctx context.Context, in *ScheduleIDRequest, opts ...grpc.CallOption) (*Schedule, error)
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ScheduleList, error)
+	GetNextTakings(ctx context.Context, in *NextTakingsRequest, opts ...grpc.CallOption) (*TakingList, error)
+}
+
+type pTRServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPTRServiceClient(cc grpc.ClientConnInterface) PTRServiceClient {
+	return &pTRServiceClient{cc}
+}
+
+func (c *pTRServiceClient) GetSchedule(ctx context.Context, in *ScheduleIDRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, PTRService_GetSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, PTRService_UpdateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ScheduleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleList)
+	err := c.cc.Invoke(ctx, PTRService_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pTRServiceClient) GetNextTakings(ctx context.Context, in *NextTakingsRequest, opts ...grpc.CallOption) (*TakingList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TakingList)
+	err := c.cc.Invoke(ctx, PTRService_GetNextTakings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PTRServiceServer is the server API for PTRService service.
+// All implementations must embed UnimplementedPTRServiceServer
+// for forward compatibility.
+type PTRServiceServer interface {
+	GetSchedule(context.Context, *ScheduleIDRequest) (*Schedule, error)
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*Schedule, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ScheduleList, error)
+	GetNextTakings(context.Context, *NextTakingsRequest) (*TakingList, error)
+	mustEmbedUnimplementedPTRServiceServer()
+}
+
+// UnimplementedPTRServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPTRServiceServer struct{}
+
+func (UnimplementedPTRServiceServer) GetSchedule(context.Context, *ScheduleIDRequest) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedule not implemented")
+}
+func (UnimplementedPTRServiceServer) UpdateSchedule(context.Context, *UpdateScheduleRequest) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (UnimplementedPTRServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ScheduleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedPTRServiceServer) GetNextTakings(context.Context, *NextTakingsRequest) (*TakingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextTakings not implemented")
+}
+func (UnimplementedPTRServiceServer) mustEmbedUnimplementedPTRServiceServer() {}
+func (UnimplementedPTRServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafePTRServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PTRServiceServer will
+// result in compilation errors.
+type UnsafePTRServiceServer interface {
+	mustEmbedUnimplementedPTRServiceServer()
+}
+
+func RegisterPTRServiceServer(s grpc.ServiceRegistrar, srv PTRServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPTRServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PTRService_ServiceDesc, srv)
+}
+
+func _PTRService_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetSchedule(ctx, req.(*ScheduleIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_UpdateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PTRService_GetNextTakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextTakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PTRServiceServer).GetNextTakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PTRService_GetNextTakings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PTRServiceServer).GetNextTakings(ctx, req.(*NextTakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PTRService_ServiceDesc is the grpc.ServiceDesc for PTRService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PTRService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ptr.v2.PTRService",
+	HandlerType: (*PTRServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchedule",
+			Handler:    _PTRService_GetSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _PTRService_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _PTRService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "GetNextTakings",
+			Handler:    _PTRService_GetNextTakings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/v2/pills.proto",
+}
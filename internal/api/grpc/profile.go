@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) SetProfile(ctx context.Context, req *pb.ProfileRequest) (*pb.ProfileResponse, error) {
+	s.logger.Info("got SetProfile request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.String("locale", req.Locale))
+
+	profile, err := s.profileUseCase.SetProfile(ctx, usecase.ProfileInput{
+		UserID: req.UserId,
+		Locale: req.Locale,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("profile setting request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("profile setting request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to set profile in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.ProfileResponse{
+		UserId: profile.UserID,
+		Locale: profile.Locale,
+	}, nil
+}
+
+func (s *GRPCServer) GetProfile(ctx context.Context, req *pb.UserIDRequest) (*pb.ProfileResponse, error) {
+	s.logger.Info("got GetProfile request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	profile, err := s.profileUseCase.GetProfile(ctx, req.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("request for getting profile rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting profile rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to get profile in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.ProfileResponse{
+		UserId: profile.UserID,
+		Locale: profile.Locale,
+	}, nil
+}
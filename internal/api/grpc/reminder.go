@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) SnoozeTaking(ctx context.Context, req *pb.SnoozeRequest) (*pb.TakingStateResponse, error) {
+	s.logger.Info("got SnoozeTaking request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("schedule_id", req.ScheduleId),
+		slog.String("planned_at", req.PlannedAt))
+
+	plannedAt, err := time.Parse(time.RFC3339, req.PlannedAt)
+	if err != nil {
+		s.logger.Debug("snooze request rejected in gRPC", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+	}
+
+	input := usecase.SnoozeInput{
+		UserID:     req.UserId,
+		ScheduleID: req.ScheduleId,
+		PlannedAt:  plannedAt,
+	}
+
+	taking, err := s.reminderUseCase.SnoozeTaking(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("snooze request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("snooze request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrTakingNotPlanned):
+			s.logger.Debug("snooze request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Taking is not planned at this time")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			s.logger.Debug("snooze request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrSnoozeLimit):
+			s.logger.Debug("snooze request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.FailedPrecondition, "Snooze limit reached, taking marked as missed")
+		case errors.Is(err, usecase.ErrTakingClosed):
+			s.logger.Debug("snooze request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.FailedPrecondition, "Taking is already closed")
+		default:
+			s.logger.Error("failed to snooze taking in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	response := &pb.TakingStateResponse{
+		ScheduleId:   taking.ScheduleID,
+		MedicineName: taking.MedicineName,
+		PlannedAt:    taking.PlannedAt.Format(time.RFC3339),
+		Status:       taking.Status,
+		SnoozeCount:  int32(taking.SnoozeCount),
+	}
+	if taking.SnoozedUntil != nil {
+		response.SnoozedUntil = taking.SnoozedUntil.Format(time.RFC3339)
+	}
+
+	return response, nil
+}
+
+func (s *GRPCServer) SetEscalationRule(ctx context.Context, req *pb.EscalationRequest) (*pb.EscalationResponse, error) {
+	s.logger.Info("got SetEscalationRule request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("schedule_id", req.ScheduleId))
+
+	input := usecase.EscalationInput{
+		UserID:           req.UserId,
+		ScheduleID:       req.ScheduleId,
+		GracePeriod:      time.Duration(req.GraceMinutes) * time.Minute,
+		CaregiverDelay:   time.Duration(req.CaregiverDelayMinutes) * time.Minute,
+		NotifyCaregivers: req.NotifyCaregivers,
+	}
+
+	rule, err := s.reminderUseCase.SetEscalationRule(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("escalation rule request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("escalation rule request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			s.logger.Debug("escalation rule request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		default:
+			s.logger.Error("failed to set escalation rule in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return escalationResponse(rule), nil
+}
+
+func (s *GRPCServer) GetEscalationRule(ctx context.Context, req *pb.ScheduleIDRequest) (*pb.EscalationResponse, error) {
+	s.logger.Info("got GetEscalationRule request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("schedule_id", req.ScheduleId))
+
+	rule, err := s.reminderUseCase.GetEscalationRule(ctx, req.UserId, req.ScheduleId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("request for getting escalation rule rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting escalation rule rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			s.logger.Debug("request for getting escalation rule rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		default:
+			s.logger.Error("failed to get escalation rule in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return escalationResponse(rule), nil
+}
+
+func escalationResponse(rule *usecase.EscalationOutput) *pb.EscalationResponse {
+	return &pb.EscalationResponse{
+		ScheduleId:            rule.ScheduleID,
+		GraceMinutes:          int32(rule.GracePeriod / time.Minute),
+		CaregiverDelayMinutes: int32(rule.CaregiverDelay / time.Minute),
+		NotifyCaregivers:      rule.NotifyCaregivers,
+	}
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"pills-taking-reminder/internal/api/grpc/pb"
+	"pills-taking-reminder/internal/domain/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *GRPCServer) SetRole(ctx context.Context, req *pb.RoleRequest) (*pb.RoleResponse, error) {
+	s.logger.Info("got SetRole request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.String("role", req.Role))
+
+	role, err := s.roleUseCase.SetRole(ctx, req.UserId, req.Role)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("role setting request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("role setting request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to set role in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.RoleResponse{
+		UserId: role.UserID,
+		Role:   role.Role,
+	}, nil
+}
+
+func (s *GRPCServer) GetRole(ctx context.Context, req *pb.UserIDRequest) (*pb.RoleResponse, error) {
+	s.logger.Info("got GetRole request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	role, err := s.roleUseCase.GetRole(ctx, req.UserId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("request for getting role rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting role rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to get role in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return &pb.RoleResponse{
+		UserId: role.UserID,
+		Role:   role.Role,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"pills-taking-reminder/internal/api/grpc/pb"
+	pbv2 "pills-taking-reminder/internal/api/grpc/pb/v2"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+// scopes lists the API key scope each method requires. Methods missing here,
+// like the management of API keys, are not available to API keys.
+var scopes = map[string]string{
+	pb.PTRService_CreateSchedule_FullMethodName:                  string(entities.ScopeSchedulesWrite),
+	pb.PTRService_UpdateSchedule_FullMethodName:                  string(entities.ScopeSchedulesWrite),
+	pb.PTRService_SetSchedulePaused_FullMethodName:               string(entities.ScopeSchedulesWrite),
+	pb.PTRService_GetSchedule_FullMethodName:                     string(entities.ScopeSchedulesRead),
+	pb.PTRService_GetSchedulesIDs_FullMethodName:                 string(entities.ScopeSchedulesRead),
+	pb.PTRService_GetNextTakings_FullMethodName:                  string(entities.ScopeSchedulesRead),
+	pb.PTRService_RecordRefill_FullMethodName:                    string(entities.ScopeInventoryWrite),
+	pb.PTRService_GetInventory_FullMethodName:                    string(entities.ScopeInventoryRead),
+	pb.PTRService_SnoozeTaking_FullMethodName:                    string(entities.ScopeRemindersWrite),
+	pb.PTRService_RecordIntake_FullMethodName:                    string(entities.ScopeIntakesWrite),
+	pb.PTRService_LinkCaregiver_FullMethodName:                   string(entities.ScopeCaregiversWrite),
+	pb.PTRService_UpdateCaregiver_FullMethodName:                 string(entities.ScopeCaregiversWrite),
+	pb.PTRService_UnlinkCaregiver_FullMethodName:                 string(entities.ScopeCaregiversWrite),
+	pb.PTRService_GetCaregivers_FullMethodName:                   string(entities.ScopeCaregiversRead),
+	pb.PTRService_GetDependants_FullMethodName:                   string(entities.ScopeCaregiversRead),
+	pb.PTRService_SetEscalationRule_FullMethodName:               string(entities.ScopeRemindersWrite),
+	pb.PTRService_GetEscalationRule_FullMethodName:               string(entities.ScopeRemindersRead),
+	pb.PTRService_GetScheduleHistory_FullMethodName:              string(entities.ScopeSchedulesRead),
+	pb.PTRService_ListSchedules_FullMethodName:                   string(entities.ScopeSchedulesRead),
+	pb.PTRService_GetCalendar_FullMethodName:                     string(entities.ScopeSchedulesRead),
+	pb.PTRService_ExportSchedulesICS_FullMethodName:              string(entities.ScopeSchedulesRead),
+	pb.PTRService_ImportSchedulesICS_FullMethodName:              string(entities.ScopeSchedulesWrite),
+	pb.PTRService_ExportUserData_FullMethodName:                  string(entities.ScopeSchedulesRead),
+	pb.PTRService_ImportUserData_FullMethodName:                  string(entities.ScopeSchedulesWrite),
+	pb.PTRService_ImportMedicationRequest_FullMethodName:         string(entities.ScopeSchedulesWrite),
+	pb.PTRService_CreateSchedules_FullMethodName:                 string(entities.ScopeSchedulesWrite),
+	pb.PTRService_ExportMedicationStatements_FullMethodName:      string(entities.ScopeSchedulesRead),
+	pb.PTRService_ExportMedicationAdministrations_FullMethodName: string(entities.ScopeSchedulesRead),
+	pb.PTRService_SearchMedicines_FullMethodName:                 string(entities.ScopeSchedulesRead),
+	pb.PTRService_SetDoseLimit_FullMethodName:                    string(entities.ScopeSchedulesWrite),
+	pb.PTRService_GetDoseLimits_FullMethodName:                   string(entities.ScopeSchedulesRead),
+	pb.PTRService_DeleteDoseLimit_FullMethodName:                 string(entities.ScopeSchedulesWrite),
+
+	pbv2.PTRService_GetSchedule_FullMethodName:    string(entities.ScopeSchedulesRead),
+	pbv2.PTRService_UpdateSchedule_FullMethodName: string(entities.ScopeSchedulesWrite),
+	pbv2.PTRService_ListSchedules_FullMethodName:  string(entities.ScopeSchedulesRead),
+	pbv2.PTRService_GetNextTakings_FullMethodName: string(entities.ScopeSchedulesRead),
+}
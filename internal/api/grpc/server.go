@@ -7,9 +7,16 @@ import (
 	"log/slog"
 	"net"
 	"pills-taking-reminder/internal/api/grpc/pb"
+	pbv2 "pills-taking-reminder/internal/api/grpc/pb/v2"
+	"pills-taking-reminder/internal/domain/entities"
 	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/i18n"
 	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+	"time"
 
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
@@ -18,47 +25,156 @@ import (
 
 type GRPCServer struct {
 	pb.UnimplementedPTRServiceServer
-	scheduleUseCase *usecase.ScheduleUseCase
-	logger          *slog.Logger
-	server          *grpc.Server
+	scheduleUseCase    *usecase.ScheduleUseCase
+	inventoryUseCase   *usecase.InventoryUseCase
+	reminderUseCase    *usecase.ReminderUseCase
+	intakeUseCase      *usecase.IntakeUseCase
+	caregiverUseCase   *usecase.CaregiverUseCase
+	apiKeyUseCase      *usecase.APIKeyUseCase
+	roleUseCase        *usecase.RoleUseCase
+	calendarUseCase    *usecase.CalendarUseCase
+	exportUseCase      *usecase.ExportUseCase
+	medicineUseCase    *usecase.MedicineUseCase
+	profileUseCase     *usecase.ProfileUseCase
+	doseLimitUseCase   *usecase.DoseLimitUseCase
+	idempotencyUseCase *usecase.IdempotencyUseCase
+	authenticator      mw.Authenticator
+	logger             *slog.Logger
+	validate           *validator.Validate
+	server             *grpc.Server
 }
 
-func NewGRPCServer(useCase *usecase.ScheduleUseCase, logger *slog.Logger) *GRPCServer {
+func NewGRPCServer(useCases usecase.UseCases, logger *slog.Logger) *GRPCServer {
 	return &GRPCServer{
-		scheduleUseCase: useCase,
-		logger:          logger,
+		scheduleUseCase:    useCases.Schedule,
+		inventoryUseCase:   useCases.Inventory,
+		reminderUseCase:    useCases.Reminder,
+		intakeUseCase:      useCases.Intake,
+		caregiverUseCase:   useCases.Caregiver,
+		apiKeyUseCase:      useCases.APIKey,
+		roleUseCase:        useCases.Role,
+		calendarUseCase:    useCases.Calendar,
+		exportUseCase:      useCases.Export,
+		medicineUseCase:    useCases.Medicine,
+		profileUseCase:     useCases.Profile,
+		doseLimitUseCase:   useCases.DoseLimit,
+		idempotencyUseCase: useCases.Idempotency,
+		logger:             logger,
+		validate:           newValidator(),
 	}
 }
 
+// newValidator returns the validator of the requests, the rules match the
+// ones of the HTTP API.
+func newValidator() *validator.Validate {
+	validate := problem.NewValidator()
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"MedicineName": "required_without=MedicineId",
+		"Frequency":    "required,gte=1,lte=15",
+		"Duration":     "gte=0",
+		"UserId":       "required,gte=1",
+		"Dose":         "gte=0",
+	}, pb.ScheduleRequest{})
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"ScheduleId":   "required,gte=1",
+		"MedicineName": "required_without=MedicineId",
+		"Frequency":    "required,gte=1,lte=15",
+		"Duration":     "gte=0",
+		"UserId":       "required,gte=1",
+		"Dose":         "gte=0",
+	}, pb.UpdateScheduleRequest{}, pbv2.UpdateScheduleRequest{})
+	validate.RegisterStructValidationMapRules(map[string]string{
+		"ScheduleId": "required,gte=1",
+		"UserId":     "required,gte=1",
+	}, pb.SchedulePauseRequest{})
+	return validate
+}
+
+// invalidRequest returns the error of a request failing the validation. The
+// fields are described in English like the message, clients show the
+// LocalizedMessage detail.
+func (s *GRPCServer) invalidRequest(ctx context.Context, err error) error {
+	return problem.Status(codes.InvalidArgument, problem.CodeValidationFailed, "Invalid input parameters",
+		mw.GetTraceID(ctx), problem.FieldErrors(err, i18n.Default)).Err()
+}
+
+// separationError names the schedule whose takings the taking times can't be
+// kept apart from in a PreconditionFailure detail.
+func separationError(ctx context.Context, err error) error {
+	st := problem.Status(codes.FailedPrecondition, problem.CodeSeparationUnsatisfiable, "Taking times can't be kept apart",
+		mw.GetTraceID(ctx), nil)
+	var separationErr *entities.SeparationError
+	if errors.As(err, &separationErr) {
+		violation := &errdetails.PreconditionFailure_Violation{
+			Type:        "SEPARATION",
+			Subject:     fmt.Sprintf("schedules/%d", separationErr.Constraint.ScheduleID),
+			Description: separationErr.Error(),
+		}
+		detailed, detailsErr := st.WithDetails(&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{violation},
+		})
+		if detailsErr == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
+
 func (s *GRPCServer) CreateSchedule(ctx context.Context, req *pb.ScheduleRequest) (*pb.ScheduleIDResponse, error) {
 	s.logger.Info("got schedule creating request in grpc",
 		slog.String("medicine", req.MedicineName),
 		slog.Int("frequency", int(req.Frequency)),
 		slog.Int64("user_id", req.UserId))
 
+	if err := s.validate.Struct(req); err != nil {
+		s.logger.Debug("schedule creation request rejected in gRPC", slog.String("error", err.Error()))
+		return nil, s.invalidRequest(ctx, err)
+	}
+
 	input := usecase.ScheduleInput{
-		MedicineName: req.MedicineName,
-		Frequency:    int(req.Frequency),
-		Duration:     int(req.Duration),
-		UserID:       req.UserId,
+		MedicineName:         req.MedicineName,
+		MedicineID:           req.MedicineId,
+		Frequency:            int(req.Frequency),
+		Duration:             int(req.Duration),
+		UserID:               req.UserId,
+		Dose:                 req.Dose,
+		OverrideInteractions: req.OverrideInteractions,
 	}
 
-	id, err := s.scheduleUseCase.CreateSchedule(ctx, input)
+	id, warnings, err := s.scheduleUseCase.CreateSchedule(ctx, input)
 	if err != nil {
 		switch {
 		case errors.Is(err, usecase.ErrInvalidInput):
 			s.logger.Debug("schedule creation request rejected in gRPC", slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("schedule creation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
 		case errors.Is(err, usecase.ErrScheduleExists):
 			s.logger.Debug("schedule creation request rejected in gRPC", slog.String("error", err.Error()))
-			return nil, status.Error(codes.AlreadyExists, "Schedule already exists")
+			return nil, problem.Status(codes.AlreadyExists, problem.CodeScheduleExists, "Schedule already exists", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrMedicineNotFound):
+			s.logger.Debug("schedule creation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.NotFound, problem.CodeMedicineNotFound, "Medicine was not found", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrContraindicated):
+			s.logger.Debug("schedule creation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeInteractionContraindicated,
+				"Medicine is contraindicated with another one", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			s.logger.Debug("schedule creation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeDailyDoseExceeded, "Daily dose exceeds the limit", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			s.logger.Debug("schedule creation request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, separationError(ctx, err)
 		default:
 			s.logger.Error("failed to create schedule in gRPC", slog.String("error", err.Error()))
 			return nil, status.Error(codes.Internal, "Internal server error")
 		}
 	}
+
 	return &pb.ScheduleIDResponse{
 		ScheduleId: id,
+		Warnings:   interactionWarnings(warnings),
 	}, nil
 }
 
@@ -73,6 +189,9 @@ func (s *GRPCServer) GetSchedulesIDs(ctx context.Context, req *pb.UserIDRequest)
 		case errors.Is(err, usecase.ErrInvalidInput):
 			s.logger.Debug("request for getting schedule IDs rejected in gRPC", slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting schedule IDs rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
 		default:
 			s.logger.Error("failed to get schedule IDs in gRPC", slog.String("error", err.Error()))
 			return nil, status.Error(codes.Internal, "Internal server error")
@@ -83,17 +202,84 @@ func (s *GRPCServer) GetSchedulesIDs(ctx context.Context, req *pb.UserIDRequest)
 	}, nil
 }
 
-func (s *GRPCServer) GetNextTakings(ctx context.Context, req *pb.UserIDRequest) (*pb.TakingList, error) {
+func (s *GRPCServer) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.ScheduleList, error) {
+	s.logger.Info("got ListSchedules request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	input := usecase.ListSchedulesInput{
+		UserID:         req.UserId,
+		Status:         req.Status,
+		MedicinePrefix: req.MedicinePrefix,
+		Sort:           req.Sort,
+		Descending:     req.Descending,
+		Limit:          int(req.Limit),
+		Cursor:         req.Cursor,
+	}
+	from, err := parseDate(req.From)
+	if err != nil {
+		s.logger.Debug("request for listing schedules rejected in gRPC", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+	}
+	to, err := parseDate(req.To)
+	if err != nil {
+		s.logger.Debug("request for listing schedules rejected in gRPC", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+	}
+	input.From, input.To = from, to
+
+	list, err := s.scheduleUseCase.ListSchedules(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("request for listing schedules rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for listing schedules rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			s.logger.Error("failed to list schedules in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	schedules := make([]*pb.ScheduleResponse, len(list.Schedules))
+	for i := range list.Schedules {
+		schedules[i] = scheduleResponse(&list.Schedules[i])
+	}
+
+	return &pb.ScheduleList{
+		Schedules:  schedules,
+		NextCursor: list.NextCursor,
+	}, nil
+}
+
+func (s *GRPCServer) GetNextTakings(ctx context.Context, req *pb.NextTakingsRequest) (*pb.TakingList, error) {
 	s.logger.Info("got GetNextTakings request in grpc",
 		slog.Int64("user_id", req.UserId))
 
-	takings, err := s.scheduleUseCase.GetNextTakings(ctx, req.UserId)
+	input := usecase.NextTakingsInput{
+		UserID: req.UserId,
+		Limit:  int(req.Limit),
+	}
+	if req.Within != "" {
+		within, err := time.ParseDuration(req.Within)
+		if err != nil {
+			s.logger.Debug("request for getting next takings rejected in grpc", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		}
+		input.Within = within
+	}
+
+	takings, err := s.scheduleUseCase.GetNextTakings(ctx, input)
 
 	if err != nil {
 		switch {
 		case errors.Is(err, usecase.ErrInvalidInput):
 			s.logger.Debug("request for getting next takings rejected in grpc", slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting next takings rejected in grpc", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
 		default:
 			s.logger.Error("error getting next takings in grpc", slog.String("error", err.Error()))
 			return nil, status.Error(codes.Internal, "Internal server error")
@@ -105,6 +291,7 @@ func (s *GRPCServer) GetNextTakings(ctx context.Context, req *pb.UserIDRequest)
 		pbTakings[i] = &pb.Taking{
 			MedicineName: taking.MedicineName,
 			TakingTime:   taking.TakingTime,
+			TakingDate:   taking.At.Format(time.DateOnly),
 		}
 	}
 
@@ -129,20 +316,180 @@ func (s *GRPCServer) GetSchedule(ctx context.Context, req *pb.ScheduleIDRequest)
 		case errors.Is(err, usecase.ErrInvalidInput):
 			s.logger.Debug("request for getting schedule rejected in gRPC", slog.String("error", err.Error()))
 			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("request for getting schedule rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
 		default:
 			s.logger.Error("failed to get schedule in gRPC", slog.String("error", err.Error()))
 			return nil, status.Error(codes.Internal, "Internal server error")
 		}
 	}
 
+	return scheduleResponse(schedule), nil
+}
+
+func (s *GRPCServer) UpdateSchedule(ctx context.Context, req *pb.UpdateScheduleRequest) (*pb.ScheduleResponse, error) {
+	s.logger.Info("got UpdateSchedule request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("schedule_id", req.ScheduleId))
+
+	if err := s.validate.Struct(req); err != nil {
+		s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+		return nil, s.invalidRequest(ctx, err)
+	}
+
+	input := usecase.UpdateScheduleInput{
+		ScheduleID:           req.ScheduleId,
+		MedicineName:         req.MedicineName,
+		MedicineID:           req.MedicineId,
+		Frequency:            int(req.Frequency),
+		Duration:             int(req.Duration),
+		UserID:               req.UserId,
+		Version:              req.Version,
+		Dose:                 req.Dose,
+		OverrideInteractions: req.OverrideInteractions,
+	}
+
+	schedule, err := s.scheduleUseCase.UpdateSchedule(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrMedicineNotFound):
+			s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.NotFound, problem.CodeMedicineNotFound, "Medicine was not found", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrScheduleExists):
+			s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.AlreadyExists, problem.CodeScheduleExists, "Schedule already exists", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrVersionRequired):
+			s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeVersionRequired, "Schedule version is required", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrScheduleModified):
+			s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeScheduleModified,
+				"Schedule was modified since the given version", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrContraindicated):
+			s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeInteractionContraindicated,
+				"Medicine is contraindicated with another one", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeDailyDoseExceeded, "Daily dose exceeds the limit", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, separationError(ctx, err)
+		default:
+			s.logger.Error("failed to update schedule in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return scheduleResponse(schedule), nil
+}
+
+func (s *GRPCServer) SetSchedulePaused(ctx context.Context, req *pb.SchedulePauseRequest) (*pb.ScheduleResponse, error) {
+	s.logger.Info("got SetSchedulePaused request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("schedule_id", req.ScheduleId),
+		slog.Bool("paused", req.Paused))
+
+	if err := s.validate.Struct(req); err != nil {
+		s.logger.Debug("schedule pause request rejected in gRPC", slog.String("error", err.Error()))
+		return nil, s.invalidRequest(ctx, err)
+	}
+
+	schedule, err := s.scheduleUseCase.SetSchedulePaused(ctx, usecase.PauseScheduleInput{
+		ScheduleID:           req.ScheduleId,
+		UserID:               req.UserId,
+		Paused:               req.Paused,
+		OverrideInteractions: req.OverrideInteractions,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			s.logger.Debug("schedule pause request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			s.logger.Debug("schedule pause request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			s.logger.Debug("schedule pause request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrScheduleModified):
+			s.logger.Debug("schedule pause request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.Aborted, problem.CodeScheduleModified,
+				"Schedule was modified since the given version", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrContraindicated):
+			s.logger.Debug("schedule pause request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeInteractionContraindicated,
+				"Medicine is contraindicated with another one", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			s.logger.Debug("schedule pause request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeDailyDoseExceeded, "Daily dose exceeds the limit", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			s.logger.Debug("schedule pause request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, separationError(ctx, err)
+		default:
+			s.logger.Error("failed to pause schedule in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return scheduleResponse(schedule), nil
+}
+
+// parseDate parses an optional date in the YYYY-MM-DD format.
+func parseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+func scheduleResponse(schedule *usecase.ScheduleOutput) *pb.ScheduleResponse {
 	return &pb.ScheduleResponse{
 		Id:           schedule.ID,
 		MedicineName: schedule.MedicineName,
+		MedicineId:   schedule.MedicineID,
 		StartDate:    schedule.StartDate,
 		EndDate:      schedule.EndDate,
 		UserId:       schedule.UserID,
 		TakingTime:   schedule.TakingTimes,
-	}, nil
+		Version:      schedule.Version,
+		Dose:         schedule.Dose,
+		Warnings:     interactionWarnings(schedule.Warnings),
+		Paused:       schedule.Paused,
+	}
+}
+
+func interactionWarnings(warnings []usecase.InteractionWarning) []*pb.InteractionWarning {
+	response := make([]*pb.InteractionWarning, len(warnings))
+	for i, warning := range warnings {
+		response[i] = &pb.InteractionWarning{
+			ScheduleId:   warning.ScheduleID,
+			MedicineId:   warning.MedicineID,
+			MedicineName: warning.MedicineName,
+			Severity:     string(warning.Severity),
+			Description:  warning.Description,
+		}
+	}
+	return response
+}
+
+// SetAuthenticator makes the server reject requests without valid credentials.
+func (s *GRPCServer) SetAuthenticator(authenticator mw.Authenticator) {
+	s.authenticator = authenticator
 }
 
 func (s *GRPCServer) Run(addr string) error {
@@ -151,11 +498,38 @@ func (s *GRPCServer) Run(addr string) error {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		mw.UnaryServerInterceptor(s.logger),
+		mw.UnaryErrorDetailsInterceptor(),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{mw.StreamServerInterceptor(s.logger)}
+	if s.authenticator != nil {
+		unaryInterceptors = append(unaryInterceptors,
+			mw.UnaryAuthInterceptor(s.authenticator, s.logger),
+			mw.UnaryScopeInterceptor(scopes, s.logger))
+		streamInterceptors = append(streamInterceptors,
+			mw.StreamAuthInterceptor(s.authenticator, s.logger),
+			mw.StreamScopeInterceptor(scopes, s.logger))
+	}
+
+	// The locale is selected for the actor, so after the authentication, and
+	// the localized message is not stored with the idempotent response.
+	var profiles mw.LocaleStore
+	if s.profileUseCase != nil {
+		profiles = s.profileUseCase
+	}
+	unaryInterceptors = append(unaryInterceptors, mw.UnaryLocaleInterceptor(profiles, s.logger))
+
+	if s.idempotencyUseCase != nil {
+		unaryInterceptors = append(unaryInterceptors, mw.UnaryIdempotencyInterceptor(s.idempotencyUseCase, s.logger))
+	}
+
 	s.server = grpc.NewServer(
-		grpc.UnaryInterceptor(mw.UnaryServerInterceptor(s.logger)),
-		grpc.StreamInterceptor(mw.StreamServerInterceptor(s.logger)))
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...))
 
 	pb.RegisterPTRServiceServer(s.server, s)
+	pbv2.RegisterPTRServiceServer(s.server, &serverV2{s: s})
 
 	reflection.Register(s.server)
 
@@ -0,0 +1,269 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	pbv2 "pills-taking-reminder/internal/api/grpc/pb/v2"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+	"time"
+
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// serverV2 serves the ptr.v2 package, the schedules and the takings of ptr
+// with typed dates.
+type serverV2 struct {
+	pbv2.UnimplementedPTRServiceServer
+	s *GRPCServer
+}
+
+func (v *serverV2) GetSchedule(ctx context.Context, req *pbv2.ScheduleIDRequest) (*pbv2.Schedule, error) {
+	v.s.logger.Info("got GetSchedule v2 request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("schedule_id", req.ScheduleId))
+
+	schedule, err := v.s.scheduleUseCase.GetSchedule(ctx, req.UserId, req.ScheduleId)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			v.s.logger.Debug("request for getting schedule rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrInvalidInput):
+			v.s.logger.Debug("request for getting schedule rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			v.s.logger.Debug("request for getting schedule rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			v.s.logger.Error("failed to get schedule in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return scheduleV2(schedule), nil
+}
+
+func (v *serverV2) UpdateSchedule(ctx context.Context, req *pbv2.UpdateScheduleRequest) (*pbv2.Schedule, error) {
+	v.s.logger.Info("got UpdateSchedule v2 request in grpc",
+		slog.Int64("user_id", req.UserId),
+		slog.Int64("schedule_id", req.ScheduleId))
+
+	if err := v.s.validate.Struct(req); err != nil {
+		v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+		return nil, v.s.invalidRequest(ctx, err)
+	}
+
+	input := usecase.UpdateScheduleInput{
+		ScheduleID:           req.ScheduleId,
+		MedicineName:         req.MedicineName,
+		MedicineID:           req.MedicineId,
+		Frequency:            int(req.Frequency),
+		Duration:             int(req.Duration),
+		UserID:               req.UserId,
+		Version:              req.Version,
+		Dose:                 req.Dose,
+		OverrideInteractions: req.OverrideInteractions,
+	}
+
+	schedule, err := v.s.scheduleUseCase.UpdateSchedule(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.NotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrMedicineNotFound):
+			v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.NotFound, problem.CodeMedicineNotFound, "Medicine was not found", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrScheduleExists):
+			v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.AlreadyExists, problem.CodeScheduleExists, "Schedule already exists", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrVersionRequired):
+			v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeVersionRequired, "Schedule version is required", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrScheduleModified):
+			v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeScheduleModified,
+				"Schedule was modified since the given version", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrContraindicated):
+			v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeInteractionContraindicated,
+				"Medicine is contraindicated with another one", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, problem.Status(codes.FailedPrecondition, problem.CodeDailyDoseExceeded, "Daily dose exceeds the limit", mw.GetTraceID(ctx), nil).Err()
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			v.s.logger.Debug("schedule update request rejected in gRPC", slog.String("error", err.Error()))
+			return nil, separationError(ctx, err)
+		default:
+			v.s.logger.Error("failed to update schedule in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	return scheduleV2(schedule), nil
+}
+
+func (v *serverV2) ListSchedules(ctx context.Context, req *pbv2.ListSchedulesRequest) (*pbv2.ScheduleList, error) {
+	v.s.logger.Info("got ListSchedules v2 request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	input := usecase.ListSchedulesInput{
+		UserID:         req.UserId,
+		Status:         req.Status,
+		MedicinePrefix: req.MedicinePrefix,
+		Sort:           req.Sort,
+		Descending:     req.Descending,
+		Limit:          int(req.Limit),
+		Cursor:         req.Cursor,
+	}
+	from, err := dateValue(req.From)
+	if err != nil {
+		v.s.logger.Debug("request for listing schedules rejected in gRPC", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+	}
+	to, err := dateValue(req.To)
+	if err != nil {
+		v.s.logger.Debug("request for listing schedules rejected in gRPC", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+	}
+	input.From, input.To = from, to
+
+	list, err := v.s.scheduleUseCase.ListSchedules(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			v.s.logger.Debug("request for listing schedules rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			v.s.logger.Debug("request for listing schedules rejected in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			v.s.logger.Error("failed to list schedules in gRPC", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	schedules := make([]*pbv2.Schedule, len(list.Schedules))
+	for i := range list.Schedules {
+		schedules[i] = scheduleV2(&list.Schedules[i])
+	}
+
+	return &pbv2.ScheduleList{
+		Schedules:  schedules,
+		NextCursor: list.NextCursor,
+	}, nil
+}
+
+func (v *serverV2) GetNextTakings(ctx context.Context, req *pbv2.NextTakingsRequest) (*pbv2.TakingList, error) {
+	v.s.logger.Info("got GetNextTakings v2 request in grpc",
+		slog.Int64("user_id", req.UserId))
+
+	input := usecase.NextTakingsInput{
+		UserID: req.UserId,
+		Limit:  int(req.Limit),
+	}
+	if req.Within != nil {
+		if err := req.Within.CheckValid(); err != nil {
+			v.s.logger.Debug("request for getting next takings rejected in grpc", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		}
+		input.Within = req.Within.AsDuration()
+	}
+
+	takings, err := v.s.scheduleUseCase.GetNextTakings(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			v.s.logger.Debug("request for getting next takings rejected in grpc", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			v.s.logger.Debug("request for getting next takings rejected in grpc", slog.String("error", err.Error()))
+			return nil, status.Error(codes.PermissionDenied, "Permission denied")
+		default:
+			v.s.logger.Error("error getting next takings in grpc", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+
+	pbTakings := make([]*pbv2.Taking, len(takings))
+	for i, taking := range takings {
+		pbTakings[i] = &pbv2.Taking{
+			MedicineName: taking.MedicineName,
+			TakingTime:   timestamppb.New(taking.At),
+		}
+	}
+
+	return &pbv2.TakingList{
+		Takings: pbTakings,
+	}, nil
+}
+
+func scheduleV2(schedule *usecase.ScheduleOutput) *pbv2.Schedule {
+	response := &pbv2.Schedule{
+		Id:           schedule.ID,
+		MedicineName: schedule.MedicineName,
+		MedicineId:   schedule.MedicineID,
+		StartDate:    dateMessage(schedule.Start),
+		UserId:       schedule.UserID,
+		TakingTimes:  make([]*pbv2.TimeOfDay, len(schedule.Times)),
+		Version:      schedule.Version,
+		Dose:         schedule.Dose,
+		Paused:       schedule.Paused,
+	}
+	if schedule.End != nil {
+		response.EndDate = dateMessage(*schedule.End)
+	}
+	for i, takingTime := range schedule.Times {
+		response.TakingTimes[i] = &pbv2.TimeOfDay{
+			Hours:   int32(takingTime.Hour()),
+			Minutes: int32(takingTime.Minute()),
+		}
+	}
+	for _, warning := range schedule.Warnings {
+		response.Warnings = append(response.Warnings, &pbv2.InteractionWarning{
+			ScheduleId:   warning.ScheduleID,
+			MedicineId:   warning.MedicineID,
+			MedicineName: warning.MedicineName,
+			Severity:     string(warning.Severity),
+			Description:  warning.Description,
+		})
+	}
+	return response
+}
+
+func dateMessage(day time.Time) *date.Date {
+	return &date.Date{
+		Year:  int32(day.Year()),
+		Month: int32(day.Month()),
+		Day:   int32(day.Day()),
+	}
+}
+
+// dateValue returns the optional date of the message, rejecting the partial
+// dates and the days which do not exist.
+func dateValue(message *date.Date) (*time.Time, error) {
+	if message == nil {
+		return nil, nil
+	}
+	if message.Year == 0 {
+		return nil, fmt.Errorf("date %d-%d has no year", message.Month, message.Day)
+	}
+	value := time.Date(int(message.Year), time.Month(message.Month), int(message.Day), 0, 0, 0, 0, time.UTC)
+	if value.Year() != int(message.Year) || int32(value.Month()) != message.Month || int32(value.Day()) != message.Day {
+		return nil, fmt.Errorf("invalid date %d-%d-%d", message.Year, message.Month, message.Day)
+	}
+	return &value, nil
+}
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.CreateAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	scopes := make([]string, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	key, err := h.apiKeyUseCase.CreateAPIKey(ctx, usecase.APIKeyInput{
+		UserID: req.UserId,
+		Name:   req.Name,
+		Scopes: scopes,
+	})
+	if err != nil {
+		h.logger.Error("failed to create api key",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to create api key")
+		}
+		return
+	}
+
+	response := apiKeyResponse(*key)
+	h.logger.Info("api key was created successfully",
+		slog.String("trace_id", traceID),
+		slog.String("prefix", key.Prefix))
+	h.respondWithJSON(w, http.StatusOK, api.APIKeyCreated{
+		Id:        response.Id,
+		UserId:    response.UserId,
+		Name:      response.Name,
+		Prefix:    response.Prefix,
+		Scopes:    response.Scopes,
+		CreatedAt: response.CreatedAt,
+		Key:       &key.Key,
+	})
+}
+
+func (h *ScheduleHandler) GetAPIKeys(w http.ResponseWriter, r *http.Request, params api.GetAPIKeysParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	keys, err := h.apiKeyUseCase.GetAPIKeys(ctx, params.UserId)
+	if err != nil {
+		h.logger.Error("failed to get api keys",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get api keys")
+		}
+		return
+	}
+
+	response := make([]api.APIKey, len(keys))
+	for i, key := range keys {
+		response[i] = apiKeyResponse(key)
+	}
+
+	h.logger.Info("successfully got api keys",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func (h *ScheduleHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request, params api.RevokeAPIKeyParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	if err := h.apiKeyUseCase.RevokeAPIKey(ctx, params.UserId, params.KeyId); err != nil {
+		h.logger.Error("failed to revoke api key",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId),
+			slog.Int64("key_id", params.KeyId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrAPIKeyNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "API key was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to revoke api key")
+		}
+		return
+	}
+
+	h.logger.Info("api key was revoked successfully",
+		slog.String("trace_id", traceID))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func apiKeyResponse(key usecase.APIKeyOutput) api.APIKey {
+	scopes := make([]api.APIKeyScope, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = api.APIKeyScope(scope)
+	}
+
+	return api.APIKey{
+		Id:        &key.ID,
+		UserId:    &key.UserID,
+		Name:      &key.Name,
+		Prefix:    &key.Prefix,
+		Scopes:    &scopes,
+		CreatedAt: &key.CreatedAt,
+		RevokedAt: key.RevokedAt,
+	}
+}
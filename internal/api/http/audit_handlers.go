@@ -0,0 +1,67 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) GetScheduleHistory(w http.ResponseWriter, r *http.Request, params api.GetScheduleHistoryParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	entries, err := h.scheduleUseCase.GetScheduleHistory(ctx, params.ScheduleId)
+	if err != nil {
+		h.logger.Error("failed to get schedule history",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("schedule_id", params.ScheduleId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Schedule was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get schedule history")
+		}
+		return
+	}
+
+	response := make([]api.AuditEntry, len(entries))
+	for i, entry := range entries {
+		action := api.AuditEntryAction(entry.Action)
+		response[i] = api.AuditEntry{
+			Id:         &entry.ID,
+			ScheduleId: &entry.ScheduleID,
+			UserId:     &entry.UserID,
+			ActorId:    entry.ActorID,
+			Action:     &action,
+			TraceId:    &entry.TraceID,
+			Before:     snapshotResponse(entry.Before),
+			After:      snapshotResponse(entry.After),
+			CreatedAt:  &entry.CreatedAt,
+		}
+	}
+
+	h.logger.Info("successfully got schedule history",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func snapshotResponse(snapshot json.RawMessage) *map[string]any {
+	if len(snapshot) == 0 {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(snapshot, &fields); err != nil {
+		return nil
+	}
+	return &fields
+}
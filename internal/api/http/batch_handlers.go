@@ -0,0 +1,112 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) CreateSchedules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.CreateSchedulesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	mode := usecase.BatchAllOrNothing
+	if req.Mode != nil {
+		mode = usecase.BatchMode(*req.Mode)
+	}
+	inputs := make([]usecase.ScheduleInput, len(req.Schedules))
+	for i, schedule := range req.Schedules {
+		duration := 0
+		if schedule.Duration != nil {
+			duration = *schedule.Duration
+		}
+		inputs[i] = usecase.ScheduleInput{
+			MedicineName: schedule.MedicineName,
+			Frequency:    schedule.Frequency,
+			Duration:     duration,
+			UserID:       schedule.UserId,
+		}
+		if schedule.MedicineId != nil {
+			inputs[i].MedicineID = *schedule.MedicineId
+		}
+		if schedule.Dose != nil {
+			inputs[i].Dose = *schedule.Dose
+		}
+		if schedule.OverrideInteractions != nil {
+			inputs[i].OverrideInteractions = *schedule.OverrideInteractions
+		}
+	}
+
+	output, err := h.scheduleUseCase.CreateSchedules(ctx, inputs, mode)
+	if err != nil {
+		h.logger.Error("failed to create schedules",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		if errors.Is(err, usecase.ErrInvalidInput) {
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+			return
+		}
+		h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to create schedules")
+		return
+	}
+
+	results := make([]api.BatchScheduleResult, len(output.Items))
+	for i, item := range output.Items {
+		if item.Error != "" {
+			code := api.BatchScheduleResultError(item.Error)
+			results[i].Error = &code
+			continue
+		}
+		id := item.ID
+		results[i].Id = &id
+		if item.Warnings != nil {
+			warnings := interactionWarnings(item.Warnings)
+			results[i].Warnings = &warnings
+		}
+	}
+
+	code := http.StatusOK
+	switch {
+	case output.Created == len(output.Items):
+		code = http.StatusCreated
+	case output.Created == 0 && mode == usecase.BatchAllOrNothing:
+		code = http.StatusUnprocessableEntity
+	}
+
+	h.logger.Info("schedules were created",
+		slog.String("trace_id", traceID),
+		slog.Int("created", output.Created),
+		slog.Int("total", len(output.Items)))
+	h.respondWithJSON(w, code, api.BatchScheduleResponse{
+		Created: &output.Created,
+		Results: &results,
+	})
+}
+
+func interactionWarnings(warnings []usecase.InteractionWarning) []api.InteractionWarning {
+	response := make([]api.InteractionWarning, len(warnings))
+	for i, warning := range warnings {
+		response[i] = api.InteractionWarning{
+			ScheduleId:   warning.ScheduleID,
+			MedicineId:   warning.MedicineID,
+			MedicineName: warning.MedicineName,
+			Severity:     api.InteractionWarningSeverity(warning.Severity),
+			Description:  warning.Description,
+		}
+	}
+	return response
+}
@@ -0,0 +1,224 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) GetCalendar(w http.ResponseWriter, r *http.Request, params api.GetCalendarParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	takings, err := h.calendarUseCase.GetCalendar(ctx, params.UserId, params.From.Time, params.To.Time)
+	if err != nil {
+		h.logger.Error("failed to get calendar for user",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get calendar")
+		}
+		return
+	}
+
+	response := make([]api.CalendarTaking, len(takings))
+	for i, taking := range takings {
+		response[i] = api.CalendarTaking{
+			ScheduleId:   taking.ScheduleID,
+			MedicineName: taking.MedicineName,
+			PlannedAt:    taking.PlannedAt,
+			Status:       api.CalendarTakingStatus(taking.Status),
+			TakenAt:      taking.TakenAt,
+		}
+	}
+
+	h.logger.Info("successfully got calendar",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func (h *ScheduleHandler) ExportSchedulesICS(w http.ResponseWriter, r *http.Request, params api.ExportSchedulesICSParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	calendar, err := h.calendarUseCase.ExportICS(ctx, params.UserId)
+	if err != nil {
+		h.logger.Error("failed to export schedules to iCalendar",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to export schedules")
+		}
+		return
+	}
+
+	h.logger.Info("successfully exported schedules to iCalendar",
+		slog.String("trace_id", traceID))
+	h.respondWithCalendar(w, calendar, "schedules.ics")
+}
+
+// maxICSImportSize bounds the uploaded calendar.
+const maxICSImportSize = 1 << 20
+
+func (h *ScheduleHandler) ImportSchedulesICS(w http.ResponseWriter, r *http.Request, params api.ImportSchedulesICSParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	dryRun := params.DryRun != nil && *params.DryRun
+	report, err := h.scheduleUseCase.ImportICS(ctx, params.UserId, http.MaxBytesReader(w, r.Body, maxICSImportSize), dryRun)
+	if err != nil {
+		h.logger.Error("failed to import schedules from iCalendar",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge):
+			h.respondWithError(w, http.StatusRequestEntityTooLarge, problem.CodePayloadTooLarge, "Calendar is too large")
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to import schedules")
+		}
+		return
+	}
+
+	schedules := make([]api.ScheduleResponse, len(report.Schedules))
+	for i := range report.Schedules {
+		schedules[i] = scheduleResponse(&report.Schedules[i])
+	}
+	skipped := make([]api.SkippedEvent, len(report.Skipped))
+	for i, event := range report.Skipped {
+		skipped[i] = api.SkippedEvent{
+			Uid:     &event.UID,
+			Summary: &event.Summary,
+			Reason:  &event.Reason,
+		}
+	}
+
+	code := http.StatusCreated
+	if dryRun {
+		code = http.StatusOK
+	}
+
+	h.logger.Info("successfully imported schedules from iCalendar",
+		slog.String("trace_id", traceID),
+		slog.Bool("dry_run", dryRun),
+		slog.Int("schedules", len(schedules)),
+		slog.Int("skipped", len(skipped)))
+	h.respondWithJSON(w, code, api.ICSImportReport{
+		DryRun:    &dryRun,
+		Schedules: &schedules,
+		Skipped:   &skipped,
+	})
+}
+
+func (h *ScheduleHandler) CreateCalendarFeed(w http.ResponseWriter, r *http.Request, params api.CreateCalendarFeedParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	feed, err := h.calendarUseCase.CreateFeed(ctx, params.UserId)
+	if err != nil {
+		h.logger.Error("failed to create calendar feed",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to create calendar feed")
+		}
+		return
+	}
+
+	h.logger.Info("calendar feed was created successfully",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusCreated, api.CalendarFeed{
+		UserId:    &feed.UserID,
+		Path:      &feed.Path,
+		CreatedAt: &feed.CreatedAt,
+	})
+}
+
+func (h *ScheduleHandler) RevokeCalendarFeed(w http.ResponseWriter, r *http.Request, params api.RevokeCalendarFeedParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	if err := h.calendarUseCase.RevokeFeed(ctx, params.UserId); err != nil {
+		h.logger.Error("failed to revoke calendar feed",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrFeedNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Calendar feed was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to revoke calendar feed")
+		}
+		return
+	}
+
+	h.logger.Info("calendar feed was revoked successfully",
+		slog.String("trace_id", traceID))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetCalendarFeed is served without credentials, see PublicPaths.
+func (h *ScheduleHandler) GetCalendarFeed(w http.ResponseWriter, r *http.Request, token string) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	calendar, err := h.calendarUseCase.FeedICS(ctx, token)
+	if err != nil {
+		h.logger.Error("failed to get calendar feed",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		switch {
+		case errors.Is(err, usecase.ErrFeedNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Calendar feed was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get calendar feed")
+		}
+		return
+	}
+
+	h.logger.Info("successfully served calendar feed",
+		slog.String("trace_id", traceID))
+	h.respondWithCalendar(w, calendar, "")
+}
+
+func (h *ScheduleHandler) respondWithCalendar(w http.ResponseWriter, calendar []byte, filename string) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	if filename != "" {
+		w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	}
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(calendar); err != nil {
+		h.logger.Error("failed to write response into writer", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,196 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) LinkCaregiver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.LinkCaregiverJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	caregiver, err := h.caregiverUseCase.LinkCaregiver(ctx, caregiverInput(req))
+	if err != nil {
+		h.logger.Error("failed to link caregiver",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrLinkExists):
+			h.respondWithError(w, http.StatusConflict, problem.CodeConflict, "Caregiver already linked")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to link caregiver")
+		}
+		return
+	}
+
+	h.logger.Info("caregiver was linked successfully",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, caregiverResponse(*caregiver))
+}
+
+func (h *ScheduleHandler) UpdateCaregiver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.UpdateCaregiverJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	caregiver, err := h.caregiverUseCase.UpdateCaregiver(ctx, caregiverInput(req))
+	if err != nil {
+		h.logger.Error("failed to update caregiver",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrLinkNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Caregiver link was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to update caregiver")
+		}
+		return
+	}
+
+	h.logger.Info("caregiver was updated successfully",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, caregiverResponse(*caregiver))
+}
+
+func (h *ScheduleHandler) UnlinkCaregiver(w http.ResponseWriter, r *http.Request, params api.UnlinkCaregiverParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	if err := h.caregiverUseCase.UnlinkCaregiver(ctx, params.UserId, params.CaregiverId); err != nil {
+		h.logger.Error("failed to unlink caregiver",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrLinkNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Caregiver link was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to unlink caregiver")
+		}
+		return
+	}
+
+	h.logger.Info("caregiver was unlinked successfully",
+		slog.String("trace_id", traceID))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ScheduleHandler) GetCaregivers(w http.ResponseWriter, r *http.Request, params api.GetCaregiversParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	caregivers, err := h.caregiverUseCase.GetCaregivers(ctx, params.UserId)
+	if err != nil {
+		h.logger.Error("failed to get caregivers",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get caregivers")
+		}
+		return
+	}
+
+	response := make([]api.Caregiver, len(caregivers))
+	for i, caregiver := range caregivers {
+		response[i] = caregiverResponse(caregiver)
+	}
+
+	h.logger.Info("successfully got caregivers",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func (h *ScheduleHandler) GetDependants(w http.ResponseWriter, r *http.Request, params api.GetDependantsParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	dependants, err := h.caregiverUseCase.GetDependants(ctx, params.CaregiverId)
+	if err != nil {
+		h.logger.Error("failed to get dependants",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("caregiver_id", params.CaregiverId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get dependants")
+		}
+		return
+	}
+
+	response := make([]api.Caregiver, len(dependants))
+	for i, dependant := range dependants {
+		response[i] = caregiverResponse(dependant)
+	}
+
+	h.logger.Info("successfully got dependants",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func caregiverInput(req api.CaregiverRequest) usecase.CaregiverInput {
+	return usecase.CaregiverInput{
+		UserID:        req.UserId,
+		CaregiverID:   req.CaregiverId,
+		CanView:       req.CanView,
+		CanEdit:       req.CanEdit,
+		ReceiveAlerts: req.ReceiveAlerts,
+	}
+}
+
+func caregiverResponse(caregiver usecase.CaregiverOutput) api.Caregiver {
+	return api.Caregiver{
+		UserId:        &caregiver.UserID,
+		CaregiverId:   &caregiver.CaregiverID,
+		LinkedAt:      &caregiver.LinkedAt,
+		CanView:       &caregiver.CanView,
+		CanEdit:       &caregiver.CanEdit,
+		ReceiveAlerts: &caregiver.ReceiveAlerts,
+	}
+}
@@ -0,0 +1,138 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/i18n"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) SetDoseLimit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.SetDoseLimitJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		h.logger.Error("validation failed",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithProblem(w, problem.New(http.StatusBadRequest, "Invalid request parameters").
+			WithErrors(problem.FieldErrors(err, i18n.FromContext(ctx))))
+		return
+	}
+
+	input := usecase.DoseLimitInput{
+		UserID:       req.UserId,
+		MedicineName: req.MedicineName,
+		MaxDailyDose: req.MaxDailyDose,
+	}
+	if req.MedicineId != nil {
+		input.MedicineID = *req.MedicineId
+	}
+
+	limit, err := h.doseLimitUseCase.SetDoseLimit(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to set dose limit",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrMedicineNotFound):
+			h.respondWithProblem(w, problem.New(http.StatusNotFound, "Medicine was not found").
+				WithCode(problem.CodeMedicineNotFound))
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to set dose limit")
+		}
+		return
+	}
+
+	h.logger.Info("dose limit was set successfully",
+		slog.String("trace_id", traceID),
+		slog.Int64("user_id", limit.UserID),
+		slog.String("ingredient", limit.Ingredient))
+	h.respondWithJSON(w, http.StatusOK, doseLimitResponse(limit))
+}
+
+func (h *ScheduleHandler) GetDoseLimits(w http.ResponseWriter, r *http.Request, params api.GetDoseLimitsParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	limits, err := h.doseLimitUseCase.GetDoseLimits(ctx, params.UserId)
+	if err != nil {
+		h.logger.Error("failed to get dose limits",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get dose limits")
+		}
+		return
+	}
+
+	response := make([]api.DoseLimit, len(limits))
+	for i := range limits {
+		response[i] = doseLimitResponse(&limits[i])
+	}
+
+	h.logger.Info("successfully got dose limits",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func (h *ScheduleHandler) DeleteDoseLimit(w http.ResponseWriter, r *http.Request, params api.DeleteDoseLimitParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	_, err := h.doseLimitUseCase.DeleteDoseLimit(ctx, params.UserId, params.Ingredient)
+	if err != nil {
+		h.logger.Error("failed to delete dose limit",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrDoseLimitNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Dose limit was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to delete dose limit")
+		}
+		return
+	}
+
+	h.logger.Info("dose limit was deleted successfully",
+		slog.String("trace_id", traceID))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func doseLimitResponse(limit *usecase.DoseLimitOutput) api.DoseLimit {
+	return api.DoseLimit{
+		UserId:       limit.UserID,
+		Ingredient:   limit.Ingredient,
+		MaxDailyDose: limit.MaxDailyDose,
+	}
+}
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+// maxImportSize bounds the uploaded export.
+const maxImportSize = 8 << 20
+
+func (h *ScheduleHandler) ExportUserData(w http.ResponseWriter, r *http.Request, params api.ExportUserDataParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	format := usecase.ExportJSON
+	if params.Format != nil {
+		format = usecase.ExportFormat(*params.Format)
+	}
+
+	data, err := h.exportUseCase.Export(ctx, params.UserId, format)
+	if err != nil {
+		h.logger.Error("failed to export user data",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to export user data")
+		}
+		return
+	}
+
+	contentType, filename := "application/json", "export.json"
+	if format == usecase.ExportCSV {
+		contentType, filename = "application/zip", "export.zip"
+	}
+
+	h.logger.Info("successfully exported user data",
+		slog.String("trace_id", traceID),
+		slog.String("format", string(format)))
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		h.logger.Error("failed to write response into writer", slog.String("error", err.Error()))
+	}
+}
+
+func (h *ScheduleHandler) ImportUserData(w http.ResponseWriter, r *http.Request, params api.ImportUserDataParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	input := usecase.ImportInput{
+		UserID: params.UserId,
+		Format: usecase.ExportJSON,
+	}
+	if params.Format != nil {
+		input.Format = usecase.ExportFormat(*params.Format)
+	}
+	if params.Policy != nil {
+		input.Policy = usecase.ImportPolicy(*params.Policy)
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportSize))
+	if err != nil {
+		h.logger.Error("failed to read export",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.respondWithError(w, http.StatusRequestEntityTooLarge, problem.CodePayloadTooLarge, "Export is too large")
+			return
+		}
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request body")
+		return
+	}
+	input.Data = data
+
+	report, err := h.exportUseCase.Import(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to import user data",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleExists):
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Schedule already exists").
+				WithCode(problem.CodeScheduleExists))
+		case errors.Is(err, usecase.ErrScheduleModified):
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Schedule was modified during the import").
+				WithCode(problem.CodeScheduleModified))
+		case errors.Is(err, usecase.ErrContraindicated):
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Medicine is contraindicated with another one").
+				WithCode(problem.CodeInteractionContraindicated))
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			h.respondWithProblem(w, problem.New(http.StatusUnprocessableEntity, "Daily dose exceeds the limit").
+				WithCode(problem.CodeDailyDoseExceeded))
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			h.respondWithProblem(w, separationProblem(ctx, err))
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to import user data")
+		}
+		return
+	}
+
+	schedules := make([]api.ImportedSchedule, len(report.Schedules))
+	for i, schedule := range report.Schedules {
+		action := api.ImportedScheduleAction(schedule.Action)
+		schedules[i] = api.ImportedSchedule{
+			MedicineName:   &schedule.MedicineName,
+			Action:         &action,
+			ScheduleId:     &schedule.ScheduleID,
+			Intakes:        &schedule.Intakes,
+			SkippedIntakes: &schedule.SkippedIntakes,
+		}
+	}
+
+	h.logger.Info("successfully imported user data",
+		slog.String("trace_id", traceID),
+		slog.Int("schedules", len(schedules)))
+	h.respondWithJSON(w, http.StatusOK, api.ImportReport{Schedules: &schedules})
+}
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/fhir"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+// maxFHIRResourceSize bounds the uploaded resource.
+const maxFHIRResourceSize = 1 << 20
+
+func (h *ScheduleHandler) ImportMedicationRequest(w http.ResponseWriter, r *http.Request, params api.ImportMedicationRequestParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	schedule, err := h.scheduleUseCase.ImportMedicationRequest(ctx, params.UserId,
+		http.MaxBytesReader(w, r.Body, maxFHIRResourceSize))
+	if err != nil {
+		h.logger.Error("failed to import FHIR medication request",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge):
+			h.respondWithError(w, http.StatusRequestEntityTooLarge, problem.CodePayloadTooLarge, "Resource is too large")
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrUnsupportedDosage):
+			h.respondWithError(w, http.StatusUnprocessableEntity, problem.CodeUnprocessable, "Dosage can not be mapped to a daily schedule")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleExists):
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Schedule already exists").
+				WithCode(problem.CodeScheduleExists))
+		case errors.Is(err, usecase.ErrContraindicated):
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Medicine is contraindicated with another one").
+				WithCode(problem.CodeInteractionContraindicated))
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			h.respondWithProblem(w, problem.New(http.StatusUnprocessableEntity, "Daily dose exceeds the limit").
+				WithCode(problem.CodeDailyDoseExceeded))
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			h.respondWithProblem(w, separationProblem(ctx, err))
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to import medication request")
+		}
+		return
+	}
+
+	h.logger.Info("schedule was imported from FHIR medication request",
+		slog.String("trace_id", traceID),
+		slog.Int64("schedule_id", schedule.ID))
+	h.respondWithJSON(w, http.StatusCreated, scheduleResponse(schedule))
+}
+
+func (h *ScheduleHandler) ExportMedicationStatements(w http.ResponseWriter, r *http.Request, params api.ExportMedicationStatementsParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	bundle, err := h.exportUseCase.MedicationStatements(ctx, params.UserId)
+	if err != nil {
+		h.logger.Error("failed to export FHIR medication statements",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		h.respondWithExportError(w, err, "Failed to export medication statements")
+		return
+	}
+
+	h.logger.Info("successfully exported FHIR medication statements",
+		slog.String("trace_id", traceID))
+	h.respondWithFHIR(w, bundle)
+}
+
+func (h *ScheduleHandler) ExportMedicationAdministrations(w http.ResponseWriter, r *http.Request, params api.ExportMedicationAdministrationsParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	bundle, err := h.exportUseCase.MedicationAdministrations(ctx, params.UserId)
+	if err != nil {
+		h.logger.Error("failed to export FHIR medication administrations",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		h.respondWithExportError(w, err, "Failed to export medication administrations")
+		return
+	}
+
+	h.logger.Info("successfully exported FHIR medication administrations",
+		slog.String("trace_id", traceID))
+	h.respondWithFHIR(w, bundle)
+}
+
+func (h *ScheduleHandler) respondWithExportError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidInput):
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+	case errors.Is(err, usecase.ErrPermissionDenied):
+		h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+	default:
+		h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, message)
+	}
+}
+
+func (h *ScheduleHandler) respondWithFHIR(w http.ResponseWriter, bundle []byte) {
+	w.Header().Set("Content-Type", fhir.ContentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(bundle); err != nil {
+		h.logger.Error("failed to write response into writer", slog.String("error", err.Error()))
+	}
+}
@@ -3,38 +3,663 @@
 // Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
 package api
 
-// Error defines model for Error.
+import (
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for APIKeyScope.
+const (
+	CaregiversRead  APIKeyScope = "caregivers:read"
+	CaregiversWrite APIKeyScope = "caregivers:write"
+	IntakesWrite    APIKeyScope = "intakes:write"
+	InventoryRead   APIKeyScope = "inventory:read"
+	InventoryWrite  APIKeyScope = "inventory:write"
+	RemindersRead   APIKeyScope = "reminders:read"
+	RemindersWrite  APIKeyScope = "reminders:write"
+	SchedulesRead   APIKeyScope = "schedules:read"
+	SchedulesWrite  APIKeyScope = "schedules:write"
+)
+
+// Defines values for AuditEntryAction.
+const (
+	IntakeRecorded  AuditEntryAction = "intake.recorded"
+	ScheduleCreated AuditEntryAction = "schedule.created"
+	SchedulePaused  AuditEntryAction = "schedule.paused"
+	ScheduleResumed AuditEntryAction = "schedule.resumed"
+	ScheduleUpdated AuditEntryAction = "schedule.updated"
+)
+
+// Defines values for BatchScheduleRequestMode.
+const (
+	AllOrNothing BatchScheduleRequestMode = "all_or_nothing"
+	BestEffort   BatchScheduleRequestMode = "best_effort"
+)
+
+// Defines values for BatchScheduleResultError.
+const (
+	Aborted                    BatchScheduleResultError = "aborted"
+	DailyDoseExceeded          BatchScheduleResultError = "daily_dose_exceeded"
+	Duplicate                  BatchScheduleResultError = "duplicate"
+	InteractionContraindicated BatchScheduleResultError = "interaction_contraindicated"
+	Internal                   BatchScheduleResultError = "internal"
+	InvalidInput               BatchScheduleResultError = "invalid_input"
+	MedicineNotFound           BatchScheduleResultError = "medicine_not_found"
+	PermissionDenied           BatchScheduleResultError = "permission_denied"
+	ScheduleExists             BatchScheduleResultError = "schedule_exists"
+	SeparationUnsatisfiable    BatchScheduleResultError = "separation_unsatisfiable"
+)
+
+// Defines values for CalendarTakingStatus.
+const (
+	CalendarTakingStatusMissed      CalendarTakingStatus = "missed"
+	CalendarTakingStatusReminded    CalendarTakingStatus = "reminded"
+	CalendarTakingStatusSnoozed     CalendarTakingStatus = "snoozed"
+	CalendarTakingStatusTaken       CalendarTakingStatus = "taken"
+	CalendarTakingStatusUnconfirmed CalendarTakingStatus = "unconfirmed"
+	CalendarTakingStatusUpcoming    CalendarTakingStatus = "upcoming"
+)
+
+// Defines values for ImportedScheduleAction.
+const (
+	Created   ImportedScheduleAction = "created"
+	Merged    ImportedScheduleAction = "merged"
+	Replaced  ImportedScheduleAction = "replaced"
+	Skipped   ImportedScheduleAction = "skipped"
+	Unchanged ImportedScheduleAction = "unchanged"
+)
+
+// Defines values for InteractionWarningSeverity.
+const (
+	Contraindicated InteractionWarningSeverity = "contraindicated"
+	Major           InteractionWarningSeverity = "major"
+	Minor           InteractionWarningSeverity = "minor"
+	Moderate        InteractionWarningSeverity = "moderate"
+)
+
+// Defines values for Locale.
+const (
+	En Locale = "en"
+	Ru Locale = "ru"
+)
+
+// Defines values for RoleName.
+const (
+	RoleNameAdmin     RoleName = "admin"
+	RoleNameCaregiver RoleName = "caregiver"
+	RoleNameClinician RoleName = "clinician"
+	RoleNamePatient   RoleName = "patient"
+)
+
+// Defines values for TakingStateResponseStatus.
+const (
+	TakingStateResponseStatusMissed   TakingStateResponseStatus = "missed"
+	TakingStateResponseStatusReminded TakingStateResponseStatus = "reminded"
+	TakingStateResponseStatusSnoozed  TakingStateResponseStatus = "snoozed"
+)
+
+// Defines values for ExportUserDataParamsFormat.
+const (
+	ExportUserDataParamsFormatCsv  ExportUserDataParamsFormat = "csv"
+	ExportUserDataParamsFormatJson ExportUserDataParamsFormat = "json"
+)
+
+// Defines values for ImportUserDataParamsFormat.
+const (
+	ImportUserDataParamsFormatCsv  ImportUserDataParamsFormat = "csv"
+	ImportUserDataParamsFormatJson ImportUserDataParamsFormat = "json"
+)
+
+// Defines values for ImportUserDataParamsPolicy.
+const (
+	Merge   ImportUserDataParamsPolicy = "merge"
+	Replace ImportUserDataParamsPolicy = "replace"
+	Skip    ImportUserDataParamsPolicy = "skip"
+)
+
+// Defines values for ListSchedulesParamsStatus.
+const (
+	Active   ListSchedulesParamsStatus = "active"
+	All      ListSchedulesParamsStatus = "all"
+	Finished ListSchedulesParamsStatus = "finished"
+	Paused   ListSchedulesParamsStatus = "paused"
+)
+
+// Defines values for ListSchedulesParamsSort.
+const (
+	Id           ListSchedulesParamsSort = "id"
+	MedicineName ListSchedulesParamsSort = "medicine_name"
+	StartDate    ListSchedulesParamsSort = "start_date"
+)
+
+// Defines values for ListSchedulesParamsOrder.
+const (
+	Asc  ListSchedulesParamsOrder = "asc"
+	Desc ListSchedulesParamsOrder = "desc"
+)
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *int64     `json:"id,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+
+	// Prefix First characters of the key
+	Prefix *string `json:"prefix,omitempty"`
+
+	// RevokedAt Set once the key is revoked
+	RevokedAt *time.Time     `json:"revoked_at,omitempty"`
+	Scopes    *[]APIKeyScope `json:"scopes,omitempty"`
+	UserId    *int64         `json:"user_id,omitempty"`
+}
+
+// APIKeyCreated defines model for APIKeyCreated.
+type APIKeyCreated struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *int64     `json:"id,omitempty"`
+
+	// Key The API key, it can not be retrieved again
+	Key  *string `json:"key,omitempty"`
+	Name *string `json:"name,omitempty"`
+
+	// Prefix First characters of the key
+	Prefix *string `json:"prefix,omitempty"`
+
+	// RevokedAt Set once the key is revoked
+	RevokedAt *time.Time     `json:"revoked_at,omitempty"`
+	Scopes    *[]APIKeyScope `json:"scopes,omitempty"`
+	UserId    *int64         `json:"user_id,omitempty"`
+}
+
+// APIKeyRequest defines model for APIKeyRequest.
+type APIKeyRequest struct {
+	// Name Name telling the keys apart
+	Name   string        `json:"name"`
+	Scopes []APIKeyScope `json:"scopes"`
+
+	// UserId ID of the user the key acts as
+	UserId int64 `json:"user_id"`
+}
+
+// APIKeyScope defines model for APIKeyScope.
+type APIKeyScope string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action *AuditEntryAction `json:"action,omitempty"`
+
+	// ActorId User who made the change, missing if unknown
+	ActorId *int64 `json:"actor_id,omitempty"`
+
+	// After Snapshot after the change
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before Snapshot before the change, missing for creations
+	Before     *map[string]interface{} `json:"before,omitempty"`
+	CreatedAt  *time.Time              `json:"created_at,omitempty"`
+	Id         *int64                  `json:"id,omitempty"`
+	ScheduleId *int64                  `json:"schedule_id,omitempty"`
+	TraceId    *string                 `json:"trace_id,omitempty"`
+
+	// UserId Owner of the schedule
+	UserId *int64 `json:"user_id,omitempty"`
+}
+
+// AuditEntryAction defines model for AuditEntry.Action.
+type AuditEntryAction string
+
+// BatchScheduleRequest defines model for BatchScheduleRequest.
+type BatchScheduleRequest struct {
+	Mode      *BatchScheduleRequestMode `json:"mode,omitempty"`
+	Schedules []ScheduleRequest         `json:"schedules"`
+}
+
+// BatchScheduleRequestMode defines model for BatchScheduleRequest.Mode.
+type BatchScheduleRequestMode string
+
+// BatchScheduleResponse defines model for BatchScheduleResponse.
+type BatchScheduleResponse struct {
+	// Created Number of created schedules
+	Created *int                   `json:"created,omitempty"`
+	Results *[]BatchScheduleResult `json:"results,omitempty"`
+}
+
+// BatchScheduleResult defines model for BatchScheduleResult.
+type BatchScheduleResult struct {
+	// Error Why the schedule was not created. aborted is a valid schedule of an all-or-nothing batch with invalid schedules, duplicate repeats the user and medicine of an earlier schedule of the batch, interaction_contraindicated is a medicine contraindicated with an active schedule unless the schedule sets `override_interactions`.
+	Error *BatchScheduleResultError `json:"error,omitempty"`
+
+	// Id ID of the created schedule
+	Id *int64 `json:"id,omitempty"`
+
+	// Warnings Interactions of the created schedule with the active schedules, the most severe first
+	Warnings *[]InteractionWarning `json:"warnings,omitempty"`
+}
+
+// BatchScheduleResultError Why the schedule was not created. aborted is a valid schedule of an all-or-nothing batch with invalid schedules, duplicate repeats the user and medicine of an earlier schedule of the batch, interaction_contraindicated is a medicine contraindicated with an active schedule unless the schedule sets `override_interactions`.
+type BatchScheduleResultError string
+
+// CalendarFeed defines model for CalendarFeed.
+type CalendarFeed struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Path Feed path with the secret token, relative to the server address
+	Path   *string `json:"path,omitempty"`
+	UserId *int64  `json:"user_id,omitempty"`
+}
+
+// CalendarTaking defines model for CalendarTaking.
+type CalendarTaking struct {
+	MedicineName string    `json:"medicine_name"`
+	PlannedAt    time.Time `json:"planned_at"`
+	ScheduleId   int64     `json:"schedule_id"`
+
+	// Status taken, missed, snoozed and reminded come from the recorded intakes and reminders, upcoming and unconfirmed mark future and past takings without them
+	Status  CalendarTakingStatus `json:"status"`
+	TakenAt *time.Time           `json:"taken_at,omitempty"`
+}
+
+// CalendarTakingStatus taken, missed, snoozed and reminded come from the recorded intakes and reminders, upcoming and unconfirmed mark future and past takings without them
+type CalendarTakingStatus string
+
+// Caregiver defines model for Caregiver.
+type Caregiver struct {
+	// CanEdit Whether the caregiver can create and change the user's schedules
+	CanEdit *bool `json:"can_edit,omitempty"`
+
+	// CanView Whether the caregiver can read the user's schedules
+	CanView *bool `json:"can_view,omitempty"`
+
+	// CaregiverId ID of the caregiver user
+	CaregiverId *int64 `json:"caregiver_id,omitempty"`
+
+	// LinkedAt Time the caregiver was linked
+	LinkedAt *time.Time `json:"linked_at,omitempty"`
+
+	// ReceiveAlerts Whether the caregiver is notified about missed doses
+	ReceiveAlerts *bool `json:"receive_alerts,omitempty"`
+
+	// UserId ID of the dependant user
+	UserId *int64 `json:"user_id,omitempty"`
+}
+
+// CaregiverRequest defines model for CaregiverRequest.
+type CaregiverRequest struct {
+	// CanEdit Whether the caregiver can create and change the user's schedules (false by default)
+	CanEdit *bool `json:"can_edit,omitempty"`
+
+	// CanView Whether the caregiver can read the user's schedules (true by default)
+	CanView *bool `json:"can_view,omitempty"`
+
+	// CaregiverId ID of the caregiver user
+	CaregiverId int64 `json:"caregiver_id"`
+
+	// ReceiveAlerts Whether the caregiver is notified about missed doses (true by default)
+	ReceiveAlerts *bool `json:"receive_alerts,omitempty"`
+
+	// UserId ID of the user
+	UserId int64 `json:"user_id"`
+}
+
+// DoseLimit defines model for DoseLimit.
+type DoseLimit struct {
+	// Ingredient Normalized name of the ingredient
+	Ingredient string `json:"ingredient"`
+
+	// MaxDailyDose Maximum daily dose of the ingredient in milligrams
+	MaxDailyDose float64 `json:"max_daily_dose"`
+
+	// UserId ID of the user
+	UserId int64 `json:"user_id"`
+}
+
+// DoseLimitRequest defines model for DoseLimitRequest.
+type DoseLimitRequest struct {
+	// MaxDailyDose Maximum daily dose of the ingredient in milligrams
+	MaxDailyDose float64 `json:"max_daily_dose" validate:"required,gt=0"`
+
+	// MedicineId ID of the catalog medicine
+	MedicineId *string `json:"medicine_id,omitempty"`
+
+	// MedicineName Name of the medicine, required without `medicine_id`
+	MedicineName string `json:"medicine_name,omitempty" validate:"required_without=MedicineId"`
+
+	// UserId ID of the user
+	UserId int64 `json:"user_id" validate:"required,gte=1"`
+}
+
+// Error RFC 7807 problem details
 type Error struct {
-	// Error Error message
-	Error *string `json:"error,omitempty"`
+	// Code Stable machine readable error code
+	Code string `json:"code"`
+
+	// Detail Human readable description of the error
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Invalid fields of the request
+	Errors *[]FieldError `json:"errors,omitempty"`
+	Status int           `json:"status"`
+
+	// Title Reason phrase of the status
+	Title string `json:"title"`
+
+	// TraceId Trace ID of the request
+	TraceId *string `json:"trace_id,omitempty"`
+	Type    string  `json:"type"`
+}
+
+// EscalationRequest defines model for EscalationRequest.
+type EscalationRequest struct {
+	// CaregiverDelayMinutes Minutes after the dose is missed before caregivers are notified
+	CaregiverDelayMinutes int `json:"caregiver_delay_minutes"`
+
+	// GraceMinutes Minutes after the planned time before the dose is missed
+	GraceMinutes int `json:"grace_minutes"`
+
+	// NotifyCaregivers Whether linked caregivers are notified
+	NotifyCaregivers bool `json:"notify_caregivers"`
+
+	// ScheduleId ID of the schedule
+	ScheduleId int64 `json:"schedule_id"`
+
+	// UserId ID of the user
+	UserId int64 `json:"user_id"`
+}
+
+// EscalationResponse defines model for EscalationResponse.
+type EscalationResponse struct {
+	// CaregiverDelayMinutes Minutes after the dose is missed before caregivers are notified
+	CaregiverDelayMinutes *int `json:"caregiver_delay_minutes,omitempty"`
+
+	// GraceMinutes Minutes after the planned time before the dose is missed
+	GraceMinutes *int `json:"grace_minutes,omitempty"`
+
+	// NotifyCaregivers Whether linked caregivers are notified
+	NotifyCaregivers *bool `json:"notify_caregivers,omitempty"`
+
+	// ScheduleId ID of the schedule
+	ScheduleId *int64 `json:"schedule_id,omitempty"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Code Failed validation rule
+	Code string `json:"code"`
+
+	// Field JSON name of the field or the parameter name
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ICSImportReport defines model for ICSImportReport.
+type ICSImportReport struct {
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// Schedules Created schedules, without IDs on a dry run
+	Schedules *[]ScheduleResponse `json:"schedules,omitempty"`
+	Skipped   *[]SkippedEvent     `json:"skipped,omitempty"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	Schedules *[]ImportedSchedule `json:"schedules,omitempty"`
+}
+
+// ImportedSchedule defines model for ImportedSchedule.
+type ImportedSchedule struct {
+	Action *ImportedScheduleAction `json:"action,omitempty"`
+
+	// Intakes Number of recorded intakes
+	Intakes      *int    `json:"intakes,omitempty"`
+	MedicineName *string `json:"medicine_name,omitempty"`
+
+	// ScheduleId Created or existing schedule
+	ScheduleId *int64 `json:"schedule_id,omitempty"`
+
+	// SkippedIntakes Number of intakes which are not planned by the schedule
+	SkippedIntakes *int `json:"skipped_intakes,omitempty"`
+}
+
+// ImportedScheduleAction defines model for ImportedSchedule.Action.
+type ImportedScheduleAction string
+
+// IntakeExport defines model for IntakeExport.
+type IntakeExport struct {
+	PlannedAt *time.Time `json:"planned_at,omitempty"`
+	TakenAt   *time.Time `json:"taken_at,omitempty"`
+}
+
+// IntakeRequest defines model for IntakeRequest.
+type IntakeRequest struct {
+	// PlannedAt Planned time of the taking
+	PlannedAt time.Time `json:"planned_at"`
+
+	// ScheduleId ID of the schedule
+	ScheduleId int64 `json:"schedule_id"`
+
+	// TakenAt Time the medicine was taken, now by default
+	TakenAt *time.Time `json:"taken_at,omitempty"`
+
+	// UserId ID of the user
+	UserId int64 `json:"user_id"`
+}
+
+// IntakeResponse defines model for IntakeResponse.
+type IntakeResponse struct {
+	// Id ID of the intake
+	Id *int64 `json:"id,omitempty"`
+
+	// MedicineName Name of the medicine
+	MedicineName *string `json:"medicine_name,omitempty"`
+
+	// PlannedAt Planned time of the taking
+	PlannedAt *time.Time `json:"planned_at,omitempty"`
+
+	// ScheduleId ID of the schedule
+	ScheduleId *int64 `json:"schedule_id,omitempty"`
+
+	// TakenAt Time the medicine was taken
+	TakenAt *time.Time `json:"taken_at,omitempty"`
+}
+
+// InteractionWarning defines model for InteractionWarning.
+type InteractionWarning struct {
+	Description string `json:"description"`
+
+	// MedicineId ID of the interacting catalog medicine
+	MedicineId string `json:"medicine_id"`
+
+	// MedicineName Name of the interacting medicine in the schedule
+	MedicineName string `json:"medicine_name"`
+
+	// ScheduleId ID of the active schedule of the interacting medicine
+	ScheduleId int64 `json:"schedule_id"`
+
+	// Severity Severity of the interaction
+	Severity InteractionWarningSeverity `json:"severity"`
+}
+
+// InteractionWarningSeverity Severity of the interaction
+type InteractionWarningSeverity string
+
+// InventoryResponse defines model for InventoryResponse.
+type InventoryResponse struct {
+	// DaysLeft Projected number of days the supply will last
+	DaysLeft *float64 `json:"days_left,omitempty"`
+
+	// DosePerTaking Units taken at a time
+	DosePerTaking *int `json:"dose_per_taking,omitempty"`
+
+	// MedicineName Name of the medicine
+	MedicineName *string `json:"medicine_name,omitempty"`
+
+	// Quantity Number of units at the last refill
+	Quantity *int `json:"quantity,omitempty"`
+
+	// RefilledAt Time of the last refill in format "DD Mon YYYY HH:MM"
+	RefilledAt *string `json:"refilled_at,omitempty"`
+
+	// Remaining Projected number of units left
+	Remaining *int `json:"remaining,omitempty"`
+
+	// ScheduleId ID of the schedule
+	ScheduleId *int64 `json:"schedule_id,omitempty"`
+}
+
+// Locale defines model for Locale.
+type Locale string
+
+// Medicine defines model for Medicine.
+type Medicine struct {
+	// Id ID of the catalog medicine
+	Id string `json:"id"`
+
+	// Ingredient Active ingredient
+	Ingredient string `json:"ingredient"`
+
+	// MaxDailyDose Maximum daily dose of the ingredient in milligrams, omitted when the catalog sets no limit. Users may set their own limits through `/dose-limits`.
+	MaxDailyDose *float64 `json:"max_daily_dose,omitempty"`
+
+	// Name Name of the medicine
+	Name string `json:"name"`
+
+	// Synonyms Other names of the medicine, like brands and the names in other languages
+	Synonyms []string `json:"synonyms"`
+}
+
+// Profile defines model for Profile.
+type Profile struct {
+	Locale *Locale `json:"locale,omitempty"`
+	UserId *int64  `json:"user_id,omitempty"`
+}
+
+// ProfileRequest defines model for ProfileRequest.
+type ProfileRequest struct {
+	Locale Locale `json:"locale"`
+	UserId int64  `json:"user_id"`
+}
+
+// RefillRequest defines model for RefillRequest.
+type RefillRequest struct {
+	// DosePerTaking Units taken at a time (1 by default)
+	DosePerTaking *int `json:"dose_per_taking,omitempty"`
+
+	// Quantity Number of units in the new pack
+	Quantity int `json:"quantity"`
+
+	// ScheduleId ID of the schedule
+	ScheduleId int64 `json:"schedule_id"`
+
+	// UserId ID of the user
+	UserId int64 `json:"user_id"`
+}
+
+// Role defines model for Role.
+type Role struct {
+	Role   *RoleName `json:"role,omitempty"`
+	UserId *int64    `json:"user_id,omitempty"`
+}
+
+// RoleName defines model for RoleName.
+type RoleName string
+
+// RoleRequest defines model for RoleRequest.
+type RoleRequest struct {
+	Role   RoleName `json:"role"`
+	UserId int64    `json:"user_id"`
+}
+
+// ScheduleExport defines model for ScheduleExport.
+type ScheduleExport struct {
+	// Dose Dose of a taking in milligrams, absent when unknown. Imports keep the dose of the existing schedule when it is absent.
+	Dose *float64 `json:"dose,omitempty"`
+
+	// EndDate Absent for an infinite schedule
+	EndDate *string `json:"end_date,omitempty"`
+
+	// Id Links the intakes of the export, imports create new IDs
+	Id      *int64          `json:"id,omitempty"`
+	Intakes *[]IntakeExport `json:"intakes,omitempty"`
+
+	// MedicineId Absent for the custom medicines. Imports find the medicine by the name when the catalog has no such entry.
+	MedicineId   *string   `json:"medicine_id,omitempty"`
+	MedicineName *string   `json:"medicine_name,omitempty"`
+	StartDate    *string   `json:"start_date,omitempty"`
+	TakingTimes  *[]string `json:"taking_times,omitempty"`
+}
+
+// ScheduleList defines model for ScheduleList.
+type ScheduleList struct {
+	// NextCursor Cursor of the next page, missing on the last page
+	NextCursor *string             `json:"next_cursor,omitempty"`
+	Schedules  *[]ScheduleResponse `json:"schedules,omitempty"`
+}
+
+// SchedulePauseRequest defines model for SchedulePauseRequest.
+type SchedulePauseRequest struct {
+	// OverrideInteractions Resume the schedule even if its medicine is contraindicated with a medicine of an active schedule of the user
+	OverrideInteractions *bool `json:"override_interactions,omitempty"`
+
+	// Paused Pause the schedule, or resume it with false
+	Paused bool `json:"paused"`
+
+	// ScheduleId ID of the schedule
+	ScheduleId int64 `json:"schedule_id" validate:"required,gte=1"`
+
+	// UserId ID of the user
+	UserId int64 `json:"user_id" validate:"required,gte=1"`
 }
 
 // ScheduleRequest defines model for ScheduleRequest.
 type ScheduleRequest struct {
+	// Dose Amount of the medicine taken at a time in milligrams, omitted when unknown. The daily amount of the ingredient across the active schedules may not exceed its maximum daily dose.
+	Dose *float64 `json:"dose,omitempty" validate:"omitempty,gte=0"`
+
 	// Duration Duration in days (0 for infinite)
-	Duration *int `json:"duration,omitempty"`
+	Duration *int `json:"duration,omitempty" validate:"omitempty,gte=0"`
 
 	// Frequency Number of times per day to take the medicine (1-15)
-	Frequency int `json:"frequency"`
+	Frequency int `json:"frequency" validate:"required,gte=1,lte=15"`
 
-	// MedicineName Name of the medicine
-	MedicineName string `json:"medicine_name"`
+	// MedicineId ID of the catalog medicine from `/medicines`, the schedule is named after it when `medicine_name` is omitted. Without it the medicine is found in the catalog by the name, the medicines missing there are custom ones.
+	MedicineId *string `json:"medicine_id,omitempty"`
+
+	// MedicineName Name of the medicine, required without `medicine_id`
+	MedicineName string `json:"medicine_name,omitempty" validate:"required_without=MedicineId"`
+
+	// OverrideInteractions Create the schedule even if its medicine is contraindicated with a medicine of an active schedule of the user
+	OverrideInteractions *bool `json:"override_interactions,omitempty"`
 
 	// UserId ID of the user
-	UserId int64 `json:"user_id"`
+	UserId int64 `json:"user_id" validate:"required,gte=1"`
 }
 
 // ScheduleResponse defines model for ScheduleResponse.
 type ScheduleResponse struct {
+	// Dose Amount of the medicine taken at a time in milligrams, omitted when unknown
+	Dose *float64 `json:"dose,omitempty"`
+
 	// EndDate End date of the schedule in format "DD Mon YYYY" or "null"
 	EndDate *string `json:"end_date,omitempty"`
 
 	// Id ID of the schedule
 	Id *int64 `json:"id,omitempty"`
 
+	// MedicineId ID of the catalog medicine, omitted for the custom medicines
+	MedicineId *string `json:"medicine_id,omitempty"`
+
 	// MedicineName Name of the medicine
 	MedicineName *string `json:"medicine_name,omitempty"`
 
+	// Paused Paused schedules have no takings and send no reminders
+	Paused *bool `json:"paused,omitempty"`
+
 	// StartDate Start date of the schedule in format "DD Mon YYYY"
 	StartDate *string `json:"start_date,omitempty"`
 
@@ -43,6 +668,58 @@ type ScheduleResponse struct {
 
 	// UserId ID of the user
 	UserId *int64 `json:"user_id,omitempty"`
+
+	// Version Version of the schedule, incremented by every update
+	Version *int64 `json:"version,omitempty"`
+}
+
+// ScheduleUpdateRequest defines model for ScheduleUpdateRequest.
+type ScheduleUpdateRequest struct {
+	// Dose Amount of the medicine taken at a time in milligrams, omitted when unknown. The daily amount of the ingredient across the active schedules may not exceed its maximum daily dose.
+	Dose *float64 `json:"dose,omitempty" validate:"omitempty,gte=0"`
+
+	// Duration Duration in days from the start date (0 for infinite)
+	Duration *int `json:"duration,omitempty" validate:"omitempty,gte=0"`
+
+	// Frequency Number of times per day to take the medicine (1-15)
+	Frequency int `json:"frequency" validate:"required,gte=1,lte=15"`
+
+	// MedicineId ID of the catalog medicine, see `ScheduleRequest`
+	MedicineId *string `json:"medicine_id,omitempty"`
+
+	// MedicineName Name of the medicine, required without `medicine_id`
+	MedicineName string `json:"medicine_name,omitempty" validate:"required_without=MedicineId"`
+
+	// OverrideInteractions Update the schedule even if its changed medicine is contraindicated with a medicine of an active schedule of the user
+	OverrideInteractions *bool `json:"override_interactions,omitempty"`
+
+	// ScheduleId ID of the schedule
+	ScheduleId int64 `json:"schedule_id" validate:"required,gte=1"`
+
+	// UserId ID of the user
+	UserId int64 `json:"user_id" validate:"required,gte=1"`
+
+	// Version Version of the schedule the update is based on, when If-Match is not sent
+	Version *int64 `json:"version,omitempty"`
+}
+
+// SkippedEvent defines model for SkippedEvent.
+type SkippedEvent struct {
+	Reason  *string `json:"reason,omitempty"`
+	Summary *string `json:"summary,omitempty"`
+	Uid     *string `json:"uid,omitempty"`
+}
+
+// SnoozeRequest defines model for SnoozeRequest.
+type SnoozeRequest struct {
+	// PlannedAt Planned time of the taking being snoozed
+	PlannedAt time.Time `json:"planned_at"`
+
+	// ScheduleId ID of the schedule
+	ScheduleId int64 `json:"schedule_id"`
+
+	// UserId ID of the user
+	UserId int64 `json:"user_id"`
 }
 
 // Taking defines model for Taking.
@@ -50,14 +727,202 @@ type Taking struct {
 	// MedicineName Name of the medicine
 	MedicineName *string `json:"medicine_name,omitempty"`
 
+	// TakingDate Day to take the medicine on
+	TakingDate *openapi_types.Date `json:"taking_date,omitempty"`
+
 	// TakingTime Time to take the medicine
 	TakingTime *string `json:"taking_time,omitempty"`
 }
 
+// TakingStateResponse defines model for TakingStateResponse.
+type TakingStateResponse struct {
+	// MedicineName Name of the medicine
+	MedicineName *string `json:"medicine_name,omitempty"`
+
+	// PlannedAt Planned time of the taking
+	PlannedAt *time.Time `json:"planned_at,omitempty"`
+
+	// ScheduleId ID of the schedule
+	ScheduleId *int64 `json:"schedule_id,omitempty"`
+
+	// SnoozeCount Number of snoozes used
+	SnoozeCount *int `json:"snooze_count,omitempty"`
+
+	// SnoozedUntil Time the reminder will be sent again
+	SnoozedUntil *time.Time `json:"snoozed_until,omitempty"`
+
+	// Status State of the taking
+	Status *TakingStateResponseStatus `json:"status,omitempty"`
+}
+
+// TakingStateResponseStatus State of the taking
+type TakingStateResponseStatus string
+
+// UserDataExport defines model for UserDataExport.
+type UserDataExport struct {
+	ExportedAt *time.Time        `json:"exported_at,omitempty"`
+	Schedules  *[]ScheduleExport `json:"schedules,omitempty"`
+	UserId     *int64            `json:"user_id,omitempty"`
+	Version    *int              `json:"version,omitempty"`
+}
+
+// RevokeAPIKeyParams defines parameters for RevokeAPIKey.
+type RevokeAPIKeyParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+
+	// KeyId API key ID
+	KeyId int64 `form:"key_id" json:"key_id"`
+}
+
+// GetAPIKeysParams defines parameters for GetAPIKeys.
+type GetAPIKeysParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+}
+
+// GetCalendarParams defines parameters for GetCalendar.
+type GetCalendarParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+
+	// From First day of the range
+	From openapi_types.Date `form:"from" json:"from"`
+
+	// To Last day of the range
+	To openapi_types.Date `form:"to" json:"to"`
+}
+
+// RevokeCalendarFeedParams defines parameters for RevokeCalendarFeed.
+type RevokeCalendarFeedParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+}
+
+// CreateCalendarFeedParams defines parameters for CreateCalendarFeed.
+type CreateCalendarFeedParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+}
+
+// UnlinkCaregiverParams defines parameters for UnlinkCaregiver.
+type UnlinkCaregiverParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+
+	// CaregiverId Caregiver user ID
+	CaregiverId int64 `form:"caregiver_id" json:"caregiver_id"`
+}
+
+// GetCaregiversParams defines parameters for GetCaregivers.
+type GetCaregiversParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+}
+
+// GetDependantsParams defines parameters for GetDependants.
+type GetDependantsParams struct {
+	// CaregiverId Caregiver user ID
+	CaregiverId int64 `form:"caregiver_id" json:"caregiver_id"`
+}
+
+// DeleteDoseLimitParams defines parameters for DeleteDoseLimit.
+type DeleteDoseLimitParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+
+	// Ingredient Ingredient of the limit
+	Ingredient string `form:"ingredient" json:"ingredient"`
+}
+
+// GetDoseLimitsParams defines parameters for GetDoseLimits.
+type GetDoseLimitsParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+}
+
+// ExportUserDataParams defines parameters for ExportUserData.
+type ExportUserDataParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+
+	// Format Format of the export
+	Format *ExportUserDataParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportUserDataParamsFormat defines parameters for ExportUserData.
+type ExportUserDataParamsFormat string
+
+// ExportMedicationAdministrationsParams defines parameters for ExportMedicationAdministrations.
+type ExportMedicationAdministrationsParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+}
+
+// ImportMedicationRequestApplicationFhirPlusJSONBody defines parameters for ImportMedicationRequest.
+type ImportMedicationRequestApplicationFhirPlusJSONBody = map[string]interface{}
+
+// ImportMedicationRequestParams defines parameters for ImportMedicationRequest.
+type ImportMedicationRequestParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+}
+
+// ExportMedicationStatementsParams defines parameters for ExportMedicationStatements.
+type ExportMedicationStatementsParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+}
+
+// ImportUserDataParams defines parameters for ImportUserData.
+type ImportUserDataParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+
+	// Format Format of the export
+	Format *ImportUserDataParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Policy What to do with a schedule of a medicine the user already has a schedule of. skip keeps the existing schedule, merge adds the imported taking times and widens the dates to cover both schedules, replace overwrites the dates and taking times.
+	Policy *ImportUserDataParamsPolicy `form:"policy,omitempty" json:"policy,omitempty"`
+}
+
+// ImportUserDataParamsFormat defines parameters for ImportUserData.
+type ImportUserDataParamsFormat string
+
+// ImportUserDataParamsPolicy defines parameters for ImportUserData.
+type ImportUserDataParamsPolicy string
+
+// SearchMedicinesParams defines parameters for SearchMedicines.
+type SearchMedicinesParams struct {
+	// Q Beginning of the medicine name
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum number of medicines, 10 by default
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetNextTakingsParams defines parameters for GetNextTakings.
 type GetNextTakingsParams struct {
 	// UserId ID of the user
 	UserId int64 `form:"user_id" json:"user_id"`
+
+	// Within Length of the window, like 90m or 12h
+	Within *string `form:"within,omitempty" json:"within,omitempty"`
+
+	// Limit Maximum number of takings
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetProfileParams defines parameters for GetProfile.
+type GetProfileParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+}
+
+// GetRoleParams defines parameters for GetRole.
+type GetRoleParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
 }
 
 // GetScheduleParams defines parameters for GetSchedule.
@@ -69,11 +934,140 @@ type GetScheduleParams struct {
 	ScheduleId int64 `form:"schedule_id" json:"schedule_id"`
 }
 
+// UpdateScheduleParams defines parameters for UpdateSchedule.
+type UpdateScheduleParams struct {
+	// IfMatch ETag of the schedule version the update is based on
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetEscalationRuleParams defines parameters for GetEscalationRule.
+type GetEscalationRuleParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+
+	// ScheduleId Schedule ID
+	ScheduleId int64 `form:"schedule_id" json:"schedule_id"`
+}
+
+// GetScheduleHistoryParams defines parameters for GetScheduleHistory.
+type GetScheduleHistoryParams struct {
+	// ScheduleId Schedule ID
+	ScheduleId int64 `form:"schedule_id" json:"schedule_id"`
+}
+
+// GetInventoryParams defines parameters for GetInventory.
+type GetInventoryParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+
+	// ScheduleId Schedule ID
+	ScheduleId int64 `form:"schedule_id" json:"schedule_id"`
+}
+
+// ListSchedulesParams defines parameters for ListSchedules.
+type ListSchedulesParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+
+	// Status Whether the schedules are still running (all by default). Active and paused schedules are running, finished ones may be paused.
+	Status *ListSchedulesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// MedicinePrefix Case-insensitive prefix of the medicine name
+	MedicinePrefix *string `form:"medicine_prefix,omitempty" json:"medicine_prefix,omitempty"`
+
+	// From Keep schedules running on this day or later
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Keep schedules running on this day or earlier
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// Sort Sort field (id by default)
+	Sort *ListSchedulesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort order (asc by default)
+	Order *ListSchedulesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Page size (20 by default)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListSchedulesParamsStatus defines parameters for ListSchedules.
+type ListSchedulesParamsStatus string
+
+// ListSchedulesParamsSort defines parameters for ListSchedules.
+type ListSchedulesParamsSort string
+
+// ListSchedulesParamsOrder defines parameters for ListSchedules.
+type ListSchedulesParamsOrder string
+
 // GetScheduleIDsParams defines parameters for GetScheduleIDs.
 type GetScheduleIDsParams struct {
 	// UserId User ID
 	UserId int64 `form:"user_id" json:"user_id"`
 }
 
+// ExportSchedulesICSParams defines parameters for ExportSchedulesICS.
+type ExportSchedulesICSParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+}
+
+// ImportSchedulesICSParams defines parameters for ImportSchedulesICS.
+type ImportSchedulesICSParams struct {
+	// UserId User ID
+	UserId int64 `form:"user_id" json:"user_id"`
+
+	// DryRun Only report what would be created
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = APIKeyRequest
+
+// LinkCaregiverJSONRequestBody defines body for LinkCaregiver for application/json ContentType.
+type LinkCaregiverJSONRequestBody = CaregiverRequest
+
+// UpdateCaregiverJSONRequestBody defines body for UpdateCaregiver for application/json ContentType.
+type UpdateCaregiverJSONRequestBody = CaregiverRequest
+
+// SetDoseLimitJSONRequestBody defines body for SetDoseLimit for application/json ContentType.
+type SetDoseLimitJSONRequestBody = DoseLimitRequest
+
+// ImportMedicationRequestApplicationFhirPlusJSONRequestBody defines body for ImportMedicationRequest for application/fhir+json ContentType.
+type ImportMedicationRequestApplicationFhirPlusJSONRequestBody = ImportMedicationRequestApplicationFhirPlusJSONBody
+
+// ImportUserDataJSONRequestBody defines body for ImportUserData for application/json ContentType.
+type ImportUserDataJSONRequestBody = UserDataExport
+
+// SetProfileJSONRequestBody defines body for SetProfile for application/json ContentType.
+type SetProfileJSONRequestBody = ProfileRequest
+
+// SetRoleJSONRequestBody defines body for SetRole for application/json ContentType.
+type SetRoleJSONRequestBody = RoleRequest
+
 // CreateScheduleJSONRequestBody defines body for CreateSchedule for application/json ContentType.
 type CreateScheduleJSONRequestBody = ScheduleRequest
+
+// UpdateScheduleJSONRequestBody defines body for UpdateSchedule for application/json ContentType.
+type UpdateScheduleJSONRequestBody = ScheduleUpdateRequest
+
+// SetEscalationRuleJSONRequestBody defines body for SetEscalationRule for application/json ContentType.
+type SetEscalationRuleJSONRequestBody = EscalationRequest
+
+// PauseScheduleJSONRequestBody defines body for PauseSchedule for application/json ContentType.
+type PauseScheduleJSONRequestBody = SchedulePauseRequest
+
+// RecordRefillJSONRequestBody defines body for RecordRefill for application/json ContentType.
+type RecordRefillJSONRequestBody = RefillRequest
+
+// CreateSchedulesJSONRequestBody defines body for CreateSchedules for application/json ContentType.
+type CreateSchedulesJSONRequestBody = BatchScheduleRequest
+
+// RecordIntakeJSONRequestBody defines body for RecordIntake for application/json ContentType.
+type RecordIntakeJSONRequestBody = IntakeRequest
+
+// SnoozeTakingJSONRequestBody defines body for SnoozeTaking for application/json ContentType.
+type SnoozeTakingJSONRequestBody = SnoozeRequest
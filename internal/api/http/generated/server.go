@@ -4,6 +4,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -13,65 +14,1840 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Revokes an API key
+	// (DELETE /api-keys)
+	RevokeAPIKey(w http.ResponseWriter, r *http.Request, params RevokeAPIKeyParams)
+	// Get API keys of the user
+	// (GET /api-keys)
+	GetAPIKeys(w http.ResponseWriter, r *http.Request, params GetAPIKeysParams)
+	// Creates an API key for a service acting as the user
+	// (POST /api-keys)
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	// Get all takings of the user's schedules over a range of days
+	// (GET /calendar)
+	GetCalendar(w http.ResponseWriter, r *http.Request, params GetCalendarParams)
+	// Revoke the feed of the user's schedules
+	// (DELETE /calendar/feed)
+	RevokeCalendarFeed(w http.ResponseWriter, r *http.Request, params RevokeCalendarFeedParams)
+	// Create a secret-token feed of the user's schedules
+	// (POST /calendar/feed)
+	CreateCalendarFeed(w http.ResponseWriter, r *http.Request, params CreateCalendarFeedParams)
+	// Unlinks a caregiver from the user
+	// (DELETE /caregivers)
+	UnlinkCaregiver(w http.ResponseWriter, r *http.Request, params UnlinkCaregiverParams)
+	// Get caregivers linked to the user
+	// (GET /caregivers)
+	GetCaregivers(w http.ResponseWriter, r *http.Request, params GetCaregiversParams)
+	// Links a caregiver to the user
+	// (POST /caregivers)
+	LinkCaregiver(w http.ResponseWriter, r *http.Request)
+	// Changes the permissions of a linked caregiver
+	// (PUT /caregivers)
+	UpdateCaregiver(w http.ResponseWriter, r *http.Request)
+	// Get users the caregiver is linked to
+	// (GET /dependants)
+	GetDependants(w http.ResponseWriter, r *http.Request, params GetDependantsParams)
+	// Removes the limit the user set, the catalog one applies again
+	// (DELETE /dose-limits)
+	DeleteDoseLimit(w http.ResponseWriter, r *http.Request, params DeleteDoseLimitParams)
+	// Get the maximum daily doses the user set
+	// (GET /dose-limits)
+	GetDoseLimits(w http.ResponseWriter, r *http.Request, params GetDoseLimitsParams)
+	// Sets the maximum daily dose of the ingredient of a medicine
+	// (PUT /dose-limits)
+	SetDoseLimit(w http.ResponseWriter, r *http.Request)
+	// Export all data of the user
+	// (GET /export)
+	ExportUserData(w http.ResponseWriter, r *http.Request, params ExportUserDataParams)
+	// Get the schedules of the feed owner as an iCalendar file
+	// (GET /feeds/{token}.ics)
+	GetCalendarFeed(w http.ResponseWriter, r *http.Request, token string)
+	// Export the user's intakes as FHIR MedicationAdministrations
+	// (GET /fhir/MedicationAdministration)
+	ExportMedicationAdministrations(w http.ResponseWriter, r *http.Request, params ExportMedicationAdministrationsParams)
+	// Create a schedule from a FHIR MedicationRequest
+	// (POST /fhir/MedicationRequest)
+	ImportMedicationRequest(w http.ResponseWriter, r *http.Request, params ImportMedicationRequestParams)
+	// Export the user's schedules as FHIR MedicationStatements
+	// (GET /fhir/MedicationStatement)
+	ExportMedicationStatements(w http.ResponseWriter, r *http.Request, params ExportMedicationStatementsParams)
+	// Import data exported by GET /export
+	// (POST /import)
+	ImportUserData(w http.ResponseWriter, r *http.Request, params ImportUserDataParams)
+	// Search the medicine catalog
+	// (GET /medicines)
+	SearchMedicines(w http.ResponseWriter, r *http.Request, params SearchMedicinesParams)
 	// Get next takings for user
 	// (GET /next_takings)
 	GetNextTakings(w http.ResponseWriter, r *http.Request, params GetNextTakingsParams)
+	// Get the preferences of the user
+	// (GET /profile)
+	GetProfile(w http.ResponseWriter, r *http.Request, params GetProfileParams)
+	// Saves the preferences of the user
+	// (PUT /profile)
+	SetProfile(w http.ResponseWriter, r *http.Request)
+	// Get the role of the user
+	// (GET /roles)
+	GetRole(w http.ResponseWriter, r *http.Request, params GetRoleParams)
+	// Assigns a role to the user, only admins may do it
+	// (PUT /roles)
+	SetRole(w http.ResponseWriter, r *http.Request)
 	// Get schedule by id
 	// (GET /schedule)
 	GetSchedule(w http.ResponseWriter, r *http.Request, params GetScheduleParams)
 	// Creates new schedule
 	// (POST /schedule)
 	CreateSchedule(w http.ResponseWriter, r *http.Request)
+	// Updates the schedule
+	// (PUT /schedule)
+	UpdateSchedule(w http.ResponseWriter, r *http.Request, params UpdateScheduleParams)
+	// Get the missed-dose escalation rule of the schedule
+	// (GET /schedule/escalation)
+	GetEscalationRule(w http.ResponseWriter, r *http.Request, params GetEscalationRuleParams)
+	// Sets the missed-dose escalation rule of the schedule
+	// (PUT /schedule/escalation)
+	SetEscalationRule(w http.ResponseWriter, r *http.Request)
+	// Get the audit log of changes of the schedule and its intakes
+	// (GET /schedule/history)
+	GetScheduleHistory(w http.ResponseWriter, r *http.Request, params GetScheduleHistoryParams)
+	// Get projected supply of the medicine pack
+	// (GET /schedule/inventory)
+	GetInventory(w http.ResponseWriter, r *http.Request, params GetInventoryParams)
+	// Get a page of the user's schedules with all their details
+	// (GET /schedule/list)
+	ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams)
+	// Pauses or resumes the schedule
+	// (POST /schedule/pause)
+	PauseSchedule(w http.ResponseWriter, r *http.Request)
+	// Records a refill of the medicine pack
+	// (POST /schedule/refill)
+	RecordRefill(w http.ResponseWriter, r *http.Request)
 	// Get all schedules for user
 	// (GET /schedules)
 	GetScheduleIDs(w http.ResponseWriter, r *http.Request, params GetScheduleIDsParams)
+	// Export the user's schedules as an iCalendar file
+	// (GET /schedules.ics)
+	ExportSchedulesICS(w http.ResponseWriter, r *http.Request, params ExportSchedulesICSParams)
+	// Import schedules from an iCalendar file
+	// (POST /schedules.ics)
+	ImportSchedulesICS(w http.ResponseWriter, r *http.Request, params ImportSchedulesICSParams)
+	// Create several schedules at once
+	// (POST /schedules:batch)
+	CreateSchedules(w http.ResponseWriter, r *http.Request)
+	// Records that a planned taking was taken
+	// (POST /taking/intake)
+	RecordIntake(w http.ResponseWriter, r *http.Request)
+	// Snoozes the reminder of a planned taking
+	// (POST /taking/snooze)
+	SnoozeTaking(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Revokes an API key
+// (DELETE /api-keys)
+func (_ Unimplemented) RevokeAPIKey(w http.ResponseWriter, r *http.Request, params RevokeAPIKeyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get API keys of the user
+// (GET /api-keys)
+func (_ Unimplemented) GetAPIKeys(w http.ResponseWriter, r *http.Request, params GetAPIKeysParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Creates an API key for a service acting as the user
+// (POST /api-keys)
+func (_ Unimplemented) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all takings of the user's schedules over a range of days
+// (GET /calendar)
+func (_ Unimplemented) GetCalendar(w http.ResponseWriter, r *http.Request, params GetCalendarParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke the feed of the user's schedules
+// (DELETE /calendar/feed)
+func (_ Unimplemented) RevokeCalendarFeed(w http.ResponseWriter, r *http.Request, params RevokeCalendarFeedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a secret-token feed of the user's schedules
+// (POST /calendar/feed)
+func (_ Unimplemented) CreateCalendarFeed(w http.ResponseWriter, r *http.Request, params CreateCalendarFeedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unlinks a caregiver from the user
+// (DELETE /caregivers)
+func (_ Unimplemented) UnlinkCaregiver(w http.ResponseWriter, r *http.Request, params UnlinkCaregiverParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get caregivers linked to the user
+// (GET /caregivers)
+func (_ Unimplemented) GetCaregivers(w http.ResponseWriter, r *http.Request, params GetCaregiversParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Links a caregiver to the user
+// (POST /caregivers)
+func (_ Unimplemented) LinkCaregiver(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Changes the permissions of a linked caregiver
+// (PUT /caregivers)
+func (_ Unimplemented) UpdateCaregiver(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get users the caregiver is linked to
+// (GET /dependants)
+func (_ Unimplemented) GetDependants(w http.ResponseWriter, r *http.Request, params GetDependantsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Removes the limit the user set, the catalog one applies again
+// (DELETE /dose-limits)
+func (_ Unimplemented) DeleteDoseLimit(w http.ResponseWriter, r *http.Request, params DeleteDoseLimitParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the maximum daily doses the user set
+// (GET /dose-limits)
+func (_ Unimplemented) GetDoseLimits(w http.ResponseWriter, r *http.Request, params GetDoseLimitsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sets the maximum daily dose of the ingredient of a medicine
+// (PUT /dose-limits)
+func (_ Unimplemented) SetDoseLimit(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export all data of the user
+// (GET /export)
+func (_ Unimplemented) ExportUserData(w http.ResponseWriter, r *http.Request, params ExportUserDataParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the schedules of the feed owner as an iCalendar file
+// (GET /feeds/{token}.ics)
+func (_ Unimplemented) GetCalendarFeed(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export the user's intakes as FHIR MedicationAdministrations
+// (GET /fhir/MedicationAdministration)
+func (_ Unimplemented) ExportMedicationAdministrations(w http.ResponseWriter, r *http.Request, params ExportMedicationAdministrationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a schedule from a FHIR MedicationRequest
+// (POST /fhir/MedicationRequest)
+func (_ Unimplemented) ImportMedicationRequest(w http.ResponseWriter, r *http.Request, params ImportMedicationRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export the user's schedules as FHIR MedicationStatements
+// (GET /fhir/MedicationStatement)
+func (_ Unimplemented) ExportMedicationStatements(w http.ResponseWriter, r *http.Request, params ExportMedicationStatementsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import data exported by GET /export
+// (POST /import)
+func (_ Unimplemented) ImportUserData(w http.ResponseWriter, r *http.Request, params ImportUserDataParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search the medicine catalog
+// (GET /medicines)
+func (_ Unimplemented) SearchMedicines(w http.ResponseWriter, r *http.Request, params SearchMedicinesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get next takings for user
 // (GET /next_takings)
 func (_ Unimplemented) GetNextTakings(w http.ResponseWriter, r *http.Request, params GetNextTakingsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get schedule by id
-// (GET /schedule)
-func (_ Unimplemented) GetSchedule(w http.ResponseWriter, r *http.Request, params GetScheduleParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// Get the preferences of the user
+// (GET /profile)
+func (_ Unimplemented) GetProfile(w http.ResponseWriter, r *http.Request, params GetProfileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Saves the preferences of the user
+// (PUT /profile)
+func (_ Unimplemented) SetProfile(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the role of the user
+// (GET /roles)
+func (_ Unimplemented) GetRole(w http.ResponseWriter, r *http.Request, params GetRoleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Assigns a role to the user, only admins may do it
+// (PUT /roles)
+func (_ Unimplemented) SetRole(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get schedule by id
+// (GET /schedule)
+func (_ Unimplemented) GetSchedule(w http.ResponseWriter, r *http.Request, params GetScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Creates new schedule
+// (POST /schedule)
+func (_ Unimplemented) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Updates the schedule
+// (PUT /schedule)
+func (_ Unimplemented) UpdateSchedule(w http.ResponseWriter, r *http.Request, params UpdateScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the missed-dose escalation rule of the schedule
+// (GET /schedule/escalation)
+func (_ Unimplemented) GetEscalationRule(w http.ResponseWriter, r *http.Request, params GetEscalationRuleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sets the missed-dose escalation rule of the schedule
+// (PUT /schedule/escalation)
+func (_ Unimplemented) SetEscalationRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the audit log of changes of the schedule and its intakes
+// (GET /schedule/history)
+func (_ Unimplemented) GetScheduleHistory(w http.ResponseWriter, r *http.Request, params GetScheduleHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get projected supply of the medicine pack
+// (GET /schedule/inventory)
+func (_ Unimplemented) GetInventory(w http.ResponseWriter, r *http.Request, params GetInventoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a page of the user's schedules with all their details
+// (GET /schedule/list)
+func (_ Unimplemented) ListSchedules(w http.ResponseWriter, r *http.Request, params ListSchedulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Pauses or resumes the schedule
+// (POST /schedule/pause)
+func (_ Unimplemented) PauseSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Records a refill of the medicine pack
+// (POST /schedule/refill)
+func (_ Unimplemented) RecordRefill(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all schedules for user
+// (GET /schedules)
+func (_ Unimplemented) GetScheduleIDs(w http.ResponseWriter, r *http.Request, params GetScheduleIDsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export the user's schedules as an iCalendar file
+// (GET /schedules.ics)
+func (_ Unimplemented) ExportSchedulesICS(w http.ResponseWriter, r *http.Request, params ExportSchedulesICSParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Import schedules from an iCalendar file
+// (POST /schedules.ics)
+func (_ Unimplemented) ImportSchedulesICS(w http.ResponseWriter, r *http.Request, params ImportSchedulesICSParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create several schedules at once
+// (POST /schedules:batch)
+func (_ Unimplemented) CreateSchedules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Records that a planned taking was taken
+// (POST /taking/intake)
+func (_ Unimplemented) RecordIntake(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Snoozes the reminder of a planned taking
+// (POST /taking/snooze)
+func (_ Unimplemented) SnoozeTaking(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// RevokeAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevokeAPIKeyParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "key_id" -------------
+
+	if paramValue := r.URL.Query().Get("key_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "key_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "key_id", r.URL.Query(), &params.KeyId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeAPIKey(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAPIKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPIKeysParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIKeys(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAPIKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendar(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RevokeCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevokeCalendarFeedParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeCalendarFeed(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) CreateCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateCalendarFeedParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCalendarFeed(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UnlinkCaregiver operation middleware
+func (siw *ServerInterfaceWrapper) UnlinkCaregiver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnlinkCaregiverParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "caregiver_id" -------------
+
+	if paramValue := r.URL.Query().Get("caregiver_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "caregiver_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "caregiver_id", r.URL.Query(), &params.CaregiverId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "caregiver_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnlinkCaregiver(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCaregivers operation middleware
+func (siw *ServerInterfaceWrapper) GetCaregivers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCaregiversParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCaregivers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// LinkCaregiver operation middleware
+func (siw *ServerInterfaceWrapper) LinkCaregiver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LinkCaregiver(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateCaregiver operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCaregiver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCaregiver(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDependants operation middleware
+func (siw *ServerInterfaceWrapper) GetDependants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDependantsParams
+
+	// ------------- Required query parameter "caregiver_id" -------------
+
+	if paramValue := r.URL.Query().Get("caregiver_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "caregiver_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "caregiver_id", r.URL.Query(), &params.CaregiverId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "caregiver_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDependants(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteDoseLimit operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDoseLimit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDoseLimitParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "ingredient" -------------
+
+	if paramValue := r.URL.Query().Get("ingredient"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ingredient"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "ingredient", r.URL.Query(), &params.Ingredient)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ingredient", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDoseLimit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDoseLimits operation middleware
+func (siw *ServerInterfaceWrapper) GetDoseLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDoseLimitsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDoseLimits(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetDoseLimit operation middleware
+func (siw *ServerInterfaceWrapper) SetDoseLimit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetDoseLimit(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportUserData operation middleware
+func (siw *ServerInterfaceWrapper) ExportUserData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportUserDataParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportUserData(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCalendarFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCalendarFeed(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportMedicationAdministrations operation middleware
+func (siw *ServerInterfaceWrapper) ExportMedicationAdministrations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportMedicationAdministrationsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportMedicationAdministrations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportMedicationRequest operation middleware
+func (siw *ServerInterfaceWrapper) ImportMedicationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportMedicationRequestParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportMedicationRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportMedicationStatements operation middleware
+func (siw *ServerInterfaceWrapper) ExportMedicationStatements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportMedicationStatementsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportMedicationStatements(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportUserData operation middleware
+func (siw *ServerInterfaceWrapper) ImportUserData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportUserDataParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "policy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "policy", r.URL.Query(), &params.Policy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policy", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportUserData(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SearchMedicines operation middleware
+func (siw *ServerInterfaceWrapper) SearchMedicines(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchMedicinesParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchMedicines(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetNextTakings operation middleware
+func (siw *ServerInterfaceWrapper) GetNextTakings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNextTakingsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "within" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "within", r.URL.Query(), &params.Within)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "within", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNextTakings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProfile(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetProfile operation middleware
+func (siw *ServerInterfaceWrapper) SetProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRole operation middleware
+func (siw *ServerInterfaceWrapper) GetRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRoleParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRole(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetRole operation middleware
+func (siw *ServerInterfaceWrapper) SetRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetRole(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScheduleParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "schedule_id" -------------
+
+	if paramValue := r.URL.Query().Get("schedule_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "schedule_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "schedule_id", r.URL.Query(), &params.ScheduleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schedule_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchedule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSchedule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateScheduleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSchedule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetEscalationRule operation middleware
+func (siw *ServerInterfaceWrapper) GetEscalationRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEscalationRuleParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "schedule_id" -------------
+
+	if paramValue := r.URL.Query().Get("schedule_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "schedule_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "schedule_id", r.URL.Query(), &params.ScheduleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schedule_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEscalationRule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SetEscalationRule operation middleware
+func (siw *ServerInterfaceWrapper) SetEscalationRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetEscalationRule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetScheduleHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduleHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScheduleHistoryParams
+
+	// ------------- Required query parameter "schedule_id" -------------
+
+	if paramValue := r.URL.Query().Get("schedule_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "schedule_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "schedule_id", r.URL.Query(), &params.ScheduleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schedule_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScheduleHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetInventory operation middleware
+func (siw *ServerInterfaceWrapper) GetInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInventoryParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "schedule_id" -------------
+
+	if paramValue := r.URL.Query().Get("schedule_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "schedule_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "schedule_id", r.URL.Query(), &params.ScheduleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schedule_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInventory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListSchedules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSchedulesParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
 
-// Creates new schedule
-// (POST /schedule)
-func (_ Unimplemented) CreateSchedule(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "status" -------------
 
-// Get all schedules for user
-// (GET /schedules)
-func (_ Unimplemented) GetScheduleIDs(w http.ResponseWriter, r *http.Request, params GetScheduleIDsParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "medicine_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "medicine_prefix", r.URL.Query(), &params.MedicinePrefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "medicine_prefix", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSchedules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// PauseSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PauseSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseSchedule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// RecordRefill operation middleware
+func (siw *ServerInterfaceWrapper) RecordRefill(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// GetNextTakings operation middleware
-func (siw *ServerInterfaceWrapper) GetNextTakings(w http.ResponseWriter, r *http.Request) {
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordRefill(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetScheduleIDs operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduleIDs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetNextTakingsParams
+	var params GetScheduleIDsParams
 
 	// ------------- Required query parameter "user_id" -------------
 
@@ -89,7 +1865,7 @@ func (siw *ServerInterfaceWrapper) GetNextTakings(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNextTakings(w, r, params)
+		siw.Handler.GetScheduleIDs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -99,14 +1875,18 @@ func (siw *ServerInterfaceWrapper) GetNextTakings(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSchedule operation middleware
-func (siw *ServerInterfaceWrapper) GetSchedule(w http.ResponseWriter, r *http.Request) {
+// ExportSchedulesICS operation middleware
+func (siw *ServerInterfaceWrapper) ExportSchedulesICS(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetScheduleParams
+	var params ExportSchedulesICSParams
 
 	// ------------- Required query parameter "user_id" -------------
 
@@ -123,23 +1903,55 @@ func (siw *ServerInterfaceWrapper) GetSchedule(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Required query parameter "schedule_id" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportSchedulesICS(w, r, params)
+	}))
 
-	if paramValue := r.URL.Query().Get("schedule_id"); paramValue != "" {
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportSchedulesICS operation middleware
+func (siw *ServerInterfaceWrapper) ImportSchedulesICS(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportSchedulesICSParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "schedule_id"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "schedule_id", r.URL.Query(), &params.ScheduleId)
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schedule_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSchedule(w, r, params)
+		siw.Handler.ImportSchedulesICS(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -149,12 +1961,16 @@ func (siw *ServerInterfaceWrapper) GetSchedule(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateSchedule operation middleware
-func (siw *ServerInterfaceWrapper) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+// CreateSchedules operation middleware
+func (siw *ServerInterfaceWrapper) CreateSchedules(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSchedule(w, r)
+		siw.Handler.CreateSchedules(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -164,32 +1980,35 @@ func (siw *ServerInterfaceWrapper) CreateSchedule(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetScheduleIDs operation middleware
-func (siw *ServerInterfaceWrapper) GetScheduleIDs(w http.ResponseWriter, r *http.Request) {
+// RecordIntake operation middleware
+func (siw *ServerInterfaceWrapper) RecordIntake(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetScheduleIDsParams
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// ------------- Required query parameter "user_id" -------------
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordIntake(w, r)
+	}))
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
-	}
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SnoozeTaking operation middleware
+func (siw *ServerInterfaceWrapper) SnoozeTaking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetScheduleIDs(w, r, params)
+		siw.Handler.SnoozeTaking(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -312,18 +2131,132 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api-keys", wrapper.RevokeAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api-keys", wrapper.GetAPIKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api-keys", wrapper.CreateAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendar", wrapper.GetCalendar)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/calendar/feed", wrapper.RevokeCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/calendar/feed", wrapper.CreateCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/caregivers", wrapper.UnlinkCaregiver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/caregivers", wrapper.GetCaregivers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/caregivers", wrapper.LinkCaregiver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/caregivers", wrapper.UpdateCaregiver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/dependants", wrapper.GetDependants)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/dose-limits", wrapper.DeleteDoseLimit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/dose-limits", wrapper.GetDoseLimits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/dose-limits", wrapper.SetDoseLimit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export", wrapper.ExportUserData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/feeds/{token}.ics", wrapper.GetCalendarFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/fhir/MedicationAdministration", wrapper.ExportMedicationAdministrations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/fhir/MedicationRequest", wrapper.ImportMedicationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/fhir/MedicationStatement", wrapper.ExportMedicationStatements)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/import", wrapper.ImportUserData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/medicines", wrapper.SearchMedicines)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/next_takings", wrapper.GetNextTakings)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/profile", wrapper.GetProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/profile", wrapper.SetProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/roles", wrapper.GetRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/roles", wrapper.SetRole)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/schedule", wrapper.GetSchedule)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/schedule", wrapper.CreateSchedule)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/schedule", wrapper.UpdateSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedule/escalation", wrapper.GetEscalationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/schedule/escalation", wrapper.SetEscalationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedule/history", wrapper.GetScheduleHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedule/inventory", wrapper.GetInventory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedule/list", wrapper.ListSchedules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schedule/pause", wrapper.PauseSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schedule/refill", wrapper.RecordRefill)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/schedules", wrapper.GetScheduleIDs)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedules.ics", wrapper.ExportSchedulesICS)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schedules.ics", wrapper.ImportSchedulesICS)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/schedules:batch", wrapper.CreateSchedules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/taking/intake", wrapper.RecordIntake)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/taking/snooze", wrapper.SnoozeTaking)
+	})
 
 	return r
 }
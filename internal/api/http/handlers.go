@@ -1,41 +1,111 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
 	"net/http"
-	"pills-taking-reminder/internal/api/dto"
 	api "pills-taking-reminder/internal/api/http/generated"
+	apiv2 "pills-taking-reminder/internal/api/http/generated/v2"
+	"pills-taking-reminder/internal/domain/entities"
 	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/i18n"
 	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 type ScheduleHandler struct {
-	scheduleUseCase *usecase.ScheduleUseCase
-	logger          *slog.Logger
-	validate        *validator.Validate
+	scheduleUseCase    *usecase.ScheduleUseCase
+	inventoryUseCase   *usecase.InventoryUseCase
+	reminderUseCase    *usecase.ReminderUseCase
+	intakeUseCase      *usecase.IntakeUseCase
+	caregiverUseCase   *usecase.CaregiverUseCase
+	apiKeyUseCase      *usecase.APIKeyUseCase
+	roleUseCase        *usecase.RoleUseCase
+	calendarUseCase    *usecase.CalendarUseCase
+	exportUseCase      *usecase.ExportUseCase
+	medicineUseCase    *usecase.MedicineUseCase
+	doseLimitUseCase   *usecase.DoseLimitUseCase
+	profileUseCase     *usecase.ProfileUseCase
+	idempotencyUseCase *usecase.IdempotencyUseCase
+	logger             *slog.Logger
+	validate           *validator.Validate
 }
 
-func NewScheduleHandler(useCase *usecase.ScheduleUseCase, logger *slog.Logger) *ScheduleHandler {
+func NewScheduleHandler(useCases usecase.UseCases, logger *slog.Logger) *ScheduleHandler {
 	return &ScheduleHandler{
-		scheduleUseCase: useCase,
-		logger:          logger,
-		validate:        validator.New(),
+		scheduleUseCase:    useCases.Schedule,
+		inventoryUseCase:   useCases.Inventory,
+		reminderUseCase:    useCases.Reminder,
+		intakeUseCase:      useCases.Intake,
+		caregiverUseCase:   useCases.Caregiver,
+		apiKeyUseCase:      useCases.APIKey,
+		roleUseCase:        useCases.Role,
+		calendarUseCase:    useCases.Calendar,
+		exportUseCase:      useCases.Export,
+		medicineUseCase:    useCases.Medicine,
+		doseLimitUseCase:   useCases.DoseLimit,
+		profileUseCase:     useCases.Profile,
+		idempotencyUseCase: useCases.Idempotency,
+		logger:             logger,
+		validate:           problem.NewValidator(),
 	}
 }
 
 func (h *ScheduleHandler) RegisterRoutes(r chi.Router) {
+	// The last middleware runs first: the locale is selected, then the scope
+	// is checked before the idempotency key is reserved.
+	var middlewares []api.MiddlewareFunc
+	if h.idempotencyUseCase != nil {
+		middlewares = append(middlewares, mw.HTTPIdempotencyMiddleware(h.idempotencyUseCase, h.logger))
+	}
+	var profiles mw.LocaleStore
+	if h.profileUseCase != nil {
+		profiles = h.profileUseCase
+	}
+	middlewares = append(middlewares,
+		mw.HTTPScopeMiddleware(scopes, h.logger),
+		mw.HTTPLocaleMiddleware(profiles, h.logger))
+
 	handler := api.HandlerWithOptions(h, api.ChiServerOptions{
-		BaseRouter: r,
+		BaseRouter:       r,
+		Middlewares:      middlewares,
+		ErrorHandlerFunc: h.paramError,
+	})
+
+	// The second version is served under /v2 with the same middlewares.
+	middlewaresV2 := make([]apiv2.MiddlewareFunc, len(middlewares))
+	for i, middleware := range middlewares {
+		middlewaresV2[i] = apiv2.MiddlewareFunc(middleware)
+	}
+	apiv2.HandlerWithOptions(&handlerV2{h: h}, apiv2.ChiServerOptions{
+		BaseRouter:       r,
+		BaseURL:          "/v2",
+		Middlewares:      middlewaresV2,
+		ErrorHandlerFunc: h.paramError,
 	})
 	r.Mount("/", handler)
 }
 
 func (h *ScheduleHandler) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	id, _, ok := h.createSchedule(w, r)
+	if !ok {
+		return
+	}
+	h.respondWithJSON(w, http.StatusOK, id)
+}
+
+// createSchedule creates the schedule for the handlers of all the API
+// versions, the request body is the same in all of them.
+func (h *ScheduleHandler) createSchedule(w http.ResponseWriter, r *http.Request) (int64, []usecase.InteractionWarning, bool) {
 	ctx := r.Context()
 	traceID := mw.GetTraceID(ctx)
 
@@ -44,16 +114,17 @@ func (h *ScheduleHandler) CreateSchedule(w http.ResponseWriter, r *http.Request)
 		h.logger.Error("failed to decode request body",
 			slog.String("error", err.Error()),
 			slog.String("trace_id", traceID))
-		h.respondWithError(w, http.StatusBadRequest, "Invalid request format")
-		return
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return 0, nil, false
 	}
 
 	if err := h.validate.Struct(req); err != nil {
 		h.logger.Error("validation failed",
 			slog.String("error", err.Error()),
 			slog.String("trace_id", traceID))
-		h.respondWithError(w, http.StatusBadRequest, "Invalid request parameters")
-		return
+		h.respondWithProblem(w, problem.New(http.StatusBadRequest, "Invalid request parameters").
+			WithErrors(problem.FieldErrors(err, i18n.FromContext(ctx))))
+		return 0, nil, false
 	}
 	duration := 0
 	if req.Duration != nil {
@@ -66,8 +137,17 @@ func (h *ScheduleHandler) CreateSchedule(w http.ResponseWriter, r *http.Request)
 		Duration:     duration,
 		UserID:       req.UserId,
 	}
+	if req.MedicineId != nil {
+		input.MedicineID = *req.MedicineId
+	}
+	if req.Dose != nil {
+		input.Dose = *req.Dose
+	}
+	if req.OverrideInteractions != nil {
+		input.OverrideInteractions = *req.OverrideInteractions
+	}
 
-	id, err := h.scheduleUseCase.CreateSchedule(ctx, input)
+	id, warnings, err := h.scheduleUseCase.CreateSchedule(ctx, input)
 	if err != nil {
 		h.logger.Error("failed to create schedule",
 			slog.String("error", err.Error()),
@@ -75,55 +155,255 @@ func (h *ScheduleHandler) CreateSchedule(w http.ResponseWriter, r *http.Request)
 
 		switch {
 		case errors.Is(err, usecase.ErrInvalidInput):
-			h.respondWithError(w, http.StatusBadRequest, "Invalid input parameters")
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
 		case errors.Is(err, usecase.ErrScheduleExists):
-			h.respondWithError(w, http.StatusConflict, "Schedule already exists")
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Schedule already exists").
+				WithCode(problem.CodeScheduleExists))
+		case errors.Is(err, usecase.ErrMedicineNotFound):
+			h.respondWithProblem(w, problem.New(http.StatusNotFound, "Medicine was not found").
+				WithCode(problem.CodeMedicineNotFound))
+		case errors.Is(err, usecase.ErrContraindicated):
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Medicine is contraindicated with another one").
+				WithCode(problem.CodeInteractionContraindicated))
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			h.respondWithProblem(w, problem.New(http.StatusUnprocessableEntity, "Daily dose exceeds the limit").
+				WithCode(problem.CodeDailyDoseExceeded))
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			h.respondWithProblem(w, separationProblem(r.Context(), err))
 		default:
-			h.respondWithJSON(w, http.StatusInternalServerError, "Failed to create schedule")
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to create schedule")
 		}
-		return
+		return 0, nil, false
 	}
 
 	h.logger.Info("schedule was created successfully!",
+		slog.Int("warnings", len(warnings)),
 		slog.String("trace_id", traceID))
-	h.respondWithJSON(w, http.StatusOK, id)
-
+	return id, warnings, true
 }
 
 func (h *ScheduleHandler) GetSchedule(w http.ResponseWriter, r *http.Request, params api.GetScheduleParams) {
+	schedule, ok := h.getSchedule(w, r, params.UserId, params.ScheduleId)
+	if !ok {
+		return
+	}
+	h.respondWithJSON(w, http.StatusOK, scheduleResponse(schedule))
+}
+
+// getSchedule gets the schedule for the handlers of all the API versions,
+// responding with the error and the ETag of the schedule.
+func (h *ScheduleHandler) getSchedule(w http.ResponseWriter, r *http.Request, userID, scheduleID int64) (*usecase.ScheduleOutput, bool) {
 	ctx := r.Context()
 	traceID := mw.GetTraceID(ctx)
 
-	schedule, err := h.scheduleUseCase.GetSchedule(ctx, params.UserId, params.ScheduleId)
+	schedule, err := h.scheduleUseCase.GetSchedule(ctx, userID, scheduleID)
 	if err != nil {
 		h.logger.Error("failed to get schedule for user",
 			slog.String("error", err.Error()),
 			slog.String("trace_id", traceID),
-			slog.Int64("user_id", params.UserId),
-			slog.Int64("schedule_id", params.ScheduleId))
+			slog.Int64("user_id", userID),
+			slog.Int64("schedule_id", scheduleID))
 		switch {
 		case errors.Is(err, usecase.ErrScheduleNotFound):
-			h.respondWithError(w, http.StatusNotFound, "Schedule was not found")
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Schedule was not found")
 		case errors.Is(err, usecase.ErrInvalidInput):
-			h.respondWithError(w, http.StatusBadRequest, "Invalid input parameters")
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
 		default:
-			h.respondWithError(w, http.StatusInternalServerError, "Failed to get schedule")
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get schedule")
 		}
+		return nil, false
+	}
+
+	h.logger.Info("successfully got schedule info",
+		slog.String("trace_id", traceID))
+	w.Header().Set("ETag", scheduleETag(schedule.Version))
+	return schedule, true
+}
+
+func (h *ScheduleHandler) UpdateSchedule(w http.ResponseWriter, r *http.Request, params api.UpdateScheduleParams) {
+	schedule, ok := h.updateSchedule(w, r, params.IfMatch)
+	if !ok {
 		return
 	}
+	h.respondWithJSON(w, http.StatusOK, scheduleResponse(schedule))
+}
 
-	response := api.ScheduleResponse{
-		Id:           &schedule.ID,
-		MedicineName: &schedule.MedicineName,
-		StartDate:    &schedule.StartDate,
-		EndDate:      &schedule.EndDate,
-		UserId:       &schedule.UserID,
-		TakingTime:   &schedule.TakingTimes,
+// updateSchedule updates the schedule for the handlers of all the API
+// versions, the request body is the same in all of them.
+func (h *ScheduleHandler) updateSchedule(w http.ResponseWriter, r *http.Request, ifMatch *string) (*usecase.ScheduleOutput, bool) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.UpdateScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return nil, false
 	}
 
-	h.logger.Info("successfully got schedule info",
+	if err := h.validate.Struct(req); err != nil {
+		h.logger.Error("validation failed",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithProblem(w, problem.New(http.StatusBadRequest, "Invalid request parameters").
+			WithErrors(problem.FieldErrors(err, i18n.FromContext(ctx))))
+		return nil, false
+	}
+
+	duration := 0
+	if req.Duration != nil {
+		duration = *req.Duration
+	}
+
+	input := usecase.UpdateScheduleInput{
+		ScheduleID:   req.ScheduleId,
+		MedicineName: req.MedicineName,
+		Frequency:    req.Frequency,
+		Duration:     duration,
+		UserID:       req.UserId,
+	}
+	if req.MedicineId != nil {
+		input.MedicineID = *req.MedicineId
+	}
+	if req.Dose != nil {
+		input.Dose = *req.Dose
+	}
+	if req.OverrideInteractions != nil {
+		input.OverrideInteractions = *req.OverrideInteractions
+	}
+	switch {
+	case ifMatch != nil:
+		version, ok := parseScheduleETag(*ifMatch)
+		if !ok {
+			h.respondWithProblem(w, problem.New(http.StatusPreconditionFailed, "Schedule was modified since the given version").
+				WithCode(problem.CodeScheduleModified))
+			return nil, false
+		}
+		input.Version = version
+	case req.Version != nil:
+		input.Version = *req.Version
+	}
+
+	schedule, err := h.scheduleUseCase.UpdateSchedule(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to update schedule",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId),
+			slog.Int64("schedule_id", req.ScheduleId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrMedicineNotFound):
+			h.respondWithProblem(w, problem.New(http.StatusNotFound, "Medicine was not found").
+				WithCode(problem.CodeMedicineNotFound))
+		case errors.Is(err, usecase.ErrScheduleExists):
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Schedule already exists").
+				WithCode(problem.CodeScheduleExists))
+		case errors.Is(err, usecase.ErrVersionRequired):
+			h.respondWithProblem(w, problem.New(http.StatusPreconditionRequired, "Schedule version is required").
+				WithCode(problem.CodeVersionRequired))
+		case errors.Is(err, usecase.ErrScheduleModified):
+			h.respondWithProblem(w, problem.New(http.StatusPreconditionFailed, "Schedule was modified since the given version").
+				WithCode(problem.CodeScheduleModified))
+		case errors.Is(err, usecase.ErrContraindicated):
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Medicine is contraindicated with another one").
+				WithCode(problem.CodeInteractionContraindicated))
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			h.respondWithProblem(w, problem.New(http.StatusUnprocessableEntity, "Daily dose exceeds the limit").
+				WithCode(problem.CodeDailyDoseExceeded))
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			h.respondWithProblem(w, separationProblem(r.Context(), err))
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to update schedule")
+		}
+		return nil, false
+	}
+
+	h.logger.Info("schedule was updated successfully",
+		slog.Int("warnings", len(schedule.Warnings)),
 		slog.String("trace_id", traceID))
-	h.respondWithJSON(w, http.StatusOK, response)
+	w.Header().Set("ETag", scheduleETag(schedule.Version))
+	return schedule, true
+}
+
+func (h *ScheduleHandler) PauseSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.PauseScheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		h.logger.Error("validation failed",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithProblem(w, problem.New(http.StatusBadRequest, "Invalid request parameters").
+			WithErrors(problem.FieldErrors(err, i18n.FromContext(ctx))))
+		return
+	}
+
+	input := usecase.PauseScheduleInput{
+		ScheduleID: req.ScheduleId,
+		UserID:     req.UserId,
+		Paused:     req.Paused,
+	}
+	if req.OverrideInteractions != nil {
+		input.OverrideInteractions = *req.OverrideInteractions
+	}
+
+	schedule, err := h.scheduleUseCase.SetSchedulePaused(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to pause schedule",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId),
+			slog.Int64("schedule_id", req.ScheduleId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrScheduleModified):
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Schedule was modified since the given version").
+				WithCode(problem.CodeScheduleModified))
+		case errors.Is(err, usecase.ErrContraindicated):
+			h.respondWithProblem(w, problem.New(http.StatusConflict, "Medicine is contraindicated with another one").
+				WithCode(problem.CodeInteractionContraindicated))
+		case errors.Is(err, usecase.ErrDailyDoseExceeded):
+			h.respondWithProblem(w, problem.New(http.StatusUnprocessableEntity, "Daily dose exceeds the limit").
+				WithCode(problem.CodeDailyDoseExceeded))
+		case errors.Is(err, usecase.ErrSeparationUnsatisfiable):
+			h.respondWithProblem(w, separationProblem(ctx, err))
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to pause schedule")
+		}
+		return
+	}
+
+	h.logger.Info("schedule was paused successfully",
+		slog.Bool("paused", schedule.Paused),
+		slog.String("trace_id", traceID))
+	w.Header().Set("ETag", scheduleETag(schedule.Version))
+	h.respondWithJSON(w, http.StatusOK, scheduleResponse(schedule))
 }
 
 func (h *ScheduleHandler) GetScheduleIDs(w http.ResponseWriter, r *http.Request, params api.GetScheduleIDsParams) {
@@ -139,9 +419,11 @@ func (h *ScheduleHandler) GetScheduleIDs(w http.ResponseWriter, r *http.Request,
 
 		switch {
 		case errors.Is(err, usecase.ErrInvalidInput):
-			h.respondWithError(w, http.StatusBadRequest, "Invalid request parameters")
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
 		default:
-			h.respondWithError(w, http.StatusInternalServerError, "Failed to get schedule IDs")
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get schedule IDs")
 		}
 		return
 	}
@@ -152,22 +434,86 @@ func (h *ScheduleHandler) GetScheduleIDs(w http.ResponseWriter, r *http.Request,
 	h.respondWithJSON(w, http.StatusOK, ids)
 }
 
-func (h *ScheduleHandler) GetNextTakings(w http.ResponseWriter, r *http.Request, params api.GetNextTakingsParams) {
+func (h *ScheduleHandler) ListSchedules(w http.ResponseWriter, r *http.Request, params api.ListSchedulesParams) {
+	input := usecase.ListSchedulesInput{
+		UserID: params.UserId,
+	}
+	if params.Status != nil {
+		input.Status = string(*params.Status)
+	}
+	if params.MedicinePrefix != nil {
+		input.MedicinePrefix = *params.MedicinePrefix
+	}
+	if params.From != nil {
+		input.From = &params.From.Time
+	}
+	if params.To != nil {
+		input.To = &params.To.Time
+	}
+	if params.Sort != nil {
+		input.Sort = string(*params.Sort)
+	}
+	if params.Order != nil {
+		input.Descending = *params.Order == api.Desc
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 {
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+			return
+		}
+		input.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		input.Cursor = *params.Cursor
+	}
+
+	list, ok := h.listSchedules(w, r, input)
+	if !ok {
+		return
+	}
+
+	schedules := make([]api.ScheduleResponse, len(list.Schedules))
+	for i := range list.Schedules {
+		schedules[i] = scheduleResponse(&list.Schedules[i])
+	}
+	response := api.ScheduleList{
+		Schedules: &schedules,
+	}
+	if list.NextCursor != "" {
+		response.NextCursor = &list.NextCursor
+	}
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func (h *ScheduleHandler) listSchedules(w http.ResponseWriter, r *http.Request, input usecase.ListSchedulesInput) (*usecase.ScheduleListOutput, bool) {
 	ctx := r.Context()
 	traceID := mw.GetTraceID(ctx)
 
-	takings, err := h.scheduleUseCase.GetNextTakings(ctx, params.UserId)
+	list, err := h.scheduleUseCase.ListSchedules(ctx, input)
 	if err != nil {
-		h.logger.Error("failed to get next takings for user",
+		h.logger.Error("failed to list schedules for user",
 			slog.String("error", err.Error()),
 			slog.String("trace_id", traceID),
-			slog.Int64("user_id", params.UserId))
+			slog.Int64("user_id", input.UserID))
 		switch {
 		case errors.Is(err, usecase.ErrInvalidInput):
-			h.respondWithError(w, http.StatusBadRequest, "Invalid input parameters")
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
 		default:
-			h.respondWithError(w, http.StatusInternalServerError, "Failed to get next takings")
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to list schedules")
 		}
+		return nil, false
+	}
+
+	h.logger.Info("successfully listed schedules",
+		slog.String("trace_id", traceID))
+	return list, true
+}
+
+func (h *ScheduleHandler) GetNextTakings(w http.ResponseWriter, r *http.Request, params api.GetNextTakingsParams) {
+	takings, ok := h.nextTakings(w, r, params.UserId, params.Within, params.Limit)
+	if !ok {
 		return
 	}
 
@@ -176,12 +522,96 @@ func (h *ScheduleHandler) GetNextTakings(w http.ResponseWriter, r *http.Request,
 		response[i] = api.Taking{
 			MedicineName: &taking.MedicineName,
 			TakingTime:   &taking.TakingTime,
+			TakingDate:   &openapi_types.Date{Time: taking.At},
 		}
 	}
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func (h *ScheduleHandler) nextTakings(w http.ResponseWriter, r *http.Request, userID int64,
+	within *string, limit *int) ([]usecase.TakingOutput, bool) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+	locale := i18n.FromContext(ctx)
+
+	input := usecase.NextTakingsInput{UserID: userID}
+	if within != nil {
+		duration, err := time.ParseDuration(*within)
+		if err != nil || duration <= 0 {
+			h.respondWithProblem(w, problem.New(http.StatusBadRequest, "Invalid request parameters").
+				WithErrors([]problem.FieldError{{Field: "within", Code: "format", Message: i18n.T(locale, "has invalid format")}}))
+			return nil, false
+		}
+		input.Within = duration
+	}
+	if limit != nil {
+		if *limit < 1 {
+			h.respondWithProblem(w, problem.New(http.StatusBadRequest, "Invalid request parameters").
+				WithErrors([]problem.FieldError{{Field: "limit", Code: "gte", Message: i18n.Tf(locale, "must be at least %s", "1")}}))
+			return nil, false
+		}
+		input.Limit = *limit
+	}
+
+	takings, err := h.scheduleUseCase.GetNextTakings(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to get next takings for user",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", userID))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get next takings")
+		}
+		return nil, false
+	}
 
 	h.logger.Info("successfully got next takings!",
 		slog.String("trace_id", traceID))
-	h.respondWithJSON(w, http.StatusOK, response)
+	return takings, true
+}
+
+func scheduleResponse(schedule *usecase.ScheduleOutput) api.ScheduleResponse {
+	response := api.ScheduleResponse{
+		Id:           &schedule.ID,
+		MedicineName: &schedule.MedicineName,
+		StartDate:    &schedule.StartDate,
+		EndDate:      &schedule.EndDate,
+		UserId:       &schedule.UserID,
+		TakingTime:   &schedule.TakingTimes,
+		Version:      &schedule.Version,
+		Paused:       &schedule.Paused,
+	}
+	if schedule.MedicineID != "" {
+		response.MedicineId = &schedule.MedicineID
+	}
+	if schedule.Dose > 0 {
+		response.Dose = &schedule.Dose
+	}
+	return response
+}
+
+// scheduleETag is the strong entity tag of the schedule version.
+func scheduleETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseScheduleETag returns the version of the If-Match value. Weak tags and
+// lists of tags never match.
+func parseScheduleETag(value string) (int64, bool) {
+	value = strings.TrimSpace(value)
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return 0, false
+	}
+	version, err := strconv.ParseInt(value[1:len(value)-1], 10, 64)
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+	return version, true
 }
 
 func (h *ScheduleHandler) respondWithJSON(w http.ResponseWriter, code int, payload any) {
@@ -198,6 +628,68 @@ func (h *ScheduleHandler) respondWithJSON(w http.ResponseWriter, code int, paylo
 	}
 }
 
-func (h *ScheduleHandler) respondWithError(w http.ResponseWriter, code int, message string) {
-	h.respondWithJSON(w, code, dto.ErrorResponse{Error: message})
+// respondWithError writes the problem of the status with the stable code
+// clients tell the errors apart by.
+func (h *ScheduleHandler) respondWithError(w http.ResponseWriter, status int, code, message string) {
+	h.respondWithProblem(w, problem.New(status, message).WithCode(code))
+}
+
+// separationProblem names the medicine whose takings the taking times can't
+// be kept apart from.
+func separationProblem(ctx context.Context, err error) *problem.Problem {
+	detail := "Taking times can't be kept apart"
+	var separationErr *entities.SeparationError
+	if errors.As(err, &separationErr) {
+		detail = i18n.Tf(i18n.FromContext(ctx), "Taking times can't be kept %g h apart from %s between %02d:00 and %02d:00",
+			separationErr.Constraint.MinSeparation.Hours(), separationErr.Constraint.MedicineName,
+			entities.WakingStartHour, entities.WakingEndHour)
+	}
+	return problem.New(http.StatusUnprocessableEntity, detail).WithCode(problem.CodeSeparationUnsatisfiable)
+}
+
+// paramError reports the missing and malformed parameters, which the
+// generated router rejects before the handlers run.
+func (h *ScheduleHandler) paramError(w http.ResponseWriter, r *http.Request, err error) {
+	h.logger.Error("invalid request parameters",
+		slog.String("error", err.Error()),
+		slog.String("trace_id", mw.GetTraceID(r.Context())))
+
+	var (
+		required   *api.RequiredParamError
+		format     *api.InvalidParamFormatError
+		requiredV2 *apiv2.RequiredParamError
+		formatV2   *apiv2.InvalidParamFormatError
+		field      problem.FieldError
+	)
+	switch {
+	case errors.As(err, &required):
+		field = problem.FieldError{Field: required.ParamName, Code: "required", Message: "is required"}
+	case errors.As(err, &format):
+		field = problem.FieldError{Field: format.ParamName, Code: "format", Message: "has invalid format"}
+	case errors.As(err, &requiredV2):
+		field = problem.FieldError{Field: requiredV2.ParamName, Code: "required", Message: "is required"}
+	case errors.As(err, &formatV2):
+		field = problem.FieldError{Field: formatV2.ParamName, Code: "format", Message: "has invalid format"}
+	default:
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request parameters")
+		return
+	}
+	h.respondWithProblem(w, problem.New(http.StatusBadRequest, "Invalid request parameters").
+		WithErrors([]problem.FieldError{field}))
+}
+
+// respondWithProblem writes the problem with the trace ID and in the locale of
+// the request, which the middlewares set on the response before the handlers
+// run.
+func (h *ScheduleHandler) respondWithProblem(w http.ResponseWriter, p *problem.Problem) {
+	if p.TraceID == "" {
+		p.TraceID = w.Header().Get("X-TRACE-ID")
+	}
+	locale, ok := i18n.Parse(w.Header().Get("Content-Language"))
+	if !ok {
+		locale = i18n.Default
+	}
+	if err := problem.Write(w, p.Localize(locale)); err != nil {
+		h.logger.Error("failed to write response into writer", slog.String("error", err.Error()))
+	}
 }
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) RecordIntake(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.RecordIntakeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	input := usecase.IntakeInput{
+		UserID:     req.UserId,
+		ScheduleID: req.ScheduleId,
+		PlannedAt:  req.PlannedAt,
+	}
+	if req.TakenAt != nil {
+		input.TakenAt = *req.TakenAt
+	}
+
+	intake, err := h.intakeUseCase.RecordIntake(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to record intake",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId),
+			slog.Int64("schedule_id", req.ScheduleId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrTakingNotPlanned):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Taking is not planned at this time")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrIntakeExists):
+			h.respondWithError(w, http.StatusConflict, problem.CodeConflict, "Intake already recorded")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to record intake")
+		}
+		return
+	}
+
+	response := api.IntakeResponse{
+		Id:           &intake.ID,
+		ScheduleId:   &intake.ScheduleID,
+		MedicineName: &intake.MedicineName,
+		PlannedAt:    &intake.PlannedAt,
+		TakenAt:      &intake.TakenAt,
+	}
+
+	h.logger.Info("intake was recorded successfully",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, response)
+}
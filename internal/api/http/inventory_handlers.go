@@ -0,0 +1,105 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) RecordRefill(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.RecordRefillJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	dose := 0
+	if req.DosePerTaking != nil {
+		dose = *req.DosePerTaking
+	}
+
+	input := usecase.RefillInput{
+		UserID:        req.UserId,
+		ScheduleID:    req.ScheduleId,
+		Quantity:      req.Quantity,
+		DosePerTaking: dose,
+	}
+
+	inventory, err := h.inventoryUseCase.RecordRefill(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to record refill",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId),
+			slog.Int64("schedule_id", req.ScheduleId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Schedule was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to record refill")
+		}
+		return
+	}
+
+	h.logger.Info("refill was recorded successfully",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, inventoryResponse(inventory))
+}
+
+func (h *ScheduleHandler) GetInventory(w http.ResponseWriter, r *http.Request, params api.GetInventoryParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	inventory, err := h.inventoryUseCase.GetInventory(ctx, params.UserId, params.ScheduleId)
+	if err != nil {
+		h.logger.Error("failed to get inventory",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId),
+			slog.Int64("schedule_id", params.ScheduleId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrPackNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Pack was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get inventory")
+		}
+		return
+	}
+
+	h.logger.Info("successfully got inventory",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, inventoryResponse(inventory))
+}
+
+func inventoryResponse(inventory *usecase.InventoryOutput) api.InventoryResponse {
+	return api.InventoryResponse{
+		ScheduleId:    &inventory.ScheduleID,
+		MedicineName:  &inventory.MedicineName,
+		Quantity:      &inventory.Quantity,
+		DosePerTaking: &inventory.DosePerTaking,
+		Remaining:     &inventory.Remaining,
+		DaysLeft:      &inventory.DaysLeft,
+		RefilledAt:    &inventory.RefilledAt,
+	}
+}
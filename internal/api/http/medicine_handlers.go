@@ -0,0 +1,57 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) SearchMedicines(w http.ResponseWriter, r *http.Request, params api.SearchMedicinesParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	limit := 0
+	if params.Limit != nil {
+		if *params.Limit < 1 {
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+			return
+		}
+		limit = *params.Limit
+	}
+
+	medicines, err := h.medicineUseCase.SearchMedicines(ctx, params.Q, limit)
+	if err != nil {
+		h.logger.Error("failed to search medicines",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.String("query", params.Q))
+		if errors.Is(err, usecase.ErrInvalidInput) {
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+			return
+		}
+		h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to search medicines")
+		return
+	}
+
+	response := make([]api.Medicine, len(medicines))
+	for i, m := range medicines {
+		response[i] = api.Medicine{
+			Id:         m.ID,
+			Name:       m.Name,
+			Ingredient: m.Ingredient,
+			Synonyms:   m.Synonyms,
+		}
+		if m.MaxDailyDose > 0 {
+			response[i].MaxDailyDose = &medicines[i].MaxDailyDose
+		}
+	}
+
+	h.logger.Info("successfully searched medicines",
+		slog.String("trace_id", traceID),
+		slog.Int("count", len(response)))
+	h.respondWithJSON(w, http.StatusOK, response)
+}
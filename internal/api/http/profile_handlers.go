@@ -0,0 +1,86 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) SetProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.SetProfileJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	profile, err := h.profileUseCase.SetProfile(ctx, usecase.ProfileInput{
+		UserID: req.UserId,
+		Locale: string(req.Locale),
+	})
+	if err != nil {
+		h.logger.Error("failed to set profile",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to set profile")
+		}
+		return
+	}
+
+	h.logger.Info("profile was set successfully",
+		slog.String("trace_id", traceID),
+		slog.Int64("user_id", profile.UserID),
+		slog.String("locale", profile.Locale))
+	h.respondWithJSON(w, http.StatusOK, profileResponse(profile))
+}
+
+func (h *ScheduleHandler) GetProfile(w http.ResponseWriter, r *http.Request, params api.GetProfileParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	profile, err := h.profileUseCase.GetProfile(ctx, params.UserId)
+	if err != nil {
+		h.logger.Error("failed to get profile",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get profile")
+		}
+		return
+	}
+
+	h.logger.Info("successfully got profile",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, profileResponse(profile))
+}
+
+func profileResponse(profile *usecase.ProfileOutput) api.Profile {
+	locale := api.Locale(profile.Locale)
+	return api.Profile{
+		UserId: &profile.UserID,
+		Locale: &locale,
+	}
+}
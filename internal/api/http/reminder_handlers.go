@@ -0,0 +1,159 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+	"time"
+)
+
+func (h *ScheduleHandler) SnoozeTaking(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.SnoozeTakingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	input := usecase.SnoozeInput{
+		UserID:     req.UserId,
+		ScheduleID: req.ScheduleId,
+		PlannedAt:  req.PlannedAt,
+	}
+
+	taking, err := h.reminderUseCase.SnoozeTaking(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to snooze taking",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId),
+			slog.Int64("schedule_id", req.ScheduleId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrTakingNotPlanned):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Taking is not planned at this time")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Schedule was not found")
+		case errors.Is(err, usecase.ErrSnoozeLimit):
+			h.respondWithError(w, http.StatusConflict, problem.CodeConflict, "Snooze limit reached, taking marked as missed")
+		case errors.Is(err, usecase.ErrTakingClosed):
+			h.respondWithError(w, http.StatusConflict, problem.CodeConflict, "Taking is already closed")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to snooze taking")
+		}
+		return
+	}
+
+	status := api.TakingStateResponseStatus(taking.Status)
+	response := api.TakingStateResponse{
+		ScheduleId:   &taking.ScheduleID,
+		MedicineName: &taking.MedicineName,
+		PlannedAt:    &taking.PlannedAt,
+		Status:       &status,
+		SnoozeCount:  &taking.SnoozeCount,
+		SnoozedUntil: taking.SnoozedUntil,
+	}
+
+	h.logger.Info("taking was snoozed successfully",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func (h *ScheduleHandler) SetEscalationRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.SetEscalationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	input := usecase.EscalationInput{
+		UserID:           req.UserId,
+		ScheduleID:       req.ScheduleId,
+		GracePeriod:      time.Duration(req.GraceMinutes) * time.Minute,
+		CaregiverDelay:   time.Duration(req.CaregiverDelayMinutes) * time.Minute,
+		NotifyCaregivers: req.NotifyCaregivers,
+	}
+
+	rule, err := h.reminderUseCase.SetEscalationRule(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to set escalation rule",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId),
+			slog.Int64("schedule_id", req.ScheduleId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Schedule was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to set escalation rule")
+		}
+		return
+	}
+
+	h.logger.Info("escalation rule was set successfully",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, escalationResponse(rule))
+}
+
+func (h *ScheduleHandler) GetEscalationRule(w http.ResponseWriter, r *http.Request, params api.GetEscalationRuleParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	rule, err := h.reminderUseCase.GetEscalationRule(ctx, params.UserId, params.ScheduleId)
+	if err != nil {
+		h.logger.Error("failed to get escalation rule",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId),
+			slog.Int64("schedule_id", params.ScheduleId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		case errors.Is(err, usecase.ErrScheduleNotFound):
+			h.respondWithError(w, http.StatusNotFound, problem.CodeNotFound, "Schedule was not found")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get escalation rule")
+		}
+		return
+	}
+
+	h.logger.Info("successfully got escalation rule",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, escalationResponse(rule))
+}
+
+func escalationResponse(rule *usecase.EscalationOutput) api.EscalationResponse {
+	graceMinutes := int(rule.GracePeriod / time.Minute)
+	delayMinutes := int(rule.CaregiverDelay / time.Minute)
+	return api.EscalationResponse{
+		ScheduleId:            &rule.ScheduleID,
+		GraceMinutes:          &graceMinutes,
+		CaregiverDelayMinutes: &delayMinutes,
+		NotifyCaregivers:      &rule.NotifyCaregivers,
+	}
+}
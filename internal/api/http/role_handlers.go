@@ -0,0 +1,83 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/mw"
+	"pills-taking-reminder/pkg/problem"
+)
+
+func (h *ScheduleHandler) SetRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	var req api.SetRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("failed to decode request body",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID))
+		h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+		return
+	}
+
+	role, err := h.roleUseCase.SetRole(ctx, req.UserId, string(req.Role))
+	if err != nil {
+		h.logger.Error("failed to set role",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", req.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to set role")
+		}
+		return
+	}
+
+	h.logger.Info("role was set successfully",
+		slog.String("trace_id", traceID),
+		slog.Int64("user_id", role.UserID),
+		slog.String("role", role.Role))
+	h.respondWithJSON(w, http.StatusOK, roleResponse(role))
+}
+
+func (h *ScheduleHandler) GetRole(w http.ResponseWriter, r *http.Request, params api.GetRoleParams) {
+	ctx := r.Context()
+	traceID := mw.GetTraceID(ctx)
+
+	role, err := h.roleUseCase.GetRole(ctx, params.UserId)
+	if err != nil {
+		h.logger.Error("failed to get role",
+			slog.String("error", err.Error()),
+			slog.String("trace_id", traceID),
+			slog.Int64("user_id", params.UserId))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidInput):
+			h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			h.respondWithError(w, http.StatusForbidden, problem.CodePermissionDenied, "Permission denied")
+		default:
+			h.respondWithError(w, http.StatusInternalServerError, problem.CodeInternal, "Failed to get role")
+		}
+		return
+	}
+
+	h.logger.Info("successfully got role",
+		slog.String("trace_id", traceID))
+	h.respondWithJSON(w, http.StatusOK, roleResponse(role))
+}
+
+func roleResponse(role *usecase.RoleOutput) api.Role {
+	name := api.RoleName(role.Role)
+	return api.Role{
+		UserId: &role.UserID,
+		Role:   &name,
+	}
+}
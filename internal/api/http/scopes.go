@@ -0,0 +1,50 @@
+package http
+
+import "pills-taking-reminder/internal/domain/entities"
+
+// PublicPaths are served without credentials. Feeds are authorized by the
+// secret token in their path.
+var PublicPaths = []string{"/feeds/"}
+
+// scopes lists the API key scope each endpoint requires. Endpoints missing
+// here, like the management of API keys, are not available to API keys.
+var scopes = map[string]string{
+	"POST /schedule":                     string(entities.ScopeSchedulesWrite),
+	"PUT /schedule":                      string(entities.ScopeSchedulesWrite),
+	"POST /schedule/pause":               string(entities.ScopeSchedulesWrite),
+	"GET /schedule":                      string(entities.ScopeSchedulesRead),
+	"GET /schedules":                     string(entities.ScopeSchedulesRead),
+	"GET /next_takings":                  string(entities.ScopeSchedulesRead),
+	"POST /schedule/refill":              string(entities.ScopeInventoryWrite),
+	"GET /schedule/inventory":            string(entities.ScopeInventoryRead),
+	"POST /taking/snooze":                string(entities.ScopeRemindersWrite),
+	"POST /taking/intake":                string(entities.ScopeIntakesWrite),
+	"POST /caregivers":                   string(entities.ScopeCaregiversWrite),
+	"PUT /caregivers":                    string(entities.ScopeCaregiversWrite),
+	"DELETE /caregivers":                 string(entities.ScopeCaregiversWrite),
+	"GET /caregivers":                    string(entities.ScopeCaregiversRead),
+	"GET /dependants":                    string(entities.ScopeCaregiversRead),
+	"PUT /schedule/escalation":           string(entities.ScopeRemindersWrite),
+	"GET /schedule/escalation":           string(entities.ScopeRemindersRead),
+	"GET /schedule/history":              string(entities.ScopeSchedulesRead),
+	"GET /schedule/list":                 string(entities.ScopeSchedulesRead),
+	"GET /calendar":                      string(entities.ScopeSchedulesRead),
+	"GET /schedules.ics":                 string(entities.ScopeSchedulesRead),
+	"POST /schedules.ics":                string(entities.ScopeSchedulesWrite),
+	"GET /export":                        string(entities.ScopeSchedulesRead),
+	"POST /import":                       string(entities.ScopeSchedulesWrite),
+	"POST /fhir/MedicationRequest":       string(entities.ScopeSchedulesWrite),
+	"POST /schedules:batch":              string(entities.ScopeSchedulesWrite),
+	"GET /fhir/MedicationStatement":      string(entities.ScopeSchedulesRead),
+	"GET /fhir/MedicationAdministration": string(entities.ScopeSchedulesRead),
+	"GET /medicines":                     string(entities.ScopeSchedulesRead),
+	"GET /dose-limits":                   string(entities.ScopeSchedulesRead),
+	"PUT /dose-limits":                   string(entities.ScopeSchedulesWrite),
+	"DELETE /dose-limits":                string(entities.ScopeSchedulesWrite),
+
+	"POST /v2/schedule":     string(entities.ScopeSchedulesWrite),
+	"GET /v2/schedule":      string(entities.ScopeSchedulesRead),
+	"PUT /v2/schedule":      string(entities.ScopeSchedulesWrite),
+	"GET /v2/schedule/list": string(entities.ScopeSchedulesRead),
+	"GET /v2/next_takings":  string(entities.ScopeSchedulesRead),
+}
@@ -0,0 +1,155 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	apiv2 "pills-taking-reminder/internal/api/http/generated/v2"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/pkg/problem"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// handlerV2 serves the /v2 endpoints, the schedules and the takings with
+// typed dates. It shares the work and the errors with the first version.
+type handlerV2 struct {
+	h *ScheduleHandler
+}
+
+func (v *handlerV2) CreateSchedule(w http.ResponseWriter, r *http.Request) {
+	id, warnings, ok := v.h.createSchedule(w, r)
+	if !ok {
+		return
+	}
+
+	response := apiv2.ScheduleCreated{
+		Id:       id,
+		Warnings: interactionWarningsV2(warnings),
+	}
+	v.h.respondWithJSON(w, http.StatusCreated, response)
+}
+
+func (v *handlerV2) GetSchedule(w http.ResponseWriter, r *http.Request, params apiv2.GetScheduleParams) {
+	schedule, ok := v.h.getSchedule(w, r, params.UserId, params.ScheduleId)
+	if !ok {
+		return
+	}
+	v.h.respondWithJSON(w, http.StatusOK, scheduleV2(schedule))
+}
+
+func (v *handlerV2) UpdateSchedule(w http.ResponseWriter, r *http.Request, params apiv2.UpdateScheduleParams) {
+	schedule, ok := v.h.updateSchedule(w, r, params.IfMatch)
+	if !ok {
+		return
+	}
+	v.h.respondWithJSON(w, http.StatusOK, scheduleV2(schedule))
+}
+
+func (v *handlerV2) ListSchedules(w http.ResponseWriter, r *http.Request, params apiv2.ListSchedulesParams) {
+	input := usecase.ListSchedulesInput{
+		UserID: params.UserId,
+	}
+	if params.Status != nil {
+		input.Status = string(*params.Status)
+	}
+	if params.MedicinePrefix != nil {
+		input.MedicinePrefix = *params.MedicinePrefix
+	}
+	if params.From != nil {
+		input.From = &params.From.Time
+	}
+	if params.To != nil {
+		input.To = &params.To.Time
+	}
+	if params.Sort != nil {
+		input.Sort = string(*params.Sort)
+	}
+	if params.Order != nil {
+		input.Descending = *params.Order == apiv2.Desc
+	}
+	if params.Limit != nil {
+		if *params.Limit < 1 {
+			v.h.respondWithError(w, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid input parameters")
+			return
+		}
+		input.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		input.Cursor = *params.Cursor
+	}
+
+	list, ok := v.h.listSchedules(w, r, input)
+	if !ok {
+		return
+	}
+
+	response := apiv2.ScheduleList{
+		Schedules: make([]apiv2.Schedule, len(list.Schedules)),
+	}
+	for i := range list.Schedules {
+		response.Schedules[i] = scheduleV2(&list.Schedules[i])
+	}
+	if list.NextCursor != "" {
+		response.NextCursor = &list.NextCursor
+	}
+	v.h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func (v *handlerV2) GetNextTakings(w http.ResponseWriter, r *http.Request, params apiv2.GetNextTakingsParams) {
+	takings, ok := v.h.nextTakings(w, r, params.UserId, params.Within, params.Limit)
+	if !ok {
+		return
+	}
+
+	response := make([]apiv2.Taking, len(takings))
+	for i, taking := range takings {
+		response[i] = apiv2.Taking{
+			MedicineName: taking.MedicineName,
+			TakingTime:   taking.At,
+		}
+	}
+	v.h.respondWithJSON(w, http.StatusOK, response)
+}
+
+func scheduleV2(schedule *usecase.ScheduleOutput) apiv2.Schedule {
+	response := apiv2.Schedule{
+		Id:           schedule.ID,
+		MedicineName: schedule.MedicineName,
+		StartDate:    openapi_types.Date{Time: schedule.Start},
+		UserId:       schedule.UserID,
+		TakingTimes:  make([]string, len(schedule.Times)),
+		Version:      schedule.Version,
+		Paused:       schedule.Paused,
+	}
+	if schedule.End != nil {
+		response.EndDate = &openapi_types.Date{Time: *schedule.End}
+	}
+	if schedule.MedicineID != "" {
+		response.MedicineId = &schedule.MedicineID
+	}
+	if schedule.Dose > 0 {
+		response.Dose = &schedule.Dose
+	}
+	for i, takingTime := range schedule.Times {
+		response.TakingTimes[i] = fmt.Sprintf("%02d:%02d", takingTime.Hour(), takingTime.Minute())
+	}
+	if schedule.Warnings != nil {
+		warnings := interactionWarningsV2(schedule.Warnings)
+		response.Warnings = &warnings
+	}
+	return response
+}
+
+func interactionWarningsV2(warnings []usecase.InteractionWarning) []apiv2.InteractionWarning {
+	response := make([]apiv2.InteractionWarning, len(warnings))
+	for i, warning := range warnings {
+		response[i] = apiv2.InteractionWarning{
+			ScheduleId:   warning.ScheduleID,
+			MedicineId:   warning.MedicineID,
+			MedicineName: warning.MedicineName,
+			Severity:     apiv2.InteractionWarningSeverity(warning.Severity),
+			Description:  warning.Description,
+		}
+	}
+	return response
+}
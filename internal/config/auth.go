@@ -0,0 +1,52 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// minHMACSecretLength is the size of the SHA-256 output, a shorter HS256
+// secret is easier to guess than the signature.
+const minHMACSecretLength = 32
+
+// placeholderSecrets are the secrets of examples which must not sign tokens.
+var placeholderSecrets = []string{"change-me", "changeme", "secret", "your-secret"}
+
+var (
+	ErrPlaceholderHMACSecret = errors.New("auth.hmac_secret is a placeholder")
+	ErrShortHMACSecret       = errors.New("auth.hmac_secret must be at least 32 bytes")
+)
+
+// Auth configures verification of the JWT bearer tokens. HS256 tokens are
+// checked with HMACSecret and RS256 tokens with the keys of JWKSFile, an empty
+// HMACSecret leaves HS256 off.
+type Auth struct {
+	Enabled    bool          `yaml:"enabled" env:"AUTH_ENABLED" env-default:"false"`
+	HMACSecret string        `yaml:"hmac_secret" env:"AUTH_HMAC_SECRET"`
+	JWKSFile   string        `yaml:"jwks_file" env:"AUTH_JWKS_FILE"`
+	Issuer     string        `yaml:"issuer"`
+	Audience   string        `yaml:"audience"`
+	Leeway     time.Duration `yaml:"leeway" env-default:"30s"`
+	// AdminIDs are given the admin role on start, admins assign the other roles.
+	AdminIDs []int64 `yaml:"admin_ids" env:"AUTH_ADMIN_IDS" env-separator:","`
+}
+
+// Validate refuses to verify HS256 tokens with a placeholder or a short secret.
+func (a Auth) Validate() error {
+	if !a.Enabled || a.HMACSecret == "" {
+		return nil
+	}
+
+	secret := strings.ToLower(strings.TrimSpace(a.HMACSecret))
+	for _, placeholder := range placeholderSecrets {
+		if secret == placeholder {
+			return ErrPlaceholderHMACSecret
+		}
+	}
+	if len(a.HMACSecret) < minHMACSecretLength {
+		return ErrShortHMACSecret
+	}
+
+	return nil
+}
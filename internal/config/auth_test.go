@@ -0,0 +1,31 @@
+package config_test
+
+import (
+	"errors"
+	"pills-taking-reminder/internal/config"
+	"strings"
+	"testing"
+)
+
+func TestAuthValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		auth     config.Auth
+		expected error
+	}{
+		{name: "disabled", auth: config.Auth{HMACSecret: "change-me"}},
+		{name: "without HS256", auth: config.Auth{Enabled: true}},
+		{name: "placeholder", auth: config.Auth{Enabled: true, HMACSecret: "change-me"}, expected: config.ErrPlaceholderHMACSecret},
+		{name: "padded placeholder", auth: config.Auth{Enabled: true, HMACSecret: " Change-Me "}, expected: config.ErrPlaceholderHMACSecret},
+		{name: "short", auth: config.Auth{Enabled: true, HMACSecret: "0123456789"}, expected: config.ErrShortHMACSecret},
+		{name: "strong", auth: config.Auth{Enabled: true, HMACSecret: strings.Repeat("k", 32)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.auth.Validate(); !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
@@ -14,6 +14,9 @@ type Config struct {
 	HTTPServer
 	GRPCServer
 	DB
+	Reminder           `yaml:"reminder"`
+	Auth               `yaml:"auth"`
+	Idempotency        `yaml:"idempotency"`
 	NearTakingInterval time.Duration `yaml:"near_taking_interval" env-default:"60m"`
 }
 
@@ -40,6 +43,10 @@ func MustLoad() *Config {
 		log.Fatalf("Error reading config: %v", err)
 	}
 
+	if err = cfg.Auth.Validate(); err != nil {
+		log.Fatalf("Invalid auth config: %v", err)
+	}
+
 	log.Println("config has been read successfully!")
 
 	return &cfg
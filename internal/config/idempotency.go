@@ -0,0 +1,13 @@
+package config
+
+import "time"
+
+// Idempotency configures how long the responses to requests with an
+// Idempotency-Key are replayed. Lock is how long a request may run before its
+// key can be reserved by a retry, expired responses are removed every
+// CleanupInterval.
+type Idempotency struct {
+	TTL             time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL" env-default:"24h"`
+	Lock            time.Duration `yaml:"lock" env:"IDEMPOTENCY_LOCK" env-default:"1m"`
+	CleanupInterval time.Duration `yaml:"cleanup_interval" env:"IDEMPOTENCY_CLEANUP_INTERVAL" env-default:"1h"`
+}
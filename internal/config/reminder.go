@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+type Reminder struct {
+	TickInterval        time.Duration `yaml:"tick_interval" env-default:"1m"`
+	RefillThresholdDays int           `yaml:"refill_threshold_days" env-default:"3"`
+	SnoozePeriod        time.Duration `yaml:"snooze_period" env-default:"15m"`
+	MaxSnoozes          int           `yaml:"max_snoozes" env-default:"3"`
+	MissedGracePeriod   time.Duration `yaml:"missed_grace_period" env-default:"30m"`
+	CaregiverDelay      time.Duration `yaml:"caregiver_delay" env-default:"30m"`
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidScope   = errors.New("unknown api key scope")
+	ErrNoScopes       = errors.New("api key needs at least one scope")
+	ErrEmptyKeyName   = errors.New("api key name must not be empty")
+	apiKeyPrefix      = "ptr_"
+	apiKeyPrefixChars = 12
+)
+
+type Scope string
+
+const (
+	ScopeSchedulesRead   Scope = "schedules:read"
+	ScopeSchedulesWrite  Scope = "schedules:write"
+	ScopeIntakesWrite    Scope = "intakes:write"
+	ScopeInventoryRead   Scope = "inventory:read"
+	ScopeInventoryWrite  Scope = "inventory:write"
+	ScopeRemindersRead   Scope = "reminders:read"
+	ScopeRemindersWrite  Scope = "reminders:write"
+	ScopeCaregiversRead  Scope = "caregivers:read"
+	ScopeCaregiversWrite Scope = "caregivers:write"
+)
+
+var Scopes = []Scope{
+	ScopeSchedulesRead,
+	ScopeSchedulesWrite,
+	ScopeIntakesWrite,
+	ScopeInventoryRead,
+	ScopeInventoryWrite,
+	ScopeRemindersRead,
+	ScopeRemindersWrite,
+	ScopeCaregiversRead,
+	ScopeCaregiversWrite,
+}
+
+// APIKey is a long-lived credential of a service acting as UserID. Only the
+// hash of the key is stored, Prefix is kept to tell the keys apart.
+type APIKey struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	Prefix    string
+	Hash      string
+	Scopes    []Scope
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+// NewAPIKey generates a key and returns it along with the plain text secret,
+// which can not be recovered later.
+func NewAPIKey(userID int64, name string, scopes []Scope) (*APIKey, string, error) {
+	if name == "" {
+		return nil, "", ErrEmptyKeyName
+	}
+	if len(scopes) == 0 {
+		return nil, "", ErrNoScopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(Scopes, scope) {
+			return nil, "", fmt.Errorf("%w: %s", ErrInvalidScope, scope)
+		}
+	}
+
+	random := make([]byte, 24)
+	if _, err := rand.Read(random); err != nil {
+		return nil, "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	secret := apiKeyPrefix + hex.EncodeToString(random)
+
+	key := &APIKey{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    secret[:apiKeyPrefixChars],
+		Hash:      HashAPIKey(secret),
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(scopes))),
+		CreatedAt: TimeNow(),
+	}
+
+	return key, secret, nil
+}
+
+// HashAPIKey returns the stored representation of the key. Keys are random,
+// so a plain digest is enough.
+func HashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
@@ -0,0 +1,47 @@
+package entities_test
+
+import (
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"strings"
+	"testing"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	key, secret, err := entities.NewAPIKey(1, "sync", []entities.Scope{
+		entities.ScopeSchedulesRead, entities.ScopeIntakesWrite, entities.ScopeSchedulesRead,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(secret, key.Prefix) {
+		t.Errorf("key %q does not start with prefix %q", secret, key.Prefix)
+	}
+	if key.Hash != entities.HashAPIKey(secret) || strings.Contains(key.Hash, secret) {
+		t.Errorf("unexpected hash %q", key.Hash)
+	}
+	if len(key.Scopes) != 2 {
+		t.Errorf("expected duplicate scopes to be dropped, got %v", key.Scopes)
+	}
+	if key.IsRevoked() {
+		t.Error("new key is revoked")
+	}
+
+	tests := []struct {
+		name    string
+		keyName string
+		scopes  []entities.Scope
+		wantErr error
+	}{
+		{"no name", "", []entities.Scope{entities.ScopeSchedulesRead}, entities.ErrEmptyKeyName},
+		{"no scopes", "sync", nil, entities.ErrNoScopes},
+		{"unknown scope", "sync", []entities.Scope{"schedules:delete"}, entities.ErrInvalidScope},
+	}
+
+	for _, tt := range tests {
+		if _, _, err := entities.NewAPIKey(1, tt.keyName, tt.scopes); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
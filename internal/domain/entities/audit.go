@@ -0,0 +1,99 @@
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditScheduleCreated AuditAction = "schedule.created"
+	AuditScheduleUpdated AuditAction = "schedule.updated"
+	AuditSchedulePaused  AuditAction = "schedule.paused"
+	AuditScheduleResumed AuditAction = "schedule.resumed"
+	AuditIntakeRecorded  AuditAction = "intake.recorded"
+)
+
+// AuditEntry records a change of the schedule. Before and After are JSON
+// snapshots of the changed object, Before is empty for creations.
+type AuditEntry struct {
+	ID         int64
+	ScheduleID int64
+	UserID     int64
+	// ActorID is nil when the request had no identity.
+	ActorID   *int64
+	Action    AuditAction
+	TraceID   string
+	Before    json.RawMessage
+	After     json.RawMessage
+	CreatedAt time.Time
+}
+
+func NewAuditEntry(action AuditAction, scheduleID, userID int64, actorID *int64, traceID string, before, after any) (*AuditEntry, error) {
+	entry := &AuditEntry{
+		ScheduleID: scheduleID,
+		UserID:     userID,
+		ActorID:    actorID,
+		Action:     action,
+		TraceID:    traceID,
+		CreatedAt:  TimeNow(),
+	}
+
+	var err error
+	if before != nil {
+		if entry.Before, err = json.Marshal(before); err != nil {
+			return nil, fmt.Errorf("failed to marshal snapshot: %w", err)
+		}
+	}
+	if after != nil {
+		if entry.After, err = json.Marshal(after); err != nil {
+			return nil, fmt.Errorf("failed to marshal snapshot: %w", err)
+		}
+	}
+
+	return entry, nil
+}
+
+type ScheduleSnapshot struct {
+	MedicineName string     `json:"medicine_name"`
+	MedicineID   string     `json:"medicine_id,omitempty"`
+	Dose         float64    `json:"dose,omitempty"`
+	Frequency    int        `json:"frequency"`
+	Duration     int        `json:"duration"`
+	StartDate    time.Time  `json:"start_date"`
+	EndDate      *time.Time `json:"end_date"`
+	TakingTimes  []string   `json:"taking_times"`
+	Paused       bool       `json:"paused,omitempty"`
+}
+
+func (s *Schedule) Snapshot() ScheduleSnapshot {
+	snapshot := ScheduleSnapshot{
+		MedicineName: s.MedicineName,
+		MedicineID:   s.MedicineID,
+		Dose:         s.Dose,
+		Frequency:    s.Frequency,
+		Duration:     s.Duration,
+		StartDate:    s.StartDate,
+		EndDate:      s.EndDate,
+		TakingTimes:  make([]string, len(s.TakingTimes)),
+		Paused:       s.Paused,
+	}
+	for i, tt := range s.TakingTimes {
+		snapshot.TakingTimes[i] = fmt.Sprintf("%02d:%02d", tt.Time.Hour(), tt.Time.Minute())
+	}
+	return snapshot
+}
+
+type IntakeSnapshot struct {
+	PlannedAt time.Time `json:"planned_at"`
+	TakenAt   time.Time `json:"taken_at"`
+}
+
+func (i *Intake) Snapshot() IntakeSnapshot {
+	return IntakeSnapshot{
+		PlannedAt: i.PlannedAt,
+		TakenAt:   i.TakenAt,
+	}
+}
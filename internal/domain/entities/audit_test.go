@@ -0,0 +1,30 @@
+package entities_test
+
+import (
+	"encoding/json"
+	"pills-taking-reminder/internal/domain/entities"
+	"testing"
+)
+
+func TestNewAuditEntry(t *testing.T) {
+	schedule, err := entities.NewSchedule("Aspirin", 2, 10, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entry, err := entities.NewAuditEntry(entities.AuditScheduleCreated, 5, 1, nil, "trace", nil, schedule.Snapshot())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.Before != nil {
+		t.Errorf("expected no snapshot before creation, got %s", entry.Before)
+	}
+
+	var after entities.ScheduleSnapshot
+	if err := json.Unmarshal(entry.After, &after); err != nil {
+		t.Fatalf("failed to unmarshal snapshot: %v", err)
+	}
+	if after.MedicineName != "Aspirin" || after.Frequency != 2 || len(after.TakingTimes) != 2 {
+		t.Errorf("unexpected snapshot %+v", after)
+	}
+}
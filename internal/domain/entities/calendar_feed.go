@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+var feedTokenPrefix = "feed_"
+
+// CalendarFeed is the secret-token URL of the user's schedules in iCalendar
+// format. Calendar apps can not send credentials, so the token in the URL is
+// the only authorization of the feed. Like API keys, only its hash is stored.
+type CalendarFeed struct {
+	UserID    int64
+	TokenHash string
+	CreatedAt time.Time
+}
+
+// NewCalendarFeed generates a feed token and returns it along with the plain
+// text token, which can not be recovered later.
+func NewCalendarFeed(userID int64) (*CalendarFeed, string, error) {
+	random := make([]byte, 24)
+	if _, err := rand.Read(random); err != nil {
+		return nil, "", fmt.Errorf("failed to generate feed token: %w", err)
+	}
+	token := feedTokenPrefix + hex.EncodeToString(random)
+
+	feed := &CalendarFeed{
+		UserID:    userID,
+		TokenHash: HashAPIKey(token),
+		CreatedAt: TimeNow(),
+	}
+
+	return feed, token, nil
+}
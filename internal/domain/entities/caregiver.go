@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrSelfCaregiver = errors.New("user can not be their own caregiver")
+
+type Permission int
+
+const (
+	// PermissionView allows the caregiver to read the dependant's schedules.
+	PermissionView Permission = iota + 1
+	// PermissionEdit allows the caregiver to create and change the dependant's schedules.
+	PermissionEdit
+	// PermissionAlerts makes the caregiver receive escalations about missed doses.
+	PermissionAlerts
+	// PermissionManage allows changing the user's caregivers, API keys and
+	// calendar feeds. No link grants it, only the user and admins have it.
+	PermissionManage
+)
+
+type CaregiverPermissions struct {
+	View   bool
+	Edit   bool
+	Alerts bool
+}
+
+// CaregiverLink relates the caregiver to the dependant UserID.
+type CaregiverLink struct {
+	UserID      int64
+	CaregiverID int64
+	Permissions CaregiverPermissions
+	CreatedAt   time.Time
+}
+
+func NewCaregiverLink(userID, caregiverID int64, permissions CaregiverPermissions) (*CaregiverLink, error) {
+	if userID == caregiverID {
+		return nil, ErrSelfCaregiver
+	}
+
+	return &CaregiverLink{
+		UserID:      userID,
+		CaregiverID: caregiverID,
+		Permissions: permissions,
+		CreatedAt:   TimeNow(),
+	}, nil
+}
+
+// Allows reports whether the link grants the permission. Editing implies viewing.
+func (l *CaregiverLink) Allows(permission Permission) bool {
+	switch permission {
+	case PermissionView:
+		return l.Permissions.View || l.Permissions.Edit
+	case PermissionEdit:
+		return l.Permissions.Edit
+	case PermissionAlerts:
+		return l.Permissions.Alerts
+	default:
+		return false
+	}
+}
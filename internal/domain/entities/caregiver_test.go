@@ -0,0 +1,38 @@
+package entities_test
+
+import (
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"testing"
+)
+
+func TestCaregiverLinkAllows(t *testing.T) {
+	if _, err := entities.NewCaregiverLink(1, 1, entities.CaregiverPermissions{}); !errors.Is(err, entities.ErrSelfCaregiver) {
+		t.Fatalf("expected ErrSelfCaregiver, got %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		permissions entities.CaregiverPermissions
+		view        bool
+		edit        bool
+		alerts      bool
+	}{
+		{"none", entities.CaregiverPermissions{}, false, false, false},
+		{"view only", entities.CaregiverPermissions{View: true}, true, false, false},
+		{"edit implies view", entities.CaregiverPermissions{Edit: true}, true, true, false},
+		{"alerts only", entities.CaregiverPermissions{Alerts: true}, false, false, true},
+	}
+
+	for _, tt := range tests {
+		link, err := entities.NewCaregiverLink(1, 2, tt.permissions)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if link.Allows(entities.PermissionView) != tt.view ||
+			link.Allows(entities.PermissionEdit) != tt.edit ||
+			link.Allows(entities.PermissionAlerts) != tt.alerts {
+			t.Errorf("%s: unexpected permissions of %+v", tt.name, link.Permissions)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidEscalation = errors.New("escalation periods must be more than 0")
+
+type EscalationStep int
+
+const (
+	// EscalationRemind reminds the user about the missed dose once more.
+	EscalationRemind EscalationStep = iota + 1
+	// EscalationCaregivers notifies the caregivers linked to the user.
+	EscalationCaregivers
+)
+
+// EscalationRule describes what happens when a planned taking has no intake.
+type EscalationRule struct {
+	ScheduleID       int64
+	GracePeriod      time.Duration
+	CaregiverDelay   time.Duration
+	NotifyCaregivers bool
+}
+
+func NewEscalationRule(scheduleID int64, gracePeriod, caregiverDelay time.Duration, notifyCaregivers bool) (*EscalationRule, error) {
+	if gracePeriod <= 0 || caregiverDelay <= 0 {
+		return nil, ErrInvalidEscalation
+	}
+
+	return &EscalationRule{
+		ScheduleID:       scheduleID,
+		GracePeriod:      gracePeriod,
+		CaregiverDelay:   caregiverDelay,
+		NotifyCaregivers: notifyCaregivers,
+	}, nil
+}
+
+// DueSteps returns the escalation steps which are due for the taking planned
+// at the given time.
+func (r *EscalationRule) DueSteps(plannedAt, now time.Time) []EscalationStep {
+	var steps []EscalationStep
+
+	missedAt := plannedAt.Add(r.GracePeriod)
+	if now.Before(missedAt) {
+		return nil
+	}
+	steps = append(steps, EscalationRemind)
+
+	if r.NotifyCaregivers && !now.Before(missedAt.Add(r.CaregiverDelay)) {
+		steps = append(steps, EscalationCaregivers)
+	}
+
+	return steps
+}
+
+// Lookback is how far back planned takings can still have pending steps.
+func (r *EscalationRule) Lookback() time.Duration {
+	if r.NotifyCaregivers {
+		return r.GracePeriod + r.CaregiverDelay
+	}
+	return r.GracePeriod
+}
@@ -0,0 +1,50 @@
+package entities_test
+
+import (
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"testing"
+	"time"
+)
+
+func TestEscalationRuleDueSteps(t *testing.T) {
+	if _, err := entities.NewEscalationRule(1, 0, time.Minute, true); !errors.Is(err, entities.ErrInvalidEscalation) {
+		t.Fatalf("expected ErrInvalidEscalation, got %v", err)
+	}
+
+	rule, err := entities.NewEscalationRule(1, 30*time.Minute, 15*time.Minute, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	plannedAt := time.Date(2025, 5, 11, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		now   time.Time
+		steps []entities.EscalationStep
+	}{
+		{"before grace period", plannedAt.Add(29 * time.Minute), nil},
+		{"missed", plannedAt.Add(30 * time.Minute), []entities.EscalationStep{entities.EscalationRemind}},
+		{"caregivers due", plannedAt.Add(45 * time.Minute), []entities.EscalationStep{entities.EscalationRemind, entities.EscalationCaregivers}},
+	}
+
+	for _, tt := range tests {
+		steps := rule.DueSteps(plannedAt, tt.now)
+		if len(steps) != len(tt.steps) {
+			t.Fatalf("%s: got steps %v, want %v", tt.name, steps, tt.steps)
+		}
+		for i := range steps {
+			if steps[i] != tt.steps[i] {
+				t.Fatalf("%s: got steps %v, want %v", tt.name, steps, tt.steps)
+			}
+		}
+	}
+
+	rule.NotifyCaregivers = false
+	if steps := rule.DueSteps(plannedAt, plannedAt.Add(time.Hour)); len(steps) != 1 {
+		t.Fatalf("expected only remind step without caregivers, got %v", steps)
+	}
+	if rule.Lookback() != 30*time.Minute {
+		t.Fatalf("unexpected lookback %s", rule.Lookback())
+	}
+}
@@ -0,0 +1,36 @@
+package entities
+
+import "time"
+
+// IdempotencyRecord is the response to a request sent with an idempotency
+// key, replayed to the retries of the request until it expires. The record
+// is created when the request starts and is not completed until the response
+// is stored. An uncompleted record is locked until LockedUntil, after which
+// the request is considered to have crashed and the key can be reserved again.
+type IdempotencyRecord struct {
+	// Key is the hash of the client key scoped to the actor and endpoint.
+	Key         string
+	RequestHash string
+	Completed   bool
+	StatusCode  int
+	ContentType string
+	// Headers are the replayed response headers, like ETag.
+	Headers     map[string]string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	LockedUntil time.Time
+}
+
+// NewIdempotencyRecord returns an uncompleted record of the request which
+// is locked for the lock period and expires after the ttl.
+func NewIdempotencyRecord(key, requestHash string, ttl, lock time.Duration) *IdempotencyRecord {
+	now := TimeNow()
+	return &IdempotencyRecord{
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+		LockedUntil: now.Add(lock),
+	}
+}
@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+// Intake is the event of a planned taking actually being taken.
+type Intake struct {
+	ID         int64
+	ScheduleID int64
+	UserID     int64
+	PlannedAt  time.Time
+	TakenAt    time.Time
+}
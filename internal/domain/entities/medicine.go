@@ -0,0 +1,55 @@
+package entities
+
+import "time"
+
+// Medicine is an entry of the medicine catalog. Synonyms are the other names
+// of the medicine, like brands and the names in other languages.
+type Medicine struct {
+	ID         string
+	Name       string
+	Ingredient string
+	Synonyms   []string
+	// MaxDailyDose is the most of the ingredient taken in a day in
+	// milligrams, 0 when the catalog sets no limit.
+	MaxDailyDose float64
+}
+
+// DoseLimit is the maximum daily dose of an ingredient the user set, it
+// replaces the limit of the catalog. Ingredient is the normalized name of the
+// ingredient of a catalog medicine or of a custom medicine.
+type DoseLimit struct {
+	UserID       int64
+	Ingredient   string
+	MaxDailyDose float64
+	UpdatedAt    time.Time
+}
+
+func NewDoseLimit(userID int64, ingredient string, maxDailyDose float64) *DoseLimit {
+	return &DoseLimit{
+		UserID:       userID,
+		Ingredient:   ingredient,
+		MaxDailyDose: maxDailyDose,
+		UpdatedAt:    TimeNow(),
+	}
+}
+
+// InteractionSeverity grades the risk of taking two medicines together.
+type InteractionSeverity string
+
+const (
+	SeverityMinor    InteractionSeverity = "minor"
+	SeverityModerate InteractionSeverity = "moderate"
+	SeverityMajor    InteractionSeverity = "major"
+	// SeverityContraindicated medicines must not be taken together.
+	SeverityContraindicated InteractionSeverity = "contraindicated"
+)
+
+// Interaction is a known interaction of two catalog medicines, in no order.
+type Interaction struct {
+	MedicineIDs [2]string
+	Severity    InteractionSeverity
+	Description string
+	// MinSeparation is the least time between the takings of the medicines,
+	// 0 when they may be taken together.
+	MinSeparation time.Duration
+}
@@ -0,0 +1,30 @@
+package entities
+
+import "time"
+
+type NotificationType string
+
+const (
+	NotificationTaking NotificationType = "taking"
+	NotificationRefill NotificationType = "refill"
+	// NotificationMissed reminds the user about a dose not taken in time.
+	NotificationMissed NotificationType = "missed"
+	// NotificationEscalation tells a caregiver about a dose missed by the user.
+	NotificationEscalation NotificationType = "escalation"
+)
+
+// Notification is addressed to RecipientID, which differs from the schedule
+// owner UserID for escalations to caregivers. Text is rendered in the locale
+// of the recipient when the notification is sent and is not stored.
+type Notification struct {
+	ID           int64
+	Type         NotificationType
+	UserID       int64
+	RecipientID  int64
+	ScheduleID   int64
+	MedicineName string
+	PlannedAt    time.Time
+	Attempt      int
+	CreatedAt    time.Time
+	Text         string
+}
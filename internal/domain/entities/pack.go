@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidQuantity = errors.New("quantity must be more than 0")
+	ErrInvalidDose     = errors.New("dose per taking must be more than 0")
+)
+
+type Pack struct {
+	ID             int64
+	ScheduleID     int64
+	UserID         int64
+	Quantity       int
+	DosePerTaking  int
+	RefilledAt     time.Time
+	RefillNotified bool
+}
+
+func NewPack(scheduleID, userID int64, quantity, dosePerTaking int) (*Pack, error) {
+	if quantity < 1 {
+		return nil, ErrInvalidQuantity
+	}
+
+	if dosePerTaking < 1 {
+		return nil, ErrInvalidDose
+	}
+
+	return &Pack{
+		ScheduleID:    scheduleID,
+		UserID:        userID,
+		Quantity:      quantity,
+		DosePerTaking: dosePerTaking,
+		RefilledAt:    TimeNow(),
+	}, nil
+}
+
+// Remaining projects how many units are left in the pack at the given moment,
+// assuming every planned taking since the refill has been taken.
+func (p *Pack) Remaining(s *Schedule, at time.Time) int {
+	remaining := p.Quantity - s.CountTakings(p.RefilledAt, at)*p.DosePerTaking
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// DaysLeft projects for how many days the remaining supply will last.
+func (p *Pack) DaysLeft(s *Schedule, at time.Time) float64 {
+	perDay := len(s.TakingTimes) * p.DosePerTaking
+	if perDay == 0 {
+		return 0
+	}
+	return float64(p.Remaining(s, at)) / float64(perDay)
+}
+
+func (p *Pack) NeedsRefill(s *Schedule, at time.Time, thresholdDays int) bool {
+	if p.RefillNotified || !s.IsActive(at) {
+		return false
+	}
+	return p.DaysLeft(s, at) < float64(thresholdDays)
+}
@@ -0,0 +1,99 @@
+package entities_test
+
+import (
+	"pills-taking-reminder/internal/domain/entities"
+	"testing"
+	"time"
+)
+
+func TestPackProjection(t *testing.T) {
+	refilledAt := time.Date(2025, 5, 10, 9, 0, 0, 0, time.UTC)
+
+	schedule := &entities.Schedule{
+		MedicineName: "Test Med",
+		Frequency:    2,
+		TakingTimes: []entities.TakingTime{
+			{Time: time.Date(0, 0, 0, 8, 0, 0, 0, time.UTC)},
+			{Time: time.Date(0, 0, 0, 22, 0, 0, 0, time.UTC)},
+		},
+		StartDate: refilledAt.AddDate(0, 0, -1),
+	}
+
+	tests := []struct {
+		name          string
+		quantity      int
+		dose          int
+		at            time.Time
+		notified      bool
+		wantRemaining int
+		wantDaysLeft  float64
+		wantRefill    bool
+	}{
+		{
+			name:          "right after refill",
+			quantity:      20,
+			dose:          1,
+			at:            refilledAt,
+			wantRemaining: 20,
+			wantDaysLeft:  10,
+		},
+		{
+			name:          "after two days",
+			quantity:      20,
+			dose:          1,
+			at:            time.Date(2025, 5, 12, 9, 0, 0, 0, time.UTC),
+			wantRemaining: 16,
+			wantDaysLeft:  8,
+		},
+		{
+			name:          "double dose runs low",
+			quantity:      10,
+			dose:          2,
+			at:            time.Date(2025, 5, 11, 23, 0, 0, 0, time.UTC),
+			wantRemaining: 4,
+			wantDaysLeft:  1,
+			wantRefill:    true,
+		},
+		{
+			name:          "never below zero",
+			quantity:      4,
+			dose:          1,
+			at:            time.Date(2025, 5, 20, 9, 0, 0, 0, time.UTC),
+			wantRemaining: 0,
+			wantDaysLeft:  0,
+			wantRefill:    true,
+		},
+		{
+			name:          "already notified",
+			quantity:      4,
+			dose:          1,
+			at:            time.Date(2025, 5, 20, 9, 0, 0, 0, time.UTC),
+			notified:      true,
+			wantRemaining: 0,
+			wantDaysLeft:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pack := &entities.Pack{
+				Quantity:       tt.quantity,
+				DosePerTaking:  tt.dose,
+				RefilledAt:     refilledAt,
+				RefillNotified: tt.notified,
+			}
+
+			if got := pack.Remaining(schedule, tt.at); got != tt.wantRemaining {
+				t.Errorf("Remaining() = %d, want %d", got, tt.wantRemaining)
+			}
+
+			if got := pack.DaysLeft(schedule, tt.at); got != tt.wantDaysLeft {
+				t.Errorf("DaysLeft() = %v, want %v", got, tt.wantDaysLeft)
+			}
+
+			if got := pack.NeedsRefill(schedule, tt.at, 3); got != tt.wantRefill {
+				t.Errorf("NeedsRefill() = %v, want %v", got, tt.wantRefill)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrSnoozeLimit  = errors.New("snooze limit reached")
+	ErrTakingClosed = errors.New("taking is already closed")
+)
+
+type TakingStatus string
+
+const (
+	TakingSnoozed  TakingStatus = "snoozed"
+	TakingReminded TakingStatus = "reminded"
+	TakingMissed   TakingStatus = "missed"
+	TakingTaken    TakingStatus = "taken"
+)
+
+// PlannedTaking keeps the state of one concrete dose of a schedule.
+type PlannedTaking struct {
+	ID           int64
+	ScheduleID   int64
+	UserID       int64
+	MedicineName string
+	PlannedAt    time.Time
+	Status       TakingStatus
+	SnoozeCount  int
+	SnoozedUntil *time.Time
+}
+
+func NewPlannedTaking(s *Schedule, plannedAt time.Time) *PlannedTaking {
+	return &PlannedTaking{
+		ScheduleID:   s.ID,
+		UserID:       s.UserID,
+		MedicineName: s.MedicineName,
+		PlannedAt:    plannedAt,
+		Status:       TakingReminded,
+	}
+}
+
+// Snooze postpones the reminder by the given period. Once maxSnoozes is used
+// up the taking is marked missed instead.
+func (t *PlannedTaking) Snooze(now time.Time, period time.Duration, maxSnoozes int) error {
+	if t.Status == TakingMissed || t.Status == TakingTaken {
+		return ErrTakingClosed
+	}
+
+	if t.SnoozeCount >= maxSnoozes {
+		t.Status = TakingMissed
+		t.SnoozedUntil = nil
+		return ErrSnoozeLimit
+	}
+
+	until := now.Add(period)
+	t.SnoozeCount++
+	t.SnoozedUntil = &until
+	t.Status = TakingSnoozed
+	return nil
+}
@@ -0,0 +1,85 @@
+package entities_test
+
+import (
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"testing"
+	"time"
+)
+
+func TestPlannedTakingSnooze(t *testing.T) {
+	now := time.Date(2025, 5, 11, 15, 5, 0, 0, time.UTC)
+	schedule := &entities.Schedule{
+		ID:           1,
+		UserID:       1,
+		MedicineName: "Test Med",
+		TakingTimes: []entities.TakingTime{
+			{Time: time.Date(0, 0, 0, 15, 0, 0, 0, time.UTC)},
+		},
+		StartDate: now.AddDate(0, 0, -1),
+	}
+	plannedAt := time.Date(2025, 5, 11, 15, 0, 0, 0, time.UTC)
+
+	if !schedule.IsPlannedAt(plannedAt) {
+		t.Fatalf("expected taking to be planned at %s", plannedAt)
+	}
+	if schedule.IsPlannedAt(plannedAt.Add(time.Minute)) {
+		t.Fatalf("expected no taking at %s", plannedAt.Add(time.Minute))
+	}
+
+	taking := entities.NewPlannedTaking(schedule, plannedAt)
+	maxSnoozes := 2
+
+	for i := 1; i <= maxSnoozes; i++ {
+		if err := taking.Snooze(now, 15*time.Minute, maxSnoozes); err != nil {
+			t.Fatalf("snooze %d: unexpected error: %v", i, err)
+		}
+		if taking.Status != entities.TakingSnoozed || taking.SnoozeCount != i {
+			t.Fatalf("snooze %d: got status %s and count %d", i, taking.Status, taking.SnoozeCount)
+		}
+		if want := now.Add(15 * time.Minute); !taking.SnoozedUntil.Equal(want) {
+			t.Errorf("snooze %d: expected snoozed until %s, got %s", i, want, taking.SnoozedUntil)
+		}
+	}
+
+	if err := taking.Snooze(now, 15*time.Minute, maxSnoozes); !errors.Is(err, entities.ErrSnoozeLimit) {
+		t.Fatalf("expected ErrSnoozeLimit, got %v", err)
+	}
+	if taking.Status != entities.TakingMissed || taking.SnoozedUntil != nil {
+		t.Errorf("expected taking to be missed, got status %s", taking.Status)
+	}
+
+	if err := taking.Snooze(now, 15*time.Minute, maxSnoozes); !errors.Is(err, entities.ErrTakingClosed) {
+		t.Errorf("expected ErrTakingClosed, got %v", err)
+	}
+}
+
+func TestPausedScheduleHasNoTakings(t *testing.T) {
+	now := time.Date(2025, 5, 11, 14, 0, 0, 0, time.UTC)
+	schedule := &entities.Schedule{
+		ID:           1,
+		UserID:       1,
+		MedicineName: "Test Med",
+		TakingTimes: []entities.TakingTime{
+			{Time: time.Date(0, 0, 0, 15, 0, 0, 0, time.UTC)},
+		},
+		StartDate: now.AddDate(0, 0, -1),
+		Paused:    true,
+	}
+	plannedAt := time.Date(2025, 5, 11, 15, 0, 0, 0, time.UTC)
+
+	if schedule.IsPlannedAt(plannedAt) {
+		t.Errorf("expected no taking of a paused schedule at %s", plannedAt)
+	}
+	if takings := schedule.TakingsBetween(now, now.AddDate(0, 0, 2)); len(takings) != 0 {
+		t.Errorf("expected no takings of a paused schedule, got %d", len(takings))
+	}
+	if takings := schedule.GetNextTakings(now, 24*time.Hour); len(takings) != 0 {
+		t.Errorf("expected no next takings of a paused schedule, got %d", len(takings))
+	}
+
+	schedule.Paused = false
+	if takings := schedule.TakingsBetween(now, now.AddDate(0, 0, 2)); len(takings) != 2 {
+		t.Errorf("expected the takings of the resumed schedule, got %d", len(takings))
+	}
+}
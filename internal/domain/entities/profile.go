@@ -0,0 +1,19 @@
+package entities
+
+import "time"
+
+// Profile keeps the preferences of the user. Locale selects the language of
+// the reminders and of the API responses without an Accept-Language header.
+type Profile struct {
+	UserID    int64
+	Locale    string
+	UpdatedAt time.Time
+}
+
+func NewProfile(userID int64, locale string) *Profile {
+	return &Profile{
+		UserID:    userID,
+		Locale:    locale,
+		UpdatedAt: TimeNow(),
+	}
+}
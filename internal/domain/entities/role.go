@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidRole = errors.New("unknown role")
+
+type Role string
+
+const (
+	// RolePatient is the role of users without an assigned one.
+	RolePatient   Role = "patient"
+	RoleCaregiver Role = "caregiver"
+	// RoleClinician reads the schedules of the patients who linked them, but
+	// edits them only with the patient's consent given as the edit permission.
+	RoleClinician Role = "clinician"
+	// RoleAdmin has access to the data of every user.
+	RoleAdmin Role = "admin"
+)
+
+func ParseRole(s string) (Role, error) {
+	switch role := Role(s); role {
+	case RolePatient, RoleCaregiver, RoleClinician, RoleAdmin:
+		return role, nil
+	default:
+		return "", ErrInvalidRole
+	}
+}
+
+// Allows reports whether an actor with the role, linked to the user by link,
+// has the permission on the user's data. Link is nil when there is none.
+func (r Role) Allows(link *CaregiverLink, permission Permission) bool {
+	if r == RoleAdmin {
+		return true
+	}
+	if link == nil {
+		return false
+	}
+	if r == RoleClinician && permission == PermissionView {
+		return true
+	}
+	return link.Allows(permission)
+}
+
+// AccessRecord is an entry of the audit trail of access to other users' data.
+type AccessRecord struct {
+	ActorID   int64
+	UserID    int64
+	Action    string
+	Allowed   bool
+	TraceID   string
+	CreatedAt time.Time
+}
@@ -0,0 +1,41 @@
+package entities_test
+
+import (
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"testing"
+)
+
+func TestRoleAllows(t *testing.T) {
+	if _, err := entities.ParseRole("doctor"); !errors.Is(err, entities.ErrInvalidRole) {
+		t.Fatalf("expected ErrInvalidRole, got %v", err)
+	}
+
+	noPermissions := &entities.CaregiverLink{UserID: 1, CaregiverID: 2}
+	consent := &entities.CaregiverLink{UserID: 1, CaregiverID: 2, Permissions: entities.CaregiverPermissions{Edit: true}}
+
+	tests := []struct {
+		name   string
+		role   entities.Role
+		link   *entities.CaregiverLink
+		view   bool
+		edit   bool
+		manage bool
+	}{
+		{"patient without link", entities.RolePatient, nil, false, false, false},
+		{"caregiver without permissions", entities.RoleCaregiver, noPermissions, false, false, false},
+		{"caregiver allowed to edit", entities.RoleCaregiver, consent, true, true, false},
+		{"clinician without link", entities.RoleClinician, nil, false, false, false},
+		{"clinician without consent", entities.RoleClinician, noPermissions, true, false, false},
+		{"clinician with consent", entities.RoleClinician, consent, true, true, false},
+		{"admin", entities.RoleAdmin, nil, true, true, true},
+	}
+
+	for _, tt := range tests {
+		if tt.role.Allows(tt.link, entities.PermissionView) != tt.view ||
+			tt.role.Allows(tt.link, entities.PermissionEdit) != tt.edit ||
+			tt.role.Allows(tt.link, entities.PermissionManage) != tt.manage {
+			t.Errorf("%s: unexpected access", tt.name)
+		}
+	}
+}
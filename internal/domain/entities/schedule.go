@@ -3,9 +3,18 @@ package entities
 import (
 	"errors"
 	"fmt"
+	"pills-taking-reminder/pkg/medicine"
+	"slices"
 	"time"
 )
 
+// WakingStartHour and WakingEndHour bound the waking window the taking times
+// are spread over.
+const (
+	WakingStartHour = 8
+	WakingEndHour   = 22
+)
+
 var (
 	ErrInvalidFrequency = errors.New("frequency must be between 1 and 15")
 	ErrInvalidDuration  = errors.New("duration must be more than 0")
@@ -14,12 +23,22 @@ var (
 type Schedule struct {
 	ID           int64
 	MedicineName string
-	Frequency    int
-	Duration     int
-	StartDate    time.Time
-	EndDate      *time.Time
-	UserID       int64
-	TakingTimes  []TakingTime
+	// MedicineID is the catalog entry of the medicine, empty for the custom
+	// medicines missing from the catalog.
+	MedicineID string
+	// Dose is the amount of the medicine taken at a time in milligrams, 0 when
+	// unknown.
+	Dose        float64
+	Frequency   int
+	Duration    int
+	StartDate   time.Time
+	EndDate     *time.Time
+	UserID      int64
+	TakingTimes []TakingTime
+	// Version starts at 1 and is incremented by every update of the schedule.
+	Version int64
+	// Paused schedules have no takings until they are resumed.
+	Paused bool
 }
 
 func NewSchedule(medicineName string, frequency, duration int, userID int64) (*Schedule, error) {
@@ -48,14 +67,97 @@ func NewSchedule(medicineName string, frequency, duration int, userID int64) (*S
 		EndDate:      endDate,
 		UserID:       userID,
 		TakingTimes:  takingTimes,
+		Version:      1,
 	}
 
 	return schedule, nil
 
 }
 
+// NewScheduleWithTimes creates a schedule with the given taking times instead
+// of the evenly spread ones, like the schedules imported from other apps. A nil
+// endDate makes the schedule infinite.
+func NewScheduleWithTimes(medicineName string, userID int64, startDate time.Time, endDate *time.Time,
+	takingTimes []TakingTime) (*Schedule, error) {
+	times := slices.Clone(takingTimes)
+	slices.SortFunc(times, func(a, b TakingTime) int {
+		return a.Time.Hour()*60 + a.Time.Minute() - (b.Time.Hour()*60 + b.Time.Minute())
+	})
+	times = slices.CompactFunc(times, func(a, b TakingTime) bool {
+		return a.Time.Hour() == b.Time.Hour() && a.Time.Minute() == b.Time.Minute()
+	})
+	if len(times) < 1 || len(times) > 15 {
+		return nil, ErrInvalidFrequency
+	}
+
+	startDate = time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+	duration := 0
+	if endDate != nil {
+		end := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, startDate.Location())
+		// Rounded to whole days, as a day may be shorter or longer around DST.
+		duration = int(end.Sub(startDate).Hours()+12) / 24
+		if duration < 1 {
+			return nil, ErrInvalidDuration
+		}
+		endDate = &end
+	}
+
+	return &Schedule{
+		MedicineName: medicineName,
+		Frequency:    len(times),
+		Duration:     duration,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		UserID:       userID,
+		TakingTimes:  times,
+		Version:      1,
+	}, nil
+}
+
+// Update changes the medicine, frequency and duration of the schedule keeping
+// its start date.
+func (s *Schedule) Update(medicineName string, frequency, duration int) error {
+	takingTimes, err := CalculateTakingTimes(frequency)
+	if err != nil {
+		return ErrInvalidFrequency
+	}
+
+	var endDate *time.Time
+	if duration > 0 {
+		end := s.StartDate.AddDate(0, 0, duration)
+		endDate = &end
+	}
+
+	s.MedicineName = medicineName
+	s.Frequency = frequency
+	s.Duration = duration
+	s.EndDate = endDate
+	s.TakingTimes = takingTimes
+	return nil
+}
+
+// DailyDose returns the amount of the medicine taken in a day in milligrams.
+func (s *Schedule) DailyDose() float64 {
+	return float64(len(s.TakingTimes)) * s.Dose
+}
+
+// MedicineKey identifies the medicine of the schedule, a user has one
+// schedule per key.
+func (s *Schedule) MedicineKey() string {
+	return MedicineKey(s.MedicineID, s.MedicineName)
+}
+
+// MedicineKey returns the catalog entry of the medicine, or the normalized
+// name of a custom one, so the names of the same medicine share the key.
+func MedicineKey(medicineID, medicineName string) string {
+	if medicineID != "" {
+		return "catalog:" + medicineID
+	}
+	return "name:" + medicine.Normalize(medicineName)
+}
+
 func (s *Schedule) IsActive(date time.Time) bool {
-	if date.Before(s.StartDate) {
+	if s.Paused || date.Before(s.StartDate) {
 		return false
 	}
 
@@ -91,13 +193,53 @@ func (s *Schedule) GetNextTakings(from time.Time, interval time.Duration) []Taki
 	return takings
 }
 
+// IsPlannedAt reports whether the schedule has a taking at the given moment.
+func (s *Schedule) IsPlannedAt(at time.Time) bool {
+	if !s.IsActive(at) {
+		return false
+	}
+
+	for _, takeTime := range s.TakingTimes {
+		if takeTime.Time.Hour() == at.Hour() && takeTime.Time.Minute() == at.Minute() && at.Second() == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// TakingsBetween returns the planned takings in the [from, to) range.
+func (s *Schedule) TakingsBetween(from, to time.Time) []Taking {
+	var takings []Taking
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, takeTime := range s.TakingTimes {
+			takingTime := time.Date(day.Year(), day.Month(), day.Day(), takeTime.Time.Hour(), takeTime.Time.Minute(), 0, 0, day.Location())
+
+			if takingTime.Before(from) || !takingTime.Before(to) || !s.IsActive(takingTime) {
+				continue
+			}
+			takings = append(takings, Taking{
+				MedicineName: s.MedicineName,
+				TakingTime:   takingTime,
+			})
+		}
+	}
+	return takings
+}
+
+// CountTakings returns the number of planned takings in the [from, to) range.
+func (s *Schedule) CountTakings(from, to time.Time) int {
+	return len(s.TakingsBetween(from, to))
+}
+
 func CalculateTakingTimes(frequency int) ([]TakingTime, error) {
 	if frequency < 1 || frequency > 15 {
 		return nil, fmt.Errorf("incorrect frequency")
 	}
 
-	startHour := 8
-	endHour := 22
+	startHour := WakingStartHour
+	endHour := WakingEndHour
 
 	takingTimes := make([]TakingTime, 0, frequency)
 	if frequency == 1 {
@@ -122,3 +122,62 @@ func TestCalculateTakingTimes(t *testing.T) {
 		})
 	}
 }
+
+func TestNewScheduleWithTimes(t *testing.T) {
+	at := func(hour, minute int) entities.TakingTime {
+		return entities.TakingTime{Time: time.Date(0, 0, 0, hour, minute, 0, 0, time.UTC)}
+	}
+	start := time.Date(2025, 5, 11, 9, 30, 0, 0, time.UTC)
+	end := time.Date(2025, 5, 21, 0, 0, 0, 0, time.UTC)
+
+	schedule, err := entities.NewScheduleWithTimes("Aspirin", 1, start, &end, []entities.TakingTime{at(20, 0), at(8, 30), at(20, 0)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schedule.Frequency != 2 || schedule.Duration != 10 {
+		t.Errorf("expected frequency 2 and duration 10, got %d and %d", schedule.Frequency, schedule.Duration)
+	}
+	if schedule.TakingTimes[0].Time.Hour() != 8 || schedule.TakingTimes[1].Time.Hour() != 20 {
+		t.Errorf("expected sorted taking times, got %v", schedule.TakingTimes)
+	}
+	if !schedule.StartDate.Equal(time.Date(2025, 5, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the start date at midnight, got %v", schedule.StartDate)
+	}
+
+	if _, err := entities.NewScheduleWithTimes("Aspirin", 1, start, nil, nil); err != entities.ErrInvalidFrequency {
+		t.Errorf("expected %v without taking times, got %v", entities.ErrInvalidFrequency, err)
+	}
+	if _, err := entities.NewScheduleWithTimes("Aspirin", 1, start, &start, []entities.TakingTime{at(8, 0)}); err != entities.ErrInvalidDuration {
+		t.Errorf("expected %v for an empty range, got %v", entities.ErrInvalidDuration, err)
+	}
+}
+
+func TestMedicineKey(t *testing.T) {
+	custom := &entities.Schedule{MedicineName: " Jelly  bears!"}
+	if key := custom.MedicineKey(); key != entities.MedicineKey("", "jelly-bears") {
+		t.Errorf("expected the spellings of a custom medicine to share the key, got %q", key)
+	}
+
+	catalog := &entities.Schedule{MedicineName: "Аспирин Кардио", MedicineID: "acetylsalicylic-acid"}
+	if key := catalog.MedicineKey(); key != entities.MedicineKey("acetylsalicylic-acid", "Aspirin") {
+		t.Errorf("expected the names of a catalog medicine to share the key, got %q", key)
+	}
+	if catalog.MedicineKey() == entities.MedicineKey("", "acetylsalicylic-acid") {
+		t.Error("expected a custom medicine named like a catalog ID to have another key")
+	}
+}
+
+func TestDailyDose(t *testing.T) {
+	schedule, err := entities.NewSchedule("Paracetamol", 4, 7, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dose := schedule.DailyDose(); dose != 0 {
+		t.Errorf("expected no daily dose without a dose, got %g", dose)
+	}
+
+	schedule.Dose = 500
+	if dose := schedule.DailyDose(); dose != 2000 {
+		t.Errorf("expected 2000 mg a day, got %g", dose)
+	}
+}
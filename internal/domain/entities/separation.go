@@ -0,0 +1,174 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	// separationStep is the grid the taking times are shifted on, in minutes.
+	separationStep = 15
+	// minTakingGap is the least time between the shifted takings of a
+	// schedule in minutes, the spacing of the most frequent schedules.
+	minTakingGap  = 60
+	minutesPerDay = 24 * 60
+)
+
+// SeparationConstraint requires the takings of a schedule to be at least
+// MinSeparation apart from the taking times of another schedule.
+type SeparationConstraint struct {
+	ScheduleID    int64
+	MedicineID    string
+	MedicineName  string
+	MinSeparation time.Duration
+	TakingTimes   []TakingTime
+}
+
+// SeparationError tells the constraint which leaves no room for the takings
+// in the waking window.
+type SeparationError struct {
+	Frequency  int
+	Constraint SeparationConstraint
+}
+
+func (e *SeparationError) Error() string {
+	return fmt.Sprintf("%d takings can't be %g h apart from %s between %02d:00 and %02d:00",
+		e.Frequency, e.Constraint.MinSeparation.Hours(), e.Constraint.MedicineName, WakingStartHour, WakingEndHour)
+}
+
+// SeparateTakingTimes shifts the sorted taking times so every one is at least
+// the separation of each constraint apart from its taking times, around the
+// clock. Times satisfying the constraints are returned as they are, even if
+// they are outside the waking window or closer than an hour. Otherwise the
+// times stay in order, an hour apart or more, within the waking window on the
+// 15-minute grid and are moved by the least total amount. When the window has
+// no room for the takings, a *SeparationError tells the first constraint
+// leaving too little.
+func SeparateTakingTimes(times []TakingTime, constraints []SeparationConstraint) ([]TakingTime, error) {
+	if len(constraints) == 0 || len(times) == 0 {
+		return times, nil
+	}
+	if !slices.ContainsFunc(times, func(t TakingTime) bool {
+		return !allowedByAll(minuteOfDay(t), constraints)
+	}) {
+		return times, nil
+	}
+
+	candidates := make([]int, 0, (WakingEndHour-WakingStartHour)*60/separationStep+1+len(times))
+	for minute := WakingStartHour * 60; minute <= WakingEndHour*60; minute += separationStep {
+		candidates = append(candidates, minute)
+	}
+	for _, t := range times {
+		candidates = append(candidates, minuteOfDay(t))
+	}
+	slices.Sort(candidates)
+	candidates = slices.Compact(candidates)
+
+	free := func(constraints []SeparationConstraint) []int {
+		return slices.DeleteFunc(slices.Clone(candidates), func(minute int) bool {
+			return !allowedByAll(minute, constraints)
+		})
+	}
+
+	if shifted, ok := closestTimes(times, free(constraints)); ok {
+		return shifted, nil
+	}
+	for i := range constraints {
+		if _, ok := closestTimes(times, free(constraints[:i+1])); !ok {
+			return nil, &SeparationError{
+				Frequency:  len(times),
+				Constraint: constraints[i],
+			}
+		}
+	}
+	return nil, &SeparationError{
+		Frequency:  len(times),
+		Constraint: constraints[len(constraints)-1],
+	}
+}
+
+func allowedByAll(minute int, constraints []SeparationConstraint) bool {
+	for _, constraint := range constraints {
+		if !constraint.allows(minute) {
+			return false
+		}
+	}
+	return true
+}
+
+// allows reports whether a taking at the minute of the day is far enough from
+// the takings of the constraint.
+func (c SeparationConstraint) allows(minute int) bool {
+	for _, t := range c.TakingTimes {
+		distance := minute - minuteOfDay(t)
+		if distance < 0 {
+			distance = -distance
+		}
+		distance = min(distance, minutesPerDay-distance)
+		if time.Duration(distance)*time.Minute < c.MinSeparation {
+			return false
+		}
+	}
+	return true
+}
+
+// closestTimes assigns the times to the free minutes in the same order with
+// the least total shift, keeping them minTakingGap apart. cost[i][j] is the
+// least total shift of the first i+1 times with the last one at free[j], -1
+// when they don't fit.
+func closestTimes(times []TakingTime, free []int) ([]TakingTime, bool) {
+	n, m := len(times), len(free)
+	cost := make([][]int, n)
+	prev := make([][]int, n)
+	for i := range n {
+		cost[i] = make([]int, m)
+		prev[i] = make([]int, m)
+		// best is the cheapest placement of the previous time at free[k] or
+		// before, far enough from free[j].
+		best, bestJ, k := -1, -1, 0
+		for j := range m {
+			shift := free[j] - minuteOfDay(times[i])
+			if shift < 0 {
+				shift = -shift
+			}
+			if i == 0 {
+				cost[i][j] = shift
+				continue
+			}
+			for ; k < m && free[k] <= free[j]-minTakingGap; k++ {
+				if cost[i-1][k] >= 0 && (best < 0 || cost[i-1][k] < best) {
+					best, bestJ = cost[i-1][k], k
+				}
+			}
+			cost[i][j] = -1
+			if best >= 0 {
+				cost[i][j] = best + shift
+				prev[i][j] = bestJ
+			}
+		}
+	}
+
+	last := -1
+	for j := range m {
+		if cost[n-1][j] >= 0 && (last < 0 || cost[n-1][j] < cost[n-1][last]) {
+			last = j
+		}
+	}
+	if last < 0 {
+		return nil, false
+	}
+
+	shifted := make([]TakingTime, n)
+	for i := n - 1; i >= 0; i-- {
+		shifted[i] = TakingTime{
+			Time: time.Date(0, 0, 0, free[last]/60, free[last]%60, 0, 0, time.UTC),
+		}
+		last = prev[i][last]
+	}
+	return shifted, true
+}
+
+func minuteOfDay(t TakingTime) int {
+	return t.Time.Hour()*60 + t.Time.Minute()
+}
@@ -0,0 +1,170 @@
+package entities_test
+
+import (
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"testing"
+	"time"
+)
+
+func TestSeparateTakingTimes(t *testing.T) {
+	at := func(hour, minute int) entities.TakingTime {
+		return entities.TakingTime{Time: time.Date(0, 0, 0, hour, minute, 0, 0, time.UTC)}
+	}
+	format := func(times []entities.TakingTime) []string {
+		formatted := make([]string, len(times))
+		for i, tt := range times {
+			formatted[i] = tt.Time.Format("15:04")
+		}
+		return formatted
+	}
+	calcium := func(times ...entities.TakingTime) entities.SeparationConstraint {
+		return entities.SeparationConstraint{
+			ScheduleID:    1,
+			MedicineID:    "calcium-carbonate",
+			MedicineName:  "Calcium",
+			MinSeparation: 4 * time.Hour,
+			TakingTimes:   times,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		times       []entities.TakingTime
+		constraints []entities.SeparationConstraint
+		expected    []string
+	}{
+		{
+			name:     "no constraints",
+			times:    []entities.TakingTime{at(15, 0)},
+			expected: []string{"15:00"},
+		},
+		{
+			name:        "satisfied constraint",
+			times:       []entities.TakingTime{at(8, 0), at(22, 0)},
+			constraints: []entities.SeparationConstraint{calcium(at(15, 0))},
+			expected:    []string{"08:00", "22:00"},
+		},
+		{
+			name:        "shifted to the closest free time",
+			times:       []entities.TakingTime{at(15, 0)},
+			constraints: []entities.SeparationConstraint{calcium(at(15, 0))},
+			expected:    []string{"11:00"},
+		},
+		{
+			name:        "shifted in order",
+			times:       []entities.TakingTime{at(8, 0), at(15, 0), at(22, 0)},
+			constraints: []entities.SeparationConstraint{calcium(at(13, 0))},
+			expected:    []string{"08:00", "17:00", "22:00"},
+		},
+		{
+			name:        "separated around midnight",
+			times:       []entities.TakingTime{at(8, 0)},
+			constraints: []entities.SeparationConstraint{calcium(at(6, 0))},
+			expected:    []string{"10:00"},
+		},
+		{
+			name:  "several constraints",
+			times: []entities.TakingTime{at(15, 0)},
+			constraints: []entities.SeparationConstraint{
+				calcium(at(15, 0)),
+				{MedicineName: "Iron", MinSeparation: 4 * time.Hour, TakingTimes: []entities.TakingTime{at(11, 0)}},
+			},
+			expected: []string{"19:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times, err := entities.SeparateTakingTimes(tt.times, tt.constraints)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := format(times)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("expected %v, got %v", tt.expected, got)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSeparateTakingTimesUnsatisfiable(t *testing.T) {
+	at := func(hour int) entities.TakingTime {
+		return entities.TakingTime{Time: time.Date(0, 0, 0, hour, 0, 0, 0, time.UTC)}
+	}
+	constraints := []entities.SeparationConstraint{
+		{ScheduleID: 1, MedicineName: "Omeprazole", MinSeparation: time.Hour, TakingTimes: []entities.TakingTime{at(8)}},
+		{ScheduleID: 2, MedicineName: "Calcium", MinSeparation: 4 * time.Hour, TakingTimes: []entities.TakingTime{at(8), at(15), at(22)}},
+		{ScheduleID: 3, MedicineName: "Iron", MinSeparation: 4 * time.Hour, TakingTimes: []entities.TakingTime{at(12)}},
+	}
+
+	_, err := entities.SeparateTakingTimes([]entities.TakingTime{at(15)}, constraints)
+	var separationErr *entities.SeparationError
+	if !errors.As(err, &separationErr) {
+		t.Fatalf("expected a separation error, got %v", err)
+	}
+	if separationErr.Constraint.ScheduleID != 2 || separationErr.Frequency != 1 {
+		t.Errorf("expected the calcium constraint to fail, got %+v", separationErr)
+	}
+}
+
+func TestSeparateTakingTimesKeepsTakingsApart(t *testing.T) {
+	at := func(hour, minute int) entities.TakingTime {
+		return entities.TakingTime{Time: time.Date(0, 0, 0, hour, minute, 0, 0, time.UTC)}
+	}
+	constraint := entities.SeparationConstraint{
+		MedicineName:  "Iron",
+		MinSeparation: 7*time.Hour + 30*time.Minute,
+		TakingTimes:   []entities.TakingTime{at(13, 0)},
+	}
+
+	// 20:30-22:00 is free, but not for three takings an hour apart.
+	_, err := entities.SeparateTakingTimes([]entities.TakingTime{at(8, 0), at(15, 0), at(22, 0)},
+		[]entities.SeparationConstraint{constraint})
+	var separationErr *entities.SeparationError
+	if !errors.As(err, &separationErr) {
+		t.Fatalf("expected a separation error, got %v", err)
+	}
+
+	times, err := entities.SeparateTakingTimes([]entities.TakingTime{at(8, 0), at(22, 0)},
+		[]entities.SeparationConstraint{constraint})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if times[0].Time.Format("15:04") != "20:30" || times[1].Time.Format("15:04") != "22:00" {
+		t.Errorf("expected 20:30 and 22:00, got %v and %v", times[0].Time.Format("15:04"), times[1].Time.Format("15:04"))
+	}
+}
+
+func TestSeparateTakingTimesKeepsValidTimes(t *testing.T) {
+	at := func(hour, minute, second int) entities.TakingTime {
+		return entities.TakingTime{Time: time.Date(0, 0, 0, hour, minute, second, 0, time.UTC)}
+	}
+	constraint := entities.SeparationConstraint{
+		MedicineName:  "Calcium",
+		MinSeparation: 4 * time.Hour,
+		TakingTimes:   []entities.TakingTime{at(15, 0, 0)},
+	}
+
+	// Imported times off the grid, outside the waking window and closer than
+	// an hour already satisfy the constraint.
+	original := []entities.TakingTime{at(7, 10, 30), at(7, 40, 0), at(23, 5, 0)}
+	times, err := entities.SeparateTakingTimes(original, []entities.SeparationConstraint{constraint})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(times) != len(original) {
+		t.Fatalf("expected %d times, got %d", len(original), len(times))
+	}
+	for i := range original {
+		if !times[i].Time.Equal(original[i].Time) {
+			t.Errorf("expected %v to be kept, got %v", original[i].Time.Format(time.TimeOnly), times[i].Time.Format(time.TimeOnly))
+		}
+	}
+}
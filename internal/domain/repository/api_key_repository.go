@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"time"
+)
+
+var ErrAPIKeyNotFound = errors.New("api key was not found")
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *entities.APIKey) (int64, error)
+	GetByHash(ctx context.Context, hash string) (*entities.APIKey, error)
+	GetByUserID(ctx context.Context, userID int64) ([]entities.APIKey, error)
+	Revoke(ctx context.Context, userID, id int64, at time.Time) error
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+// AuditRepository is append-only, entries are never changed or removed.
+type AuditRepository interface {
+	Append(ctx context.Context, entry *entities.AuditEntry) error
+	GetBySchedule(ctx context.Context, scheduleID int64) ([]entities.AuditEntry, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+var ErrFeedNotFound = errors.New("calendar feed was not found")
+
+// CalendarFeedRepository keeps one feed per user, saving a feed replaces the
+// previous token.
+type CalendarFeedRepository interface {
+	Save(ctx context.Context, feed *entities.CalendarFeed) error
+	GetByHash(ctx context.Context, hash string) (*entities.CalendarFeed, error)
+	Delete(ctx context.Context, userID int64) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+var (
+	ErrLinkExists   = errors.New("caregiver link already exists")
+	ErrLinkNotFound = errors.New("caregiver link was not found")
+)
+
+type CaregiverRepository interface {
+	Link(ctx context.Context, link *entities.CaregiverLink) error
+	Update(ctx context.Context, link *entities.CaregiverLink) error
+	Unlink(ctx context.Context, userID, caregiverID int64) error
+	Get(ctx context.Context, userID, caregiverID int64) (*entities.CaregiverLink, error)
+	GetCaregivers(ctx context.Context, userID int64) ([]entities.CaregiverLink, error)
+	GetDependants(ctx context.Context, caregiverID int64) ([]entities.CaregiverLink, error)
+}
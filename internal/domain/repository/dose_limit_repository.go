@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+var ErrDoseLimitNotFound = errors.New("dose limit was not found")
+
+type DoseLimitRepository interface {
+	Get(ctx context.Context, userID int64, ingredient string) (*entities.DoseLimit, error)
+	List(ctx context.Context, userID int64) ([]entities.DoseLimit, error)
+	Save(ctx context.Context, limit *entities.DoseLimit) error
+	// Delete removes the limit of the ingredient and returns it, it reports
+	// ErrDoseLimitNotFound if there was none.
+	Delete(ctx context.Context, userID int64, ingredient string) (*entities.DoseLimit, error)
+}
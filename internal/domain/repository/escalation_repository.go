@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+var ErrRuleNotFound = errors.New("escalation rule was not found")
+
+type EscalationRepository interface {
+	Save(ctx context.Context, rule *entities.EscalationRule) error
+	GetByScheduleID(ctx context.Context, scheduleID int64) (*entities.EscalationRule, error)
+	GetAll(ctx context.Context) ([]entities.EscalationRule, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"time"
+)
+
+var ErrIdempotencyKeyNotFound = errors.New("idempotency key was not found")
+
+type IdempotencyRepository interface {
+	// Reserve saves the record unless an unexpired record has its key and
+	// is completed or still locked, and reports whether it was saved.
+	Reserve(ctx context.Context, record *entities.IdempotencyRecord) (bool, error)
+	Get(ctx context.Context, key string) (*entities.IdempotencyRecord, error)
+	// Complete stores the response of the record while its reservation holds
+	// the key, which is matched by the request hash and the lock time.
+	Complete(ctx context.Context, record *entities.IdempotencyRecord) error
+	// Delete removes the record while its reservation holds the key.
+	Delete(ctx context.Context, record *entities.IdempotencyRecord) error
+	// DeleteExpired removes the records expired by now and returns their
+	// number.
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"time"
+)
+
+var ErrIntakeExists = errors.New("intake already recorded")
+
+type IntakeRepository interface {
+	// Create inserts the intake and the audit entry, when given, in one
+	// transaction.
+	Create(ctx context.Context, intake *entities.Intake, entry *entities.AuditEntry) (int64, error)
+	GetPlannedBetween(ctx context.Context, from, to time.Time) ([]entities.Intake, error)
+	GetUserPlannedBetween(ctx context.Context, userID int64, from, to time.Time) ([]entities.Intake, error)
+	GetByUser(ctx context.Context, userID int64) ([]entities.Intake, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+var ErrPackNotFound = errors.New("pack was not found")
+
+type InventoryRepository interface {
+	Refill(ctx context.Context, pack *entities.Pack) (int64, error)
+	GetByScheduleID(ctx context.Context, userID, scheduleID int64) (*entities.Pack, error)
+	GetAwaitingRefill(ctx context.Context) ([]entities.Pack, error)
+	MarkRefillNotified(ctx context.Context, packID int64) (bool, error)
+	ClearRefillNotified(ctx context.Context, packID int64) error
+}
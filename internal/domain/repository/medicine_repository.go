@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+var ErrMedicineNotFound = errors.New("medicine was not found")
+
+// MedicineRepository keeps the medicine catalog. Names are looked up by
+// their normalized form, see medicine.Normalize.
+type MedicineRepository interface {
+	// Seed saves the medicines and their names, replacing the stored entries
+	// with the same IDs.
+	Seed(ctx context.Context, medicines []entities.Medicine) error
+	Get(ctx context.Context, id string) (*entities.Medicine, error)
+	// FindByName returns the medicine having the normalized name or synonym.
+	FindByName(ctx context.Context, name string) (*entities.Medicine, error)
+	// Search returns the medicines having a name or a synonym with a word
+	// starting with the normalized prefix, ordered by name.
+	Search(ctx context.Context, prefix string, limit int) ([]entities.Medicine, error)
+}
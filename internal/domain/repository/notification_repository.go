@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"pills-taking-reminder/internal/domain/entities"
+	"time"
+)
+
+type NotificationRepository interface {
+	// Reserve stores the notification to be sent and reports whether it was
+	// stored. The notifications which were sent, or are reserved and not
+	// expired yet, are not stored again.
+	Reserve(ctx context.Context, notification *entities.Notification) (bool, error)
+	MarkSent(ctx context.Context, id int64) error
+	// DispatchedUntil returns the time the takings were dispatched up to, the
+	// zero time before the first dispatch.
+	DispatchedUntil(ctx context.Context) (time.Time, error)
+	SetDispatchedUntil(ctx context.Context, until time.Time) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+var ErrProfileNotFound = errors.New("profile was not found")
+
+type ProfileRepository interface {
+	Get(ctx context.Context, userID int64) (*entities.Profile, error)
+	Save(ctx context.Context, profile *entities.Profile) error
+}
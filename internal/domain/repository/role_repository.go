@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+type RoleRepository interface {
+	// Get returns RolePatient for users without an assigned role.
+	Get(ctx context.Context, userID int64) (entities.Role, error)
+	Set(ctx context.Context, userID int64, role entities.Role) error
+}
+
+type AccessLogRepository interface {
+	Record(ctx context.Context, record *entities.AccessRecord) error
+}
@@ -4,16 +4,104 @@ import (
 	"context"
 	"errors"
 	"pills-taking-reminder/internal/domain/entities"
+	"time"
 )
 
 var (
 	ErrAlreadyExists = errors.New("schedule already exists")
 	ErrNotFound      = errors.New("schedule was not found")
+	// ErrVersionMismatch is returned by updates of a schedule which was
+	// updated since it was read.
+	ErrVersionMismatch = errors.New("schedule version does not match")
+	// ErrBatchAborted is the result of the schedules of an all-or-nothing
+	// batch rolled back because of another schedule.
+	ErrBatchAborted = errors.New("batch was rolled back")
 )
 
+// ScheduleRepository writes the audit entries given with the changes in the
+// same transaction, the schedule IDs of the creation entries are set by it.
 type ScheduleRepository interface {
-	Create(ctx context.Context, schedule *entities.Schedule) (int64, error)
+	Create(ctx context.Context, schedule *entities.Schedule, entry *entities.AuditEntry) (int64, error)
+	// CreateBatch inserts the schedules in one transaction. When atomic, a
+	// failed schedule rolls back all of them, otherwise it is skipped. The
+	// entries, when given, are the ones of the schedules at the same index.
+	CreateBatch(ctx context.Context, schedules []*entities.Schedule, entries []*entities.AuditEntry,
+		atomic bool) ([]BatchResult, error)
 	GetByID(ctx context.Context, userID, scheduleID int64) (*entities.Schedule, error)
+	// GetOwnerID returns the ID of the user of the schedule.
+	GetOwnerID(ctx context.Context, scheduleID int64) (int64, error)
 	GetSchedulesIDs(ctx context.Context, userID int64) ([]int64, error)
-	GetNextTakings(ctx context.Context, userID int64, interval string) ([]entities.Taking, error)
+	// Update saves the schedule if its version is still the stored one and
+	// increments the version.
+	Update(ctx context.Context, schedule *entities.Schedule, entry *entities.AuditEntry) error
+	// GetActive returns the running schedules which are not paused.
+	GetActive(ctx context.Context) ([]entities.Schedule, error)
+	List(ctx context.Context, filter ScheduleFilter) ([]entities.Schedule, error)
+	// Import writes the schedules of an import with their intakes in one
+	// transaction, a failed write rolls back all of them.
+	Import(ctx context.Context, changes []ImportChange) error
+}
+
+// ImportChange is an imported schedule with the intakes to record. The
+// schedule is created when its ID is zero and updated when Entry is given,
+// otherwise only the intakes are recorded. The IDs of the created schedule,
+// the intakes and the entries are set by the repository, the intakes which
+// were recorded before keep a zero ID.
+type ImportChange struct {
+	Schedule *entities.Schedule
+	Entry    *entities.AuditEntry
+	Intakes  []ImportedIntake
+}
+
+type ImportedIntake struct {
+	Intake *entities.Intake
+	Entry  *entities.AuditEntry
+}
+
+// BatchResult is the outcome of a schedule of a batch, ID is set when Err is
+// nil.
+type BatchResult struct {
+	ID  int64
+	Err error
+}
+
+// ScheduleStatus selects the running schedules which are active or paused,
+// or the finished ones whether paused or not.
+type ScheduleStatus string
+
+const (
+	ScheduleStatusAll      ScheduleStatus = "all"
+	ScheduleStatusActive   ScheduleStatus = "active"
+	ScheduleStatusPaused   ScheduleStatus = "paused"
+	ScheduleStatusFinished ScheduleStatus = "finished"
+)
+
+type ScheduleSort string
+
+const (
+	ScheduleSortID           ScheduleSort = "id"
+	ScheduleSortMedicineName ScheduleSort = "medicine_name"
+	ScheduleSortStartDate    ScheduleSort = "start_date"
+)
+
+// ScheduleFilter selects a page of the user's schedules. From and To keep the
+// schedules running at some day of the range, After continues the listing
+// after the last schedule of the previous page.
+type ScheduleFilter struct {
+	UserID         int64
+	Status         ScheduleStatus
+	MedicinePrefix string
+	From           *time.Time
+	To             *time.Time
+	Sort           ScheduleSort
+	Descending     bool
+	After          *ScheduleCursor
+	Limit          int
+}
+
+// ScheduleCursor is the position of a schedule in the listing: its value of
+// the sort column and its ID breaking ties.
+type ScheduleCursor struct {
+	Value string
+	ID    int64
 }
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"time"
+)
+
+var ErrTakingNotFound = errors.New("planned taking was not found")
+
+type TakingRepository interface {
+	Get(ctx context.Context, scheduleID int64, plannedAt time.Time) (*entities.PlannedTaking, error)
+	Save(ctx context.Context, taking *entities.PlannedTaking) (int64, error)
+	GetDueSnoozes(ctx context.Context, now time.Time) ([]entities.PlannedTaking, error)
+	GetUserBetween(ctx context.Context, userID int64, from, to time.Time) ([]entities.PlannedTaking, error)
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/mw"
+	"time"
+)
+
+var ErrAPIKeyNotFound = errors.New("api key was not found")
+
+type APIKeyInput struct {
+	UserID int64
+	Name   string
+	Scopes []string
+}
+
+type APIKeyOutput struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	Prefix    string
+	Scopes    []string
+	CreatedAt time.Time
+	RevokedAt *time.Time
+	// Key is the plain text key, only set when the key is created.
+	Key string
+}
+
+type APIKeyUseCase struct {
+	apiKeyRepo repository.APIKeyRepository
+	policy     *AccessPolicy
+}
+
+func NewAPIKeyUseCase(apiKeyRepo repository.APIKeyRepository, policy *AccessPolicy) *APIKeyUseCase {
+	return &APIKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		policy:     policy,
+	}
+}
+
+func (uc *APIKeyUseCase) CreateAPIKey(ctx context.Context, input APIKeyInput) (*APIKeyOutput, error) {
+	if input.UserID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "api_key.create", entities.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	scopes := make([]entities.Scope, len(input.Scopes))
+	for i, scope := range input.Scopes {
+		scopes[i] = entities.Scope(scope)
+	}
+
+	key, secret, err := entities.NewAPIKey(input.UserID, input.Name, scopes)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidScope) || errors.Is(err, entities.ErrNoScopes) ||
+			errors.Is(err, entities.ErrEmptyKeyName) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		return nil, err
+	}
+
+	id, err := uc.apiKeyRepo.Create(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+	key.ID = id
+
+	output := apiKeyOutput(key)
+	output.Key = secret
+	return output, nil
+}
+
+func (uc *APIKeyUseCase) GetAPIKeys(ctx context.Context, userID int64) ([]APIKeyOutput, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "api_key.list", entities.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	keys, err := uc.apiKeyRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api keys: %w", err)
+	}
+
+	outputs := make([]APIKeyOutput, len(keys))
+	for i := range keys {
+		outputs[i] = *apiKeyOutput(&keys[i])
+	}
+	return outputs, nil
+}
+
+func (uc *APIKeyUseCase) RevokeAPIKey(ctx context.Context, userID, id int64) error {
+	if userID <= 0 || id <= 0 {
+		return ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "api_key.revoke", entities.PermissionManage); err != nil {
+		return err
+	}
+
+	if err := uc.apiKeyRepo.Revoke(ctx, userID, id, entities.TimeNow()); err != nil {
+		if errors.Is(err, repository.ErrAPIKeyNotFound) {
+			return ErrAPIKeyNotFound
+		}
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	return nil
+}
+
+// Authenticate implements mw.Authenticator for the ApiKey scheme. The
+// principal is limited to the scopes of the key.
+func (uc *APIKeyUseCase) Authenticate(ctx context.Context, authorization string) (mw.Principal, error) {
+	secret, ok := mw.Credentials(authorization, "ApiKey")
+	if !ok {
+		return mw.Principal{}, mw.ErrUnsupportedScheme
+	}
+
+	key, err := uc.apiKeyRepo.GetByHash(ctx, entities.HashAPIKey(secret))
+	if err != nil {
+		if errors.Is(err, repository.ErrAPIKeyNotFound) {
+			return mw.Principal{}, mw.ErrInvalidCredentials
+		}
+		return mw.Principal{}, fmt.Errorf("failed to get api key: %w", err)
+	}
+	if key.IsRevoked() {
+		return mw.Principal{}, mw.ErrInvalidCredentials
+	}
+
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return mw.Principal{UserID: key.UserID, Scopes: scopes}, nil
+}
+
+func apiKeyOutput(key *entities.APIKey) *APIKeyOutput {
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return &APIKeyOutput{
+		ID:        key.ID,
+		UserID:    key.UserID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    scopes,
+		CreatedAt: key.CreatedAt,
+		RevokedAt: key.RevokedAt,
+	}
+}
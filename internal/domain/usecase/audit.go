@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/mw"
+	"time"
+)
+
+type AuditOutput struct {
+	ID         int64
+	ScheduleID int64
+	UserID     int64
+	ActorID    *int64
+	Action     string
+	TraceID    string
+	Before     json.RawMessage
+	After      json.RawMessage
+	CreatedAt  time.Time
+}
+
+// newAudit returns the entry of the change made by the actor of the request,
+// which the repositories write together with the change. The schedule ID of a
+// created schedule is set by the repository.
+func newAudit(ctx context.Context, action entities.AuditAction, scheduleID, userID int64,
+	before, after any) (*entities.AuditEntry, error) {
+	var actorID *int64
+	if id, ok := mw.GetActorID(ctx); ok {
+		actorID = &id
+	}
+
+	return entities.NewAuditEntry(action, scheduleID, userID, actorID, mw.GetTraceID(ctx), before, after)
+}
+
+// GetScheduleHistory returns the changes of the schedule from the oldest one.
+// The actor is authorized against the owner of the schedule before the
+// history is read.
+func (uc *ScheduleUseCase) GetScheduleHistory(ctx context.Context, scheduleID int64) ([]AuditOutput, error) {
+	if scheduleID <= 0 {
+		return nil, ErrInvalidInput
+	}
+
+	userID, err := uc.scheduleRepo.GetOwnerID(ctx, scheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get schedule owner: %w", err)
+	}
+
+	if err := uc.policy.Authorize(ctx, userID, "schedule.history", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	entries, err := uc.auditRepo.GetBySchedule(ctx, scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule history: %w", err)
+	}
+
+	outputs := make([]AuditOutput, len(entries))
+	for i, entry := range entries {
+		outputs[i] = AuditOutput{
+			ID:         entry.ID,
+			ScheduleID: entry.ScheduleID,
+			UserID:     entry.UserID,
+			ActorID:    entry.ActorID,
+			Action:     string(entry.Action),
+			TraceID:    entry.TraceID,
+			Before:     entry.Before,
+			After:      entry.After,
+			CreatedAt:  entry.CreatedAt,
+		}
+	}
+	return outputs, nil
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"sort"
+	"time"
+)
+
+// MaxCalendarDays bounds the range of a calendar request, both dates included.
+const MaxCalendarDays = 92
+
+// Calendar statuses of takings which have no saved state.
+const (
+	CalendarUpcoming    = "upcoming"
+	CalendarUnconfirmed = "unconfirmed"
+)
+
+type CalendarTakingOutput struct {
+	ScheduleID   int64
+	MedicineName string
+	PlannedAt    time.Time
+	// Status is one of the planned taking statuses, or upcoming and
+	// unconfirmed for takings without a saved state.
+	Status  string
+	TakenAt *time.Time
+}
+
+type CalendarUseCase struct {
+	scheduleRepo repository.ScheduleRepository
+	intakeRepo   repository.IntakeRepository
+	takingRepo   repository.TakingRepository
+	feedRepo     repository.CalendarFeedRepository
+	profileRepo  repository.ProfileRepository
+	policy       *AccessPolicy
+}
+
+func NewCalendarUseCase(scheduleRepo repository.ScheduleRepository, intakeRepo repository.IntakeRepository,
+	takingRepo repository.TakingRepository, feedRepo repository.CalendarFeedRepository,
+	profileRepo repository.ProfileRepository, policy *AccessPolicy) *CalendarUseCase {
+	return &CalendarUseCase{
+		scheduleRepo: scheduleRepo,
+		intakeRepo:   intakeRepo,
+		takingRepo:   takingRepo,
+		feedRepo:     feedRepo,
+		profileRepo:  profileRepo,
+		policy:       policy,
+	}
+}
+
+// GetCalendar expands the user's schedules into dated takings from the start
+// of the from day to the end of the to day, merged with the recorded intakes
+// and reminder states.
+func (uc *CalendarUseCase) GetCalendar(ctx context.Context, userID int64, from, to time.Time) ([]CalendarTakingOutput, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+
+	now := TimeNow()
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, now.Location())
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+	if !start.Before(end) || end.After(start.AddDate(0, 0, MaxCalendarDays)) {
+		return nil, ErrInvalidInput
+	}
+
+	if err := uc.policy.Authorize(ctx, userID, "calendar.get", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	schedules, err := allSchedules(ctx, uc.scheduleRepo, repository.ScheduleFilter{
+		UserID: userID,
+		Status: repository.ScheduleStatusAll,
+		From:   &start,
+		To:     &end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	intakes, err := uc.intakeRepo.GetUserPlannedBetween(ctx, userID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get intakes: %w", err)
+	}
+	taken := make(map[calendarKey]time.Time, len(intakes))
+	for _, intake := range intakes {
+		taken[newCalendarKey(intake.ScheduleID, intake.PlannedAt)] = intake.TakenAt
+	}
+
+	states, err := uc.takingRepo.GetUserBetween(ctx, userID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get planned takings: %w", err)
+	}
+	statuses := make(map[calendarKey]entities.TakingStatus, len(states))
+	for _, state := range states {
+		statuses[newCalendarKey(state.ScheduleID, state.PlannedAt)] = state.Status
+	}
+
+	output := make([]CalendarTakingOutput, 0)
+	for i := range schedules {
+		for _, taking := range schedules[i].TakingsBetween(start, end) {
+			key := newCalendarKey(schedules[i].ID, taking.TakingTime)
+			item := CalendarTakingOutput{
+				ScheduleID:   schedules[i].ID,
+				MedicineName: taking.MedicineName,
+				PlannedAt:    taking.TakingTime,
+			}
+
+			if takenAt, ok := taken[key]; ok {
+				item.Status = string(entities.TakingTaken)
+				item.TakenAt = &takenAt
+			} else if status, ok := statuses[key]; ok {
+				item.Status = string(status)
+			} else if taking.TakingTime.After(now) {
+				item.Status = CalendarUpcoming
+			} else {
+				item.Status = CalendarUnconfirmed
+			}
+
+			output = append(output, item)
+		}
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		if !output[i].PlannedAt.Equal(output[j].PlannedAt) {
+			return output[i].PlannedAt.Before(output[j].PlannedAt)
+		}
+		return output[i].MedicineName < output[j].MedicineName
+	})
+
+	return output, nil
+}
+
+// allSchedules pages through all schedules matching the filter in the order
+// of their IDs.
+func allSchedules(ctx context.Context, scheduleRepo repository.ScheduleRepository,
+	filter repository.ScheduleFilter) ([]entities.Schedule, error) {
+	filter.Sort = repository.ScheduleSortID
+	filter.Descending = false
+	filter.Limit = maxListLimit
+
+	var schedules []entities.Schedule
+	for {
+		page, err := scheduleRepo.List(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list schedules: %w", err)
+		}
+		schedules = append(schedules, page...)
+		if len(page) < filter.Limit {
+			return schedules, nil
+		}
+		filter.After = &repository.ScheduleCursor{ID: page[len(page)-1].ID}
+	}
+}
+
+type calendarKey struct {
+	scheduleID int64
+	plannedAt  int64
+}
+
+func newCalendarKey(scheduleID int64, plannedAt time.Time) calendarKey {
+	return calendarKey{scheduleID: scheduleID, plannedAt: plannedAt.Unix()}
+}
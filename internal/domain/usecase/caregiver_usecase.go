@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"time"
+)
+
+var (
+	ErrLinkExists   = errors.New("caregiver link already exists")
+	ErrLinkNotFound = errors.New("caregiver link was not found")
+)
+
+// CaregiverInput leaves permissions nil to use the defaults: the caregiver can
+// view schedules and receives alerts, but can not edit.
+type CaregiverInput struct {
+	UserID        int64
+	CaregiverID   int64
+	CanView       *bool
+	CanEdit       *bool
+	ReceiveAlerts *bool
+}
+
+type CaregiverOutput struct {
+	UserID        int64
+	CaregiverID   int64
+	CanView       bool
+	CanEdit       bool
+	ReceiveAlerts bool
+	LinkedAt      time.Time
+}
+
+type CaregiverUseCase struct {
+	caregiverRepo repository.CaregiverRepository
+	policy        *AccessPolicy
+}
+
+func NewCaregiverUseCase(caregiverRepo repository.CaregiverRepository, policy *AccessPolicy) *CaregiverUseCase {
+	return &CaregiverUseCase{
+		caregiverRepo: caregiverRepo,
+		policy:        policy,
+	}
+}
+
+func (uc *CaregiverUseCase) LinkCaregiver(ctx context.Context, input CaregiverInput) (*CaregiverOutput, error) {
+	if input.UserID <= 0 || input.CaregiverID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "caregiver.link", entities.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	link, err := entities.NewCaregiverLink(input.UserID, input.CaregiverID, permissionsFromInput(input))
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+
+	if err := uc.caregiverRepo.Link(ctx, link); err != nil {
+		if errors.Is(err, repository.ErrLinkExists) {
+			return nil, ErrLinkExists
+		}
+		return nil, fmt.Errorf("failed to link caregiver: %w", err)
+	}
+
+	return caregiverOutput(link), nil
+}
+
+func (uc *CaregiverUseCase) UpdateCaregiver(ctx context.Context, input CaregiverInput) (*CaregiverOutput, error) {
+	if input.UserID <= 0 || input.CaregiverID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "caregiver.update", entities.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	link := &entities.CaregiverLink{
+		UserID:      input.UserID,
+		CaregiverID: input.CaregiverID,
+		Permissions: permissionsFromInput(input),
+	}
+
+	if err := uc.caregiverRepo.Update(ctx, link); err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to update caregiver: %w", err)
+	}
+
+	return caregiverOutput(link), nil
+}
+
+// UnlinkCaregiver can be called by both sides of the relationship.
+func (uc *CaregiverUseCase) UnlinkCaregiver(ctx context.Context, userID, caregiverID int64) error {
+	if userID <= 0 || caregiverID <= 0 {
+		return ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "caregiver.unlink", entities.PermissionManage); err != nil {
+		if !errors.Is(err, ErrPermissionDenied) {
+			return err
+		}
+		if err := uc.policy.Authorize(ctx, caregiverID, "caregiver.unlink", entities.PermissionManage); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.caregiverRepo.Unlink(ctx, userID, caregiverID); err != nil {
+		if errors.Is(err, repository.ErrLinkNotFound) {
+			return ErrLinkNotFound
+		}
+		return fmt.Errorf("failed to unlink caregiver: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *CaregiverUseCase) GetCaregivers(ctx context.Context, userID int64) ([]CaregiverOutput, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "caregiver.list", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	links, err := uc.caregiverRepo.GetCaregivers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get caregivers: %w", err)
+	}
+
+	return caregiverOutputs(links), nil
+}
+
+func (uc *CaregiverUseCase) GetDependants(ctx context.Context, caregiverID int64) ([]CaregiverOutput, error) {
+	if caregiverID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, caregiverID, "caregiver.dependants", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	links, err := uc.caregiverRepo.GetDependants(ctx, caregiverID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependants: %w", err)
+	}
+
+	return caregiverOutputs(links), nil
+}
+
+func permissionsFromInput(input CaregiverInput) entities.CaregiverPermissions {
+	return entities.CaregiverPermissions{
+		View:   valueOr(input.CanView, true),
+		Edit:   valueOr(input.CanEdit, false),
+		Alerts: valueOr(input.ReceiveAlerts, true),
+	}
+}
+
+func valueOr(value *bool, def bool) bool {
+	if value == nil {
+		return def
+	}
+	return *value
+}
+
+func caregiverOutput(link *entities.CaregiverLink) *CaregiverOutput {
+	return &CaregiverOutput{
+		UserID:        link.UserID,
+		CaregiverID:   link.CaregiverID,
+		CanView:       link.Permissions.View,
+		CanEdit:       link.Permissions.Edit,
+		ReceiveAlerts: link.Permissions.Alerts,
+		LinkedAt:      link.CreatedAt,
+	}
+}
+
+func caregiverOutputs(links []entities.CaregiverLink) []CaregiverOutput {
+	output := make([]CaregiverOutput, len(links))
+	for i := range links {
+		output[i] = *caregiverOutput(&links[i])
+	}
+	return output
+}
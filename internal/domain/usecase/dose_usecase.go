@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/medicine"
+)
+
+var (
+	// ErrDailyDoseExceeded is returned by the changes of the schedules which
+	// would take more of an ingredient in a day than its limit.
+	ErrDailyDoseExceeded = errors.New("daily dose exceeds the limit")
+	ErrDoseLimitNotFound = errors.New("dose limit was not found")
+)
+
+// DoseLimitInput sets the limit of the ingredient of the medicine, given like
+// the medicine of a schedule.
+type DoseLimitInput struct {
+	UserID       int64
+	MedicineID   string
+	MedicineName string
+	MaxDailyDose float64
+}
+
+type DoseLimitOutput struct {
+	UserID       int64
+	Ingredient   string
+	MaxDailyDose float64
+}
+
+type DoseLimitUseCase struct {
+	doseLimitRepo repository.DoseLimitRepository
+	medicineRepo  repository.MedicineRepository
+	policy        *AccessPolicy
+}
+
+func NewDoseLimitUseCase(doseLimitRepo repository.DoseLimitRepository, medicineRepo repository.MedicineRepository,
+	policy *AccessPolicy) *DoseLimitUseCase {
+	return &DoseLimitUseCase{
+		doseLimitRepo: doseLimitRepo,
+		medicineRepo:  medicineRepo,
+		policy:        policy,
+	}
+}
+
+// SetDoseLimit saves the maximum daily dose of the ingredient of the medicine
+// for the user, it replaces the limit of the catalog.
+func (uc *DoseLimitUseCase) SetDoseLimit(ctx context.Context, input DoseLimitInput) (*DoseLimitOutput, error) {
+	if input.UserID <= 0 || (input.MedicineName == "" && input.MedicineID == "") || input.MaxDailyDose <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "dose_limit.set", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	medicineID, medicineName, err := resolveMedicine(ctx, uc.medicineRepo, input.MedicineID, input.MedicineName)
+	if err != nil {
+		return nil, err
+	}
+	ingredient, _, err := ingredientOf(ctx, uc.medicineRepo, nil, medicineID, medicineName)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := entities.NewDoseLimit(input.UserID, ingredient, input.MaxDailyDose)
+	if err := uc.doseLimitRepo.Save(ctx, limit); err != nil {
+		return nil, fmt.Errorf("failed to save dose limit: %w", err)
+	}
+
+	return doseLimitOutput(limit), nil
+}
+
+// GetDoseLimits returns the limits the user set, the catalog ones are
+// returned with the medicines.
+func (uc *DoseLimitUseCase) GetDoseLimits(ctx context.Context, userID int64) ([]DoseLimitOutput, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "dose_limit.get", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	limits, err := uc.doseLimitRepo.List(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dose limits: %w", err)
+	}
+
+	output := make([]DoseLimitOutput, len(limits))
+	for i := range limits {
+		output[i] = *doseLimitOutput(&limits[i])
+	}
+	return output, nil
+}
+
+// DeleteDoseLimit removes the limit the user set for the ingredient and
+// returns it, the limit of the catalog applies again.
+func (uc *DoseLimitUseCase) DeleteDoseLimit(ctx context.Context, userID int64, ingredient string) (*DoseLimitOutput, error) {
+	ingredient = medicine.Normalize(ingredient)
+	if userID <= 0 || ingredient == "" {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "dose_limit.delete", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	limit, err := uc.doseLimitRepo.Delete(ctx, userID, ingredient)
+	if err != nil {
+		if errors.Is(err, repository.ErrDoseLimitNotFound) {
+			return nil, ErrDoseLimitNotFound
+		}
+		return nil, fmt.Errorf("failed to delete dose limit: %w", err)
+	}
+	return doseLimitOutput(limit), nil
+}
+
+func doseLimitOutput(limit *entities.DoseLimit) *DoseLimitOutput {
+	return &DoseLimitOutput{
+		UserID:       limit.UserID,
+		Ingredient:   limit.Ingredient,
+		MaxDailyDose: limit.MaxDailyDose,
+	}
+}
+
+// checkDailyDose rejects the schedule if together with the other schedules it
+// takes more of its ingredient in a day than the limit, the one the user set
+// or else the one of the catalog. The schedules with no dose are not counted.
+func (c *scheduleChecks) checkDailyDose(ctx context.Context, schedule *entities.Schedule, others []entities.Schedule) error {
+	if schedule.Dose == 0 {
+		return nil
+	}
+
+	medicines := make(map[string]*entities.Medicine)
+	ingredient, m, err := ingredientOf(ctx, c.medicineRepo, medicines, schedule.MedicineID, schedule.MedicineName)
+	if err != nil {
+		return err
+	}
+
+	var limit float64
+	if m != nil {
+		limit = m.MaxDailyDose
+	}
+	userLimit, err := c.doseLimitRepo.Get(ctx, schedule.UserID, ingredient)
+	switch {
+	case err == nil:
+		limit = userLimit.MaxDailyDose
+	case !errors.Is(err, repository.ErrDoseLimitNotFound):
+		return fmt.Errorf("failed to get dose limit: %w", err)
+	}
+	if limit == 0 {
+		return nil
+	}
+
+	total := schedule.DailyDose()
+	for i := range others {
+		other := &others[i]
+		if other.Dose == 0 {
+			continue
+		}
+		otherIngredient, _, err := ingredientOf(ctx, c.medicineRepo, medicines, other.MedicineID, other.MedicineName)
+		if err != nil {
+			return err
+		}
+		if otherIngredient == ingredient {
+			total += other.DailyDose()
+		}
+	}
+
+	if total > limit {
+		return fmt.Errorf("%w: %g mg of %s a day, the limit is %g mg", ErrDailyDoseExceeded, total, ingredient, limit)
+	}
+	return nil
+}
+
+// ingredientOf returns the normalized ingredient of the catalog medicine with
+// the entry, or the normalized name of a custom medicine, which stands for its
+// ingredient. The catalog entries are cached in medicines when it is not nil.
+func ingredientOf(ctx context.Context, medicineRepo repository.MedicineRepository, medicines map[string]*entities.Medicine,
+	medicineID, medicineName string) (string, *entities.Medicine, error) {
+	if medicineID == "" {
+		return medicine.Normalize(medicineName), nil, nil
+	}
+
+	m, ok := medicines[medicineID]
+	if !ok {
+		var err error
+		m, err = medicineRepo.Get(ctx, medicineID)
+		if err != nil {
+			if errors.Is(err, repository.ErrMedicineNotFound) {
+				return "", nil, ErrMedicineNotFound
+			}
+			return "", nil, fmt.Errorf("failed to get medicine: %w", err)
+		}
+		if medicines != nil {
+			medicines[medicineID] = m
+		}
+	}
+	return medicine.Normalize(m.Ingredient), m, nil
+}
@@ -0,0 +1,248 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// ExportVersion is the version of the export document, imports of other
+// versions are rejected.
+const ExportVersion = 1
+
+type ExportFormat string
+
+const (
+	ExportJSON ExportFormat = "json"
+	ExportCSV  ExportFormat = "csv"
+)
+
+// UserData is the export document. Dates use the YYYY-MM-DD format, taking
+// times HH:MM and intake times RFC 3339. IDs only link the records of one
+// document, imports create new ones. Medicine IDs are the catalog entries and
+// doses are in milligrams, both are omitted when unknown.
+type UserData struct {
+	Version    int                `json:"version"`
+	UserID     int64              `json:"user_id"`
+	ExportedAt time.Time          `json:"exported_at"`
+	Schedules  []ScheduleDocument `json:"schedules"`
+}
+
+type ScheduleDocument struct {
+	ID           int64            `json:"id"`
+	MedicineName string           `json:"medicine_name"`
+	MedicineID   string           `json:"medicine_id,omitempty"`
+	Dose         float64          `json:"dose,omitempty"`
+	StartDate    string           `json:"start_date"`
+	EndDate      string           `json:"end_date,omitempty"`
+	TakingTimes  []string         `json:"taking_times"`
+	Intakes      []IntakeDocument `json:"intakes"`
+}
+
+type IntakeDocument struct {
+	PlannedAt time.Time `json:"planned_at"`
+	TakenAt   time.Time `json:"taken_at"`
+}
+
+// The CSV export is a ZIP of these files, rows reference schedules by ID.
+const (
+	schedulesFile   = "schedules.csv"
+	takingTimesFile = "taking_times.csv"
+	intakesFile     = "intakes.csv"
+)
+
+var (
+	schedulesHeader   = []string{"id", "medicine_name", "start_date", "end_date", "medicine_id", "dose"}
+	takingTimesHeader = []string{"schedule_id", "taking_time"}
+	intakesHeader     = []string{"schedule_id", "planned_at", "taken_at"}
+
+	// shortSchedulesHeader is the header of the exports made before the
+	// medicine IDs and the doses were exported.
+	shortSchedulesHeader = schedulesHeader[:4]
+)
+
+// maxExportFileSize bounds every file of an imported ZIP.
+const maxExportFileSize = 32 << 20
+
+func encodeUserData(data *UserData, format ExportFormat) ([]byte, error) {
+	switch format {
+	case ExportJSON:
+		return json.MarshalIndent(data, "", "  ")
+	case ExportCSV:
+		return encodeCSVZip(data)
+	default:
+		return nil, ErrInvalidInput
+	}
+}
+
+func decodeUserData(raw []byte, format ExportFormat) (*UserData, error) {
+	var data *UserData
+	var err error
+	switch format {
+	case ExportJSON:
+		data = &UserData{}
+		err = json.Unmarshal(raw, data)
+	case ExportCSV:
+		data, err = decodeCSVZip(raw)
+	default:
+		return nil, ErrInvalidInput
+	}
+	if err != nil || data.Version != ExportVersion {
+		return nil, ErrInvalidInput
+	}
+	return data, nil
+}
+
+func encodeCSVZip(data *UserData) ([]byte, error) {
+	schedules := [][]string{schedulesHeader}
+	takingTimes := [][]string{takingTimesHeader}
+	intakes := [][]string{intakesHeader}
+	for _, schedule := range data.Schedules {
+		id := strconv.FormatInt(schedule.ID, 10)
+		dose := ""
+		if schedule.Dose > 0 {
+			dose = strconv.FormatFloat(schedule.Dose, 'f', -1, 64)
+		}
+		schedules = append(schedules, []string{id, schedule.MedicineName, schedule.StartDate, schedule.EndDate,
+			schedule.MedicineID, dose})
+		for _, takingTime := range schedule.TakingTimes {
+			takingTimes = append(takingTimes, []string{id, takingTime})
+		}
+		for _, intake := range schedule.Intakes {
+			intakes = append(intakes, []string{id, intake.PlannedAt.Format(time.RFC3339), intake.TakenAt.Format(time.RFC3339)})
+		}
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, file := range []struct {
+		name    string
+		records [][]string
+	}{
+		{schedulesFile, schedules},
+		{takingTimesFile, takingTimes},
+		{intakesFile, intakes},
+	} {
+		w, err := archive.CreateHeader(&zip.FileHeader{Name: file.name, Method: zip.Deflate, Modified: data.ExportedAt})
+		if err != nil {
+			return nil, err
+		}
+		if err := csv.NewWriter(w).WriteAll(file.records); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeCSVZip(raw []byte) (*UserData, error) {
+	archive, err := zip.NewReader(bytes.NewReader(raw), int64(len(raw)))
+	if err != nil {
+		return nil, err
+	}
+
+	// read returns the records of the file with one of the headers.
+	read := func(name string, headers ...[]string) ([][]string, error) {
+		file, err := archive.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		reader := csv.NewReader(io.LimitReader(file, maxExportFileSize))
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		if len(records) == 0 || !slices.ContainsFunc(headers, func(header []string) bool {
+			return slices.Equal(records[0], header)
+		}) {
+			return nil, fmt.Errorf("%s: unexpected header", name)
+		}
+		return records[1:], nil
+	}
+
+	data := &UserData{Version: ExportVersion}
+	index := make(map[int64]int)
+
+	schedules, err := read(schedulesFile, schedulesHeader, shortSchedulesHeader)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range schedules {
+		id, err := strconv.ParseInt(record[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		document := ScheduleDocument{
+			ID:           id,
+			MedicineName: record[1],
+			StartDate:    record[2],
+			EndDate:      record[3],
+		}
+		if len(record) == len(schedulesHeader) {
+			document.MedicineID = record[4]
+			if record[5] != "" {
+				if document.Dose, err = strconv.ParseFloat(record[5], 64); err != nil {
+					return nil, err
+				}
+			}
+		}
+		index[id] = len(data.Schedules)
+		data.Schedules = append(data.Schedules, document)
+	}
+
+	schedule := func(record []string) (*ScheduleDocument, error) {
+		id, err := strconv.ParseInt(record[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		i, ok := index[id]
+		if !ok {
+			return nil, fmt.Errorf("unknown schedule %d", id)
+		}
+		return &data.Schedules[i], nil
+	}
+
+	takingTimes, err := read(takingTimesFile, takingTimesHeader)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range takingTimes {
+		document, err := schedule(record)
+		if err != nil {
+			return nil, err
+		}
+		document.TakingTimes = append(document.TakingTimes, record[1])
+	}
+
+	intakes, err := read(intakesFile, intakesHeader)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range intakes {
+		document, err := schedule(record)
+		if err != nil {
+			return nil, err
+		}
+		plannedAt, err := time.Parse(time.RFC3339, record[1])
+		if err != nil {
+			return nil, err
+		}
+		takenAt, err := time.Parse(time.RFC3339, record[2])
+		if err != nil {
+			return nil, err
+		}
+		document.Intakes = append(document.Intakes, IntakeDocument{PlannedAt: plannedAt, TakenAt: takenAt})
+	}
+
+	return data, nil
+}
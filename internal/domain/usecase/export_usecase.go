@@ -0,0 +1,463 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"slices"
+	"time"
+)
+
+const (
+	exportDateLayout = "2006-01-02"
+	exportTimeLayout = "15:04"
+)
+
+// ImportPolicy tells what to do with an imported schedule of a medicine the
+// user already has a schedule of.
+type ImportPolicy string
+
+const (
+	// ImportSkip keeps the existing schedule and ignores the imported one
+	// with its intakes.
+	ImportSkip ImportPolicy = "skip"
+	// ImportMerge adds the imported taking times to the existing schedule and
+	// widens its dates to cover both schedules.
+	ImportMerge ImportPolicy = "merge"
+	// ImportReplace overwrites the dates and taking times of the existing
+	// schedule with the imported ones.
+	ImportReplace ImportPolicy = "replace"
+)
+
+// Actions taken on the imported schedules.
+const (
+	ImportCreated   = "created"
+	ImportMerged    = "merged"
+	ImportReplaced  = "replaced"
+	ImportSkipped   = "skipped"
+	ImportUnchanged = "unchanged"
+)
+
+type ImportInput struct {
+	UserID int64
+	Format ExportFormat
+	// Policy defaults to ImportSkip.
+	Policy ImportPolicy
+	Data   []byte
+}
+
+type ImportOutput struct {
+	Schedules []ImportedScheduleOutput
+}
+
+type ImportedScheduleOutput struct {
+	MedicineName string
+	Action       string
+	// ScheduleID is the created or existing schedule.
+	ScheduleID int64
+	// Intakes is the number of recorded intakes, the ones recorded before
+	// are not counted.
+	Intakes int
+	// SkippedIntakes is the number of intakes which are not planned by the
+	// resulting schedule.
+	SkippedIntakes int
+}
+
+type ExportUseCase struct {
+	scheduleRepo repository.ScheduleRepository
+	intakeRepo   repository.IntakeRepository
+	medicineRepo repository.MedicineRepository
+	checks       *scheduleChecks
+	policy       *AccessPolicy
+}
+
+func NewExportUseCase(scheduleRepo repository.ScheduleRepository, intakeRepo repository.IntakeRepository,
+	medicineRepo repository.MedicineRepository,
+	doseLimitRepo repository.DoseLimitRepository, interactions *InteractionChecker, policy *AccessPolicy) *ExportUseCase {
+	return &ExportUseCase{
+		scheduleRepo: scheduleRepo,
+		intakeRepo:   intakeRepo,
+		medicineRepo: medicineRepo,
+		checks: &scheduleChecks{
+			scheduleRepo:  scheduleRepo,
+			medicineRepo:  medicineRepo,
+			doseLimitRepo: doseLimitRepo,
+			interactions:  interactions,
+		},
+		policy: policy,
+	}
+}
+
+// Export returns all schedules of the user with their taking times and intake
+// history as a JSON document or a ZIP of CSV files.
+func (uc *ExportUseCase) Export(ctx context.Context, userID int64, format ExportFormat) ([]byte, error) {
+	if userID <= 0 || (format != ExportJSON && format != ExportCSV) {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "data.export", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	schedules, err := allSchedules(ctx, uc.scheduleRepo, repository.ScheduleFilter{
+		UserID: userID,
+		Status: repository.ScheduleStatusAll,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	intakes, err := uc.intakeRepo.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get intakes: %w", err)
+	}
+	bySchedule := make(map[int64][]IntakeDocument)
+	for _, intake := range intakes {
+		bySchedule[intake.ScheduleID] = append(bySchedule[intake.ScheduleID], IntakeDocument{
+			PlannedAt: intake.PlannedAt,
+			TakenAt:   intake.TakenAt,
+		})
+	}
+
+	data := &UserData{
+		Version:    ExportVersion,
+		UserID:     userID,
+		ExportedAt: TimeNow(),
+		Schedules:  make([]ScheduleDocument, 0, len(schedules)),
+	}
+	for i := range schedules {
+		document := scheduleDocument(&schedules[i])
+		document.ID = schedules[i].ID
+		document.Intakes = bySchedule[schedules[i].ID]
+		if document.Intakes == nil {
+			document.Intakes = make([]IntakeDocument, 0)
+		}
+		data.Schedules = append(data.Schedules, document)
+	}
+
+	encoded, err := encodeUserData(data, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode export: %w", err)
+	}
+	return encoded, nil
+}
+
+// Import recreates the schedules and intakes of an export for the user.
+// Schedules are matched to the existing ones by the medicine, which is unique
+// per user, and intakes by their planned time, so importing the same
+// document again changes nothing. The whole document is validated before
+// anything is written, the created and the changed schedules are checked like
+// by the creations and the updates, failing with their errors. Everything is
+// written in one transaction, so a failed write leaves the user's data as it
+// was.
+func (uc *ExportUseCase) Import(ctx context.Context, input ImportInput) (*ImportOutput, error) {
+	if input.Policy == "" {
+		input.Policy = ImportSkip
+	}
+	if input.UserID <= 0 ||
+		(input.Policy != ImportSkip && input.Policy != ImportMerge && input.Policy != ImportReplace) {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "data.import", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	data, err := decodeUserData(input.Data, input.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := allSchedules(ctx, uc.scheduleRepo, repository.ScheduleFilter{
+		UserID: input.UserID,
+		Status: repository.ScheduleStatusAll,
+	})
+	if err != nil {
+		return nil, err
+	}
+	byKey := medicineKeys(existing)
+
+	loc := TimeNow().Location()
+	plans := make([]importPlan, 0, len(data.Schedules))
+	seen := make(map[string]bool, len(data.Schedules))
+	for _, document := range data.Schedules {
+		// The catalog of the export may have other entries.
+		medicineID, _, err := resolveMedicine(ctx, uc.medicineRepo, document.MedicineID, document.MedicineName)
+		if errors.Is(err, ErrMedicineNotFound) {
+			medicineID, _, err = resolveMedicine(ctx, uc.medicineRepo, "", document.MedicineName)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// A user has one schedule of a medicine.
+		key := entities.MedicineKey(medicineID, document.MedicineName)
+		if seen[key] {
+			return nil, ErrInvalidInput
+		}
+		seen[key] = true
+
+		plan, err := planImport(document, byKey[key], input, loc)
+		if err != nil {
+			return nil, err
+		}
+		plan.schedule.MedicineID = medicineID
+		plans = append(plans, plan)
+	}
+	if err := uc.checkPlans(ctx, input.UserID, plans); err != nil {
+		return nil, err
+	}
+
+	changes := make([]repository.ImportChange, 0, len(plans))
+	outputs := make([]ImportedScheduleOutput, len(plans))
+	recorded := make([][]repository.ImportedIntake, len(plans))
+	for i, plan := range plans {
+		schedule := plan.schedule
+		outputs[i] = ImportedScheduleOutput{MedicineName: schedule.MedicineName, Action: plan.action}
+		if plan.action == ImportSkipped {
+			outputs[i].ScheduleID = plan.current.ID
+			outputs[i].SkippedIntakes = len(plan.intakes)
+			continue
+		}
+
+		change := repository.ImportChange{Schedule: schedule}
+		if plan.action != ImportUnchanged {
+			var before any
+			action := entities.AuditScheduleCreated
+			if plan.current != nil {
+				action, before = entities.AuditScheduleUpdated, plan.current.Snapshot()
+			}
+			entry, err := newAudit(ctx, action, schedule.ID, schedule.UserID, before, schedule.Snapshot())
+			if err != nil {
+				return nil, err
+			}
+			change.Entry = entry
+		}
+
+		for _, document := range plan.intakes {
+			intake, entry, err := importedIntake(ctx, schedule, document, loc)
+			if err != nil {
+				return nil, err
+			}
+			if intake == nil {
+				outputs[i].SkippedIntakes++
+				continue
+			}
+			change.Intakes = append(change.Intakes, repository.ImportedIntake{Intake: intake, Entry: entry})
+		}
+		recorded[i] = change.Intakes
+		changes = append(changes, change)
+	}
+
+	if err := uc.scheduleRepo.Import(ctx, changes); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrAlreadyExists):
+			return nil, ErrScheduleExists
+		case errors.Is(err, repository.ErrVersionMismatch):
+			return nil, ErrScheduleModified
+		}
+		return nil, fmt.Errorf("failed to import schedules: %w", err)
+	}
+
+	for i, plan := range plans {
+		if plan.action == ImportSkipped {
+			continue
+		}
+		outputs[i].ScheduleID = plan.schedule.ID
+		for _, imported := range recorded[i] {
+			if imported.Intake.ID != 0 {
+				outputs[i].Intakes++
+			}
+		}
+	}
+
+	return &ImportOutput{Schedules: outputs}, nil
+}
+
+// importPlan is the change an imported schedule makes, all of them are
+// planned before the first write.
+type importPlan struct {
+	action string
+	// schedule is the schedule to create or to update.
+	schedule *entities.Schedule
+	// current is the existing schedule of the medicine.
+	current *entities.Schedule
+	intakes []IntakeDocument
+}
+
+func planImport(document ScheduleDocument, current *entities.Schedule, input ImportInput,
+	loc *time.Location) (importPlan, error) {
+	schedule, err := documentSchedule(document, input.UserID, loc)
+	if err != nil {
+		return importPlan{}, err
+	}
+	plan := importPlan{action: ImportCreated, schedule: schedule, intakes: document.Intakes}
+
+	if current == nil {
+		return plan, nil
+	}
+	plan.current = current
+
+	switch input.Policy {
+	case ImportSkip:
+		plan.action = ImportSkipped
+		return plan, nil
+	case ImportMerge:
+		plan.action = ImportMerged
+		if plan.schedule, err = mergeImported(current, schedule); err != nil {
+			return importPlan{}, err
+		}
+	default:
+		plan.action = ImportReplaced
+	}
+	// Imports of the exports without doses keep the known one.
+	if plan.schedule.Dose == 0 {
+		plan.schedule.Dose = current.Dose
+	}
+	plan.schedule.ID = current.ID
+	plan.schedule.Version = current.Version
+
+	if sameSchedule(current, plan.schedule) {
+		plan.action = ImportUnchanged
+	}
+	return plan, nil
+}
+
+// checkPlans checks the created and the changed schedules against the active
+// schedules of the user and the schedules planned before them. Only the
+// created schedules are checked for interactions, the changed ones keep their
+// medicine.
+func (uc *ExportUseCase) checkPlans(ctx context.Context, userID int64, plans []importPlan) error {
+	active, err := uc.checks.activeSchedules(ctx, userID, 0)
+	if err != nil {
+		return err
+	}
+	for _, plan := range plans {
+		if plan.action != ImportCreated && plan.action != ImportMerged && plan.action != ImportReplaced {
+			continue
+		}
+		others := active
+		if plan.current != nil {
+			others = slices.DeleteFunc(slices.Clone(active), func(other entities.Schedule) bool {
+				return other.ID == plan.current.ID
+			})
+		}
+		if _, err := uc.checks.checkSchedule(ctx, plan.schedule, others, plan.action == ImportCreated, false); err != nil {
+			return err
+		}
+		active = append(others, *plan.schedule)
+	}
+	return nil
+}
+
+// importedIntake returns the intake to record with its audit entry, or nil
+// when the intake is not planned by the schedule. The schedule ID of a created
+// schedule is set by the repository.
+func importedIntake(ctx context.Context, schedule *entities.Schedule, document IntakeDocument,
+	loc *time.Location) (*entities.Intake, *entities.AuditEntry, error) {
+	plannedAt := document.PlannedAt.In(loc)
+	if !schedule.IsPlannedAt(plannedAt) {
+		return nil, nil, nil
+	}
+
+	intake := &entities.Intake{
+		ScheduleID: schedule.ID,
+		UserID:     schedule.UserID,
+		PlannedAt:  plannedAt,
+		TakenAt:    document.TakenAt.In(loc),
+	}
+	entry, err := newAudit(ctx, entities.AuditIntakeRecorded, schedule.ID, schedule.UserID, nil, intake.Snapshot())
+	if err != nil {
+		return nil, nil, err
+	}
+	return intake, entry, nil
+}
+
+func scheduleDocument(schedule *entities.Schedule) ScheduleDocument {
+	document := ScheduleDocument{
+		MedicineName: schedule.MedicineName,
+		MedicineID:   schedule.MedicineID,
+		Dose:         schedule.Dose,
+		StartDate:    schedule.StartDate.Format(exportDateLayout),
+		TakingTimes:  make([]string, 0, len(schedule.TakingTimes)),
+	}
+	if schedule.EndDate != nil {
+		document.EndDate = schedule.EndDate.Format(exportDateLayout)
+	}
+	for _, takingTime := range schedule.TakingTimes {
+		document.TakingTimes = append(document.TakingTimes, takingTime.Time.Format(exportTimeLayout))
+	}
+	return document
+}
+
+func documentSchedule(document ScheduleDocument, userID int64, loc *time.Location) (*entities.Schedule, error) {
+	if document.MedicineName == "" || document.Dose < 0 {
+		return nil, ErrInvalidInput
+	}
+
+	start, err := time.ParseInLocation(exportDateLayout, document.StartDate, loc)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	var end *time.Time
+	if document.EndDate != "" {
+		endDate, err := time.ParseInLocation(exportDateLayout, document.EndDate, loc)
+		if err != nil {
+			return nil, ErrInvalidInput
+		}
+		end = &endDate
+	}
+
+	takingTimes := make([]entities.TakingTime, 0, len(document.TakingTimes))
+	for _, value := range document.TakingTimes {
+		takingTime, err := time.Parse(exportTimeLayout, value)
+		if err != nil {
+			return nil, ErrInvalidInput
+		}
+		takingTimes = append(takingTimes, entities.TakingTime{
+			Time: time.Date(0, 0, 0, takingTime.Hour(), takingTime.Minute(), 0, 0, time.UTC),
+		})
+	}
+
+	schedule, err := entities.NewScheduleWithTimes(document.MedicineName, userID, start, end, takingTimes)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	schedule.Dose = document.Dose
+	return schedule, nil
+}
+
+// mergeImported returns the existing schedule with the taking times of both
+// schedules over the dates covering both of them.
+func mergeImported(current, imported *entities.Schedule) (*entities.Schedule, error) {
+	start := imported.StartDate
+	if scheduleDocument(current).StartDate < scheduleDocument(imported).StartDate {
+		start = time.Date(current.StartDate.Year(), current.StartDate.Month(), current.StartDate.Day(),
+			0, 0, 0, 0, start.Location())
+	}
+
+	var end *time.Time
+	if current.EndDate != nil && imported.EndDate != nil {
+		end = imported.EndDate
+		if scheduleDocument(current).EndDate > scheduleDocument(imported).EndDate {
+			currentEnd := time.Date(current.EndDate.Year(), current.EndDate.Month(), current.EndDate.Day(),
+				0, 0, 0, 0, start.Location())
+			end = &currentEnd
+		}
+	}
+
+	merged, err := entities.NewScheduleWithTimes(current.MedicineName, current.UserID, start, end,
+		append(slices.Clone(current.TakingTimes), imported.TakingTimes...))
+	if err != nil {
+		// The union of the taking times may exceed their limit.
+		return nil, ErrInvalidInput
+	}
+	merged.Dose = imported.Dose
+	return merged, nil
+}
+
+func sameSchedule(a, b *entities.Schedule) bool {
+	x, y := scheduleDocument(a), scheduleDocument(b)
+	return x.StartDate == y.StartDate && x.EndDate == y.EndDate && x.Dose == y.Dose &&
+		slices.Equal(x.TakingTimes, y.TakingTimes)
+}
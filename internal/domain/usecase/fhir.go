@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/fhir"
+	"strconv"
+	"time"
+)
+
+// MedicationStatements returns the user's schedules as a FHIR bundle of
+// MedicationStatement resources with a daily dosage timing.
+func (uc *ExportUseCase) MedicationStatements(ctx context.Context, userID int64) ([]byte, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "data.export", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	schedules, err := allSchedules(ctx, uc.scheduleRepo, repository.ScheduleFilter{
+		UserID: userID,
+		Status: repository.ScheduleStatusAll,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := TimeNow()
+	statements := make([]fhir.MedicationStatement, len(schedules))
+	for i := range schedules {
+		statements[i] = medicationStatement(&schedules[i], now)
+	}
+
+	return encodeBundle(fhir.NewBundle(statements, now.Format(fhir.DateTimeLayout)))
+}
+
+// MedicationAdministrations returns the user's intake history as a FHIR
+// bundle of MedicationAdministration resources, each one referencing the
+// MedicationStatement of its schedule.
+func (uc *ExportUseCase) MedicationAdministrations(ctx context.Context, userID int64) ([]byte, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "data.export", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	schedules, err := allSchedules(ctx, uc.scheduleRepo, repository.ScheduleFilter{
+		UserID: userID,
+		Status: repository.ScheduleStatusAll,
+	})
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int64]string, len(schedules))
+	for _, schedule := range schedules {
+		names[schedule.ID] = schedule.MedicineName
+	}
+
+	intakes, err := uc.intakeRepo.GetByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get intakes: %w", err)
+	}
+
+	administrations := make([]fhir.MedicationAdministration, len(intakes))
+	for i, intake := range intakes {
+		administrations[i] = fhir.MedicationAdministration{
+			ResourceType:              "MedicationAdministration",
+			ID:                        "intake-" + strconv.FormatInt(intake.ID, 10),
+			Status:                    "completed",
+			MedicationCodeableConcept: &fhir.CodeableConcept{Text: names[intake.ScheduleID]},
+			Subject:                   patientReference(userID),
+			SupportingInformation: []fhir.Reference{
+				{Reference: "MedicationStatement/" + statementID(intake.ScheduleID)},
+			},
+			EffectiveDateTime: intake.TakenAt.Format(fhir.DateTimeLayout),
+			Note: []fhir.Annotation{
+				{Text: "Planned at " + intake.PlannedAt.Format(fhir.DateTimeLayout)},
+			},
+		}
+	}
+
+	return encodeBundle(fhir.NewBundle(administrations, TimeNow().Format(fhir.DateTimeLayout)))
+}
+
+func medicationStatement(schedule *entities.Schedule, now time.Time) fhir.MedicationStatement {
+	status := "active"
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch {
+	case schedule.EndDate != nil && !schedule.EndDate.After(today):
+		status = "completed"
+	case schedule.StartDate.After(now):
+		status = "intended"
+	}
+
+	period := &fhir.Period{Start: schedule.StartDate.Format(fhir.DateLayout)}
+	if schedule.EndDate != nil {
+		// Schedules end at the start of the end date, periods include it.
+		period.End = schedule.EndDate.AddDate(0, 0, -1).Format(fhir.DateLayout)
+	}
+
+	timesOfDay := make([]string, len(schedule.TakingTimes))
+	for i, takingTime := range schedule.TakingTimes {
+		timesOfDay[i] = takingTime.Time.Format(fhir.TimeLayout)
+	}
+
+	return fhir.MedicationStatement{
+		ResourceType:              "MedicationStatement",
+		ID:                        statementID(schedule.ID),
+		Status:                    status,
+		MedicationCodeableConcept: &fhir.CodeableConcept{Text: schedule.MedicineName},
+		Subject:                   patientReference(schedule.UserID),
+		EffectivePeriod:           period,
+		DateAsserted:              now.Format(fhir.DateTimeLayout),
+		Dosage: []fhir.Dosage{{
+			Timing: &fhir.Timing{
+				Repeat: &fhir.TimingRepeat{
+					BoundsPeriod: period,
+					Frequency:    len(schedule.TakingTimes),
+					Period:       1,
+					PeriodUnit:   "d",
+					TimeOfDay:    timesOfDay,
+				},
+			},
+		}},
+	}
+}
+
+func statementID(scheduleID int64) string {
+	return "schedule-" + strconv.FormatInt(scheduleID, 10)
+}
+
+func patientReference(userID int64) fhir.Reference {
+	return fhir.Reference{Reference: "Patient/" + strconv.FormatInt(userID, 10)}
+}
+
+func encodeBundle(bundle *fhir.Bundle) ([]byte, error) {
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode bundle: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/fhir"
+	"time"
+)
+
+var ErrUnsupportedDosage = errors.New("dosage can not be mapped to a daily schedule")
+
+// ImportMedicationRequest creates a schedule from a FHIR MedicationRequest.
+// Every dosage instruction has to repeat daily over the same bounds, their
+// times of the day are merged. Bounds without a start begin on the day the
+// request was authored, or today. The schedule is checked like a created one,
+// a contraindicated medicine is rejected.
+func (uc *ScheduleUseCase) ImportMedicationRequest(ctx context.Context, userID int64, data io.Reader) (*ScheduleOutput, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "schedule.import", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	request, err := fhir.DecodeMedicationRequest(data)
+	if err != nil {
+		if errors.Is(err, fhir.ErrInvalidResource) {
+			return nil, ErrInvalidInput
+		}
+		return nil, fmt.Errorf("failed to read medication request: %w", err)
+	}
+
+	schedule, err := medicationRequestSchedule(request, userID, TimeNow())
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.MedicineID, _, err = resolveMedicine(ctx, uc.medicineRepo, "", schedule.MedicineName)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.scheduleExists(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrScheduleExists
+	}
+
+	others, err := uc.checks.activeSchedules(ctx, userID, 0)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.checks.checkSchedule(ctx, schedule, others, true, false); err != nil {
+		return nil, err
+	}
+
+	entry, err := newAudit(ctx, entities.AuditScheduleCreated, 0, schedule.UserID, nil, schedule.Snapshot())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uc.scheduleRepo.Create(ctx, schedule, entry)
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, ErrScheduleExists
+		}
+		return nil, fmt.Errorf("failed to create a schedule: %w", err)
+	}
+	schedule.ID = id
+
+	return scheduleOutput(ctx, schedule), nil
+}
+
+func medicationRequestSchedule(request *fhir.MedicationRequest, userID int64, now time.Time) (*entities.Schedule, error) {
+	name := request.MedicationName()
+	if name == "" || request.Status != "active" || len(request.DosageInstruction) == 0 {
+		return nil, ErrInvalidInput
+	}
+
+	start := now
+	if request.AuthoredOn != "" {
+		authoredOn, err := fhir.ParseDate(request.AuthoredOn, now.Location())
+		if err != nil {
+			return nil, ErrInvalidInput
+		}
+		start = authoredOn
+	}
+
+	var takingTimes []entities.TakingTime
+	var first time.Time
+	var end *time.Time
+	for i, dosage := range request.DosageInstruction {
+		if dosage.AsNeededBoolean {
+			return nil, ErrUnsupportedDosage
+		}
+
+		times, err := dosageTimes(dosage.Timing)
+		if err != nil {
+			return nil, err
+		}
+		takingTimes = append(takingTimes, times...)
+
+		dosageStart, dosageEnd, err := dosage.Timing.Repeat.Bounds(start, len(times))
+		if err != nil {
+			return nil, dosageError(err)
+		}
+		sameEnd := (end == nil && dosageEnd == nil) || (end != nil && dosageEnd != nil && end.Equal(*dosageEnd))
+		if i > 0 && (!first.Equal(dosageStart) || !sameEnd) {
+			return nil, ErrUnsupportedDosage
+		}
+		first, end = dosageStart, dosageEnd
+	}
+
+	schedule, err := entities.NewScheduleWithTimes(name, userID, first, end, takingTimes)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidDuration) {
+			return nil, ErrInvalidInput
+		}
+		return nil, ErrUnsupportedDosage
+	}
+	return schedule, nil
+}
+
+// dosageTimes returns the taking times of a daily timing, the ones which only
+// tell how many times a day are spread like the times of new schedules.
+func dosageTimes(timing *fhir.Timing) ([]entities.TakingTime, error) {
+	daily, err := fhir.Daily(timing)
+	if err != nil {
+		return nil, dosageError(err)
+	}
+
+	if len(daily.Times) == 0 {
+		takingTimes, err := entities.CalculateTakingTimes(daily.Frequency)
+		if err != nil {
+			return nil, ErrUnsupportedDosage
+		}
+		return takingTimes, nil
+	}
+
+	takingTimes := make([]entities.TakingTime, len(daily.Times))
+	for i, timeOfDay := range daily.Times {
+		takingTimes[i] = entities.TakingTime{
+			Time: time.Date(0, 0, 0, timeOfDay.Hour, timeOfDay.Minute, 0, 0, time.UTC),
+		}
+	}
+	return takingTimes, nil
+}
+
+func dosageError(err error) error {
+	if errors.Is(err, fhir.ErrUnsupportedTiming) {
+		return ErrUnsupportedDosage
+	}
+	return ErrInvalidInput
+}
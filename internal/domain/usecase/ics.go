@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/i18n"
+	"pills-taking-reminder/pkg/ical"
+	"time"
+)
+
+const (
+	icsProdID = "-//pills-taking-reminder//schedules//EN"
+	// icsEventDuration is how long a taking occupies in the calendar.
+	icsEventDuration = 15 * time.Minute
+	// icsRefreshInterval tells subscribed calendar apps how often to reload
+	// the feed.
+	icsRefreshInterval = time.Hour
+)
+
+var ErrFeedNotFound = errors.New("calendar feed was not found")
+
+type CalendarFeedOutput struct {
+	UserID int64
+	// Path is the feed URL path with the token, it is only known when the
+	// feed is created.
+	Path      string
+	CreatedAt time.Time
+}
+
+// ExportICS returns the user's schedules as an iCalendar object with a daily
+// recurring event per taking time.
+func (uc *CalendarUseCase) ExportICS(ctx context.Context, userID int64) ([]byte, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "calendar.export", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	return uc.schedulesICS(ctx, userID)
+}
+
+// CreateFeed issues a new feed token for the user, the previous feed URL stops
+// working.
+func (uc *CalendarUseCase) CreateFeed(ctx context.Context, userID int64) (*CalendarFeedOutput, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "calendar.feed.create", entities.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	feed, token, err := entities.NewCalendarFeed(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.feedRepo.Save(ctx, feed); err != nil {
+		return nil, fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+
+	return &CalendarFeedOutput{
+		UserID:    feed.UserID,
+		Path:      FeedPath(token),
+		CreatedAt: feed.CreatedAt,
+	}, nil
+}
+
+func (uc *CalendarUseCase) RevokeFeed(ctx context.Context, userID int64) error {
+	if userID <= 0 {
+		return ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "calendar.feed.revoke", entities.PermissionManage); err != nil {
+		return err
+	}
+
+	if err := uc.feedRepo.Delete(ctx, userID); err != nil {
+		if errors.Is(err, repository.ErrFeedNotFound) {
+			return ErrFeedNotFound
+		}
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+
+	return nil
+}
+
+// FeedICS returns the schedules of the feed owner. The token is the only
+// authorization, the request has no actor.
+func (uc *CalendarUseCase) FeedICS(ctx context.Context, token string) ([]byte, error) {
+	if token == "" {
+		return nil, ErrFeedNotFound
+	}
+
+	feed, err := uc.feedRepo.GetByHash(ctx, entities.HashAPIKey(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrFeedNotFound) {
+			return nil, ErrFeedNotFound
+		}
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+
+	return uc.schedulesICS(ctx, feed.UserID)
+}
+
+// FeedPath is the path of the feed served by the HTTP API.
+func FeedPath(token string) string {
+	return "/feeds/" + token + ".ics"
+}
+
+func (uc *CalendarUseCase) schedulesICS(ctx context.Context, userID int64) ([]byte, error) {
+	schedules, err := allSchedules(ctx, uc.scheduleRepo, repository.ScheduleFilter{
+		UserID: userID,
+		Status: repository.ScheduleStatusAll,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	locale, err := uc.icsLocale(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := ical.NewComponent("VCALENDAR").
+		Add("VERSION", "2.0").
+		Add("PRODID", icsProdID).
+		Add("CALSCALE", "GREGORIAN").
+		AddText("X-WR-CALNAME", i18n.T(locale, "Medicines")).
+		Add("X-PUBLISHED-TTL", ical.FormatDuration(icsRefreshInterval))
+
+	stamp := ical.FormatUTC(TimeNow())
+	for i := range schedules {
+		if schedules[i].Paused {
+			continue
+		}
+		for _, event := range scheduleEvents(&schedules[i], stamp, locale) {
+			calendar.AddComponent(event)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := ical.Encode(&buf, calendar); err != nil {
+		return nil, fmt.Errorf("failed to encode calendar: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// icsLocale returns the locale of the request, or the profile locale of the
+// user when the request has none, as calendar apps send no Accept-Language.
+func (uc *CalendarUseCase) icsLocale(ctx context.Context, userID int64) (i18n.Locale, error) {
+	if i18n.Selected(ctx) {
+		return i18n.FromContext(ctx), nil
+	}
+
+	locale, ok, err := profileLocale(ctx, uc.profileRepo, userID)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return i18n.Default, nil
+	}
+	return locale, nil
+}
+
+// scheduleEvents returns a daily event per taking time of the schedule. Times
+// are floating, so they follow the time zone of the device like the
+// reminders do.
+func scheduleEvents(schedule *entities.Schedule, stamp string, locale i18n.Locale) []*ical.Component {
+	rule := "FREQ=DAILY"
+	if schedule.EndDate != nil {
+		// Takings stop at the start of the end date.
+		end := schedule.EndDate
+		rule += ";UNTIL=" + ical.FormatDateTime(time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC))
+	}
+
+	events := make([]*ical.Component, 0, len(schedule.TakingTimes))
+	for _, takingTime := range schedule.TakingTimes {
+		start := time.Date(schedule.StartDate.Year(), schedule.StartDate.Month(), schedule.StartDate.Day(),
+			takingTime.Time.Hour(), takingTime.Time.Minute(), 0, 0, time.UTC)
+		description := i18n.Tf(locale, "Take %s", schedule.MedicineName)
+
+		alarm := ical.NewComponent("VALARM").
+			Add("ACTION", "DISPLAY").
+			Add("TRIGGER", ical.FormatDuration(0)).
+			AddText("DESCRIPTION", description)
+
+		event := ical.NewComponent("VEVENT").
+			Add("UID", fmt.Sprintf("schedule-%d-%s@pills-taking-reminder", schedule.ID, start.Format("1504"))).
+			Add("DTSTAMP", stamp).
+			Add("DTSTART", ical.FormatDateTime(start)).
+			Add("DURATION", ical.FormatDuration(icsEventDuration)).
+			Add("RRULE", rule).
+			AddText("SUMMARY", schedule.MedicineName).
+			AddText("DESCRIPTION", description).
+			AddComponent(alarm)
+		events = append(events, event)
+	}
+	return events
+}
@@ -0,0 +1,320 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/ical"
+	"pills-taking-reminder/pkg/medicine"
+	"slices"
+	"time"
+)
+
+// Reasons of the events which could not be mapped to schedules.
+const (
+	skipNoSummary      = "event has no summary"
+	skipCancelled      = "event is cancelled"
+	skipOccurrence     = "event changes a single occurrence of a recurring event"
+	skipNoStart        = "event has no start time"
+	skipAllDay         = "all-day event has no taking time"
+	skipNotRecurring   = "event does not repeat"
+	skipInvalidRule    = "recurrence rule is invalid"
+	skipNotDaily       = "only rules repeating every day are supported"
+	skipInvalidTimes   = "event has no valid taking times or more than 15 of them"
+	skipInvalidEnd     = "recurrence ends before it starts"
+	skipConflict       = "event of the same medicine has other start or end dates"
+	skipScheduleExists = "schedule of the medicine already exists"
+
+	// The schedules which failed the checks of the created ones.
+	skipContraindicated = "medicine is contraindicated with the medicine of an active schedule"
+	skipSeparation      = "taking times can not be kept apart from the takings of an interacting medicine"
+	skipDailyDose       = "daily dose exceeds the limit"
+)
+
+type ICSImportOutput struct {
+	DryRun bool
+	// Schedules are the created schedules, without IDs on a dry run.
+	Schedules []ScheduleOutput
+	Skipped   []SkippedEventOutput
+}
+
+type SkippedEventOutput struct {
+	UID     string
+	Summary string
+	Reason  string
+}
+
+// ImportICS creates schedules from the daily recurring events of an iCalendar
+// object. Events of the same medicine are merged into one schedule with their
+// times. The schedules are checked like the created ones against the active
+// schedules and the schedules imported before them, the ones which fail are
+// skipped. On a dry run nothing is created, the output tells what would be.
+func (uc *ScheduleUseCase) ImportICS(ctx context.Context, userID int64, data io.Reader, dryRun bool) (*ICSImportOutput, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "schedule.import", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	calendar, err := ical.Decode(data)
+	if err != nil {
+		if errors.Is(err, ical.ErrMalformed) {
+			return nil, ErrInvalidInput
+		}
+		return nil, fmt.Errorf("failed to read calendar: %w", err)
+	}
+	if calendar.Name != "VCALENDAR" {
+		return nil, ErrInvalidInput
+	}
+
+	output := &ICSImportOutput{
+		DryRun:    dryRun,
+		Schedules: make([]ScheduleOutput, 0),
+		Skipped:   make([]SkippedEventOutput, 0),
+	}
+	skip := func(event *ical.Component, reason string) {
+		output.Skipped = append(output.Skipped, SkippedEventOutput{
+			UID:     eventText(event, "UID"),
+			Summary: eventText(event, "SUMMARY"),
+			Reason:  reason,
+		})
+	}
+
+	loc := TimeNow().Location()
+	var schedules []*entities.Schedule
+	byKey := make(map[string]*entities.Schedule)
+	for _, event := range calendar.Children("VEVENT") {
+		schedule, reason := eventSchedule(event, userID, loc)
+		if reason != "" {
+			skip(event, reason)
+			continue
+		}
+		schedule.MedicineID, _, err = resolveMedicine(ctx, uc.medicineRepo, "", schedule.MedicineName)
+		if err != nil {
+			return nil, err
+		}
+
+		same, ok := byKey[schedule.MedicineKey()]
+		if !ok {
+			byKey[schedule.MedicineKey()] = schedule
+			schedules = append(schedules, schedule)
+			continue
+		}
+		merged, ok := mergeSchedules(same, schedule)
+		if !ok {
+			skip(event, skipConflict)
+			continue
+		}
+		*same = *merged
+	}
+
+	others, err := uc.checks.activeSchedules(ctx, userID, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, schedule := range schedules {
+		exists, err := uc.scheduleExists(ctx, schedule)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			output.Skipped = append(output.Skipped, SkippedEventOutput{
+				Summary: schedule.MedicineName,
+				Reason:  skipScheduleExists,
+			})
+			continue
+		}
+
+		reason, err := checkReason(uc.checks.checkSchedule(ctx, schedule, others, true, false))
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			output.Skipped = append(output.Skipped, SkippedEventOutput{
+				Summary: schedule.MedicineName,
+				Reason:  reason,
+			})
+			continue
+		}
+		others = append(others, *schedule)
+
+		if !dryRun {
+			entry, err := newAudit(ctx, entities.AuditScheduleCreated, 0, schedule.UserID, nil, schedule.Snapshot())
+			if err != nil {
+				return nil, err
+			}
+			id, err := uc.scheduleRepo.Create(ctx, schedule, entry)
+			if err != nil {
+				if errors.Is(err, repository.ErrAlreadyExists) {
+					return nil, ErrScheduleExists
+				}
+				return nil, fmt.Errorf("failed to create a schedule: %w", err)
+			}
+			schedule.ID = id
+		}
+
+		output.Schedules = append(output.Schedules, *scheduleOutput(ctx, schedule))
+	}
+
+	return output, nil
+}
+
+// eventSchedule maps the event to a schedule or returns the reason why it
+// can not be mapped.
+func eventSchedule(event *ical.Component, userID int64, loc *time.Location) (*entities.Schedule, string) {
+	name := eventText(event, "SUMMARY")
+	if medicine.Normalize(name) == "" {
+		return nil, skipNoSummary
+	}
+	if eventText(event, "STATUS") == "CANCELLED" {
+		return nil, skipCancelled
+	}
+	if _, ok := event.Get("RECURRENCE-ID"); ok {
+		return nil, skipOccurrence
+	}
+
+	startProperty, ok := event.Get("DTSTART")
+	if !ok {
+		return nil, skipNoStart
+	}
+	start, dateOnly, err := ical.ParseDateTime(startProperty, loc)
+	if err != nil {
+		return nil, skipNoStart
+	}
+	if dateOnly {
+		return nil, skipAllDay
+	}
+
+	ruleProperty, ok := event.Get("RRULE")
+	if !ok {
+		return nil, skipNotRecurring
+	}
+	rule, err := ical.ParseRule(ruleProperty.Value)
+	if err != nil {
+		return nil, skipInvalidRule
+	}
+	if rule.Freq != "DAILY" || rule.Interval != 1 || len(rule.Other) > 0 {
+		return nil, skipNotDaily
+	}
+
+	takingTimes, ok := ruleTimes(rule, start)
+	if !ok {
+		return nil, skipInvalidTimes
+	}
+
+	var end *time.Time
+	switch {
+	case rule.Until != "":
+		until, untilDateOnly, err := ical.ParseDateTime(ical.Property{Value: rule.Until}, loc)
+		if err != nil {
+			return nil, skipInvalidRule
+		}
+		// Schedules end at the start of a day, the day of UNTIL is kept if
+		// its first taking is not later than UNTIL.
+		first := slices.MinFunc(takingTimes, func(a, b entities.TakingTime) int {
+			return a.Time.Hour()*60 + a.Time.Minute() - (b.Time.Hour()*60 + b.Time.Minute())
+		}).Time
+		lastDay := time.Date(until.Year(), until.Month(), until.Day(), 0, 0, 0, 0, loc)
+		firstTaking := lastDay.Add(time.Duration(first.Hour())*time.Hour + time.Duration(first.Minute())*time.Minute)
+		if untilDateOnly || !firstTaking.After(until) {
+			lastDay = lastDay.AddDate(0, 0, 1)
+		}
+		end = &lastDay
+	case rule.Count > 0:
+		days := (rule.Count + len(takingTimes) - 1) / len(takingTimes)
+		lastDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, days)
+		end = &lastDay
+	}
+
+	schedule, err := entities.NewScheduleWithTimes(name, userID, start, end, takingTimes)
+	if err != nil {
+		if errors.Is(err, entities.ErrInvalidDuration) {
+			return nil, skipInvalidEnd
+		}
+		return nil, skipInvalidTimes
+	}
+	return schedule, ""
+}
+
+// ruleTimes returns the times of the day of a daily rule, which are the
+// BYHOUR and BYMINUTE combinations or the time of DTSTART.
+func ruleTimes(rule ical.Rule, start time.Time) ([]entities.TakingTime, bool) {
+	hours, minutes := rule.ByHour, rule.ByMinute
+	if len(hours) == 0 {
+		hours = []int{start.Hour()}
+	}
+	if len(minutes) == 0 {
+		minutes = []int{start.Minute()}
+	}
+	if len(hours)*len(minutes) > 15 {
+		return nil, false
+	}
+
+	var takingTimes []entities.TakingTime
+	for _, hour := range hours {
+		for _, minute := range minutes {
+			if hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+				return nil, false
+			}
+			takingTimes = append(takingTimes, entities.TakingTime{
+				Time: time.Date(0, 0, 0, hour, minute, 0, 0, time.UTC),
+			})
+		}
+	}
+	return takingTimes, true
+}
+
+// mergeSchedules combines the taking times of two events of one medicine,
+// which must run over the same dates.
+func mergeSchedules(a, b *entities.Schedule) (*entities.Schedule, bool) {
+	sameEnd := (a.EndDate == nil && b.EndDate == nil) ||
+		(a.EndDate != nil && b.EndDate != nil && a.EndDate.Equal(*b.EndDate))
+	if !a.StartDate.Equal(b.StartDate) || !sameEnd {
+		return nil, false
+	}
+
+	merged, err := entities.NewScheduleWithTimes(a.MedicineName, a.UserID, a.StartDate, a.EndDate,
+		append(slices.Clone(a.TakingTimes), b.TakingTimes...))
+	if err != nil {
+		return nil, false
+	}
+	merged.MedicineID = a.MedicineID
+	return merged, true
+}
+
+// checkReason returns the reason to skip a schedule which failed the checks,
+// or the error of the checks themselves.
+func checkReason(_ []InteractionWarning, err error) (string, error) {
+	switch {
+	case errors.Is(err, ErrContraindicated):
+		return skipContraindicated, nil
+	case errors.Is(err, ErrSeparationUnsatisfiable):
+		return skipSeparation, nil
+	case errors.Is(err, ErrDailyDoseExceeded):
+		return skipDailyDose, nil
+	}
+	return "", err
+}
+
+// scheduleExists reports whether the user already has a schedule of the
+// medicine, under this or another of its names.
+func (uc *ScheduleUseCase) scheduleExists(ctx context.Context, schedule *entities.Schedule) (bool, error) {
+	schedules, err := allSchedules(ctx, uc.scheduleRepo, repository.ScheduleFilter{
+		UserID: schedule.UserID,
+		Status: repository.ScheduleStatusAll,
+	})
+	if err != nil {
+		return false, err
+	}
+	_, ok := medicineKeys(schedules)[schedule.MedicineKey()]
+	return ok, nil
+}
+
+func eventText(event *ical.Component, name string) string {
+	property, _ := event.Get(name)
+	return ical.UnescapeText(property.Value)
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/mw"
+	"time"
+)
+
+// IdempotencyUseCase stores the responses to requests with an idempotency key
+// for the ttl. It is the mw.IdempotencyStore of the servers. A key stays
+// reserved for the lock period at most, so the retries of a request which
+// crashed before completing are executed again.
+type IdempotencyUseCase struct {
+	idempotencyRepo repository.IdempotencyRepository
+	ttl             time.Duration
+	lock            time.Duration
+}
+
+func NewIdempotencyUseCase(idempotencyRepo repository.IdempotencyRepository, ttl, lock time.Duration) *IdempotencyUseCase {
+	return &IdempotencyUseCase{
+		idempotencyRepo: idempotencyRepo,
+		ttl:             ttl,
+		lock:            lock,
+	}
+}
+
+func (uc *IdempotencyUseCase) Begin(ctx context.Context, key, requestHash string) (*mw.IdempotencyLease,
+	*mw.IdempotentResponse, error) {
+	record := entities.NewIdempotencyRecord(key, requestHash, uc.ttl, uc.lock)
+	reserved, err := uc.idempotencyRepo.Reserve(ctx, record)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if reserved {
+		return &mw.IdempotencyLease{
+			Key:         record.Key,
+			RequestHash: record.RequestHash,
+			LockedUntil: record.LockedUntil,
+		}, nil, nil
+	}
+
+	stored, err := uc.idempotencyRepo.Get(ctx, key)
+	if err != nil {
+		// The request which held the key has failed meanwhile.
+		if errors.Is(err, repository.ErrIdempotencyKeyNotFound) {
+			return nil, nil, mw.ErrIdempotencyKeyInUse
+		}
+		return nil, nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	switch {
+	case stored.RequestHash != requestHash:
+		return nil, nil, mw.ErrIdempotencyKeyReused
+	case !stored.Completed:
+		return nil, nil, mw.ErrIdempotencyKeyInUse
+	}
+
+	return nil, &mw.IdempotentResponse{
+		StatusCode:  stored.StatusCode,
+		ContentType: stored.ContentType,
+		Headers:     stored.Headers,
+		Body:        stored.Body,
+	}, nil
+}
+
+// Complete stores the response unless the lock of the lease has expired and
+// a retry has reserved the key, then the response of the retry is kept.
+func (uc *IdempotencyUseCase) Complete(ctx context.Context, lease mw.IdempotencyLease, response mw.IdempotentResponse) error {
+	record := leaseRecord(lease)
+	record.StatusCode = response.StatusCode
+	record.ContentType = response.ContentType
+	record.Headers = response.Headers
+	record.Body = response.Body
+	if err := uc.idempotencyRepo.Complete(ctx, record); err != nil {
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release deletes the reservation of the lease, a retry which has reserved
+// the key meanwhile keeps it.
+func (uc *IdempotencyUseCase) Release(ctx context.Context, lease mw.IdempotencyLease) error {
+	if err := uc.idempotencyRepo.Delete(ctx, leaseRecord(lease)); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func leaseRecord(lease mw.IdempotencyLease) *entities.IdempotencyRecord {
+	return &entities.IdempotencyRecord{
+		Key:         lease.Key,
+		RequestHash: lease.RequestHash,
+		LockedUntil: lease.LockedUntil,
+	}
+}
+
+// Cleanup removes the expired responses and returns their number.
+func (uc *IdempotencyUseCase) Cleanup(ctx context.Context) (int64, error) {
+	deleted, err := uc.idempotencyRepo.DeleteExpired(ctx, TimeNow())
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return deleted, nil
+}
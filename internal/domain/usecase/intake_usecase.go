@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"time"
+)
+
+var ErrIntakeExists = errors.New("intake already recorded")
+
+type IntakeInput struct {
+	UserID     int64
+	ScheduleID int64
+	PlannedAt  time.Time
+	TakenAt    time.Time
+}
+
+type IntakeOutput struct {
+	ID           int64
+	ScheduleID   int64
+	MedicineName string
+	PlannedAt    time.Time
+	TakenAt      time.Time
+}
+
+type IntakeUseCase struct {
+	scheduleRepo repository.ScheduleRepository
+	intakeRepo   repository.IntakeRepository
+	takingRepo   repository.TakingRepository
+	policy       *AccessPolicy
+}
+
+func NewIntakeUseCase(
+	scheduleRepo repository.ScheduleRepository,
+	intakeRepo repository.IntakeRepository,
+	takingRepo repository.TakingRepository,
+	policy *AccessPolicy,
+) *IntakeUseCase {
+	return &IntakeUseCase{
+		scheduleRepo: scheduleRepo,
+		intakeRepo:   intakeRepo,
+		takingRepo:   takingRepo,
+		policy:       policy,
+	}
+}
+
+// RecordIntake marks the planned taking as taken, which stops any pending
+// snooze or escalation for it.
+func (uc *IntakeUseCase) RecordIntake(ctx context.Context, input IntakeInput) (*IntakeOutput, error) {
+	if input.UserID <= 0 || input.ScheduleID <= 0 || input.PlannedAt.IsZero() {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "intake.record", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	now := TimeNow()
+	plannedAt := input.PlannedAt.In(now.Location())
+	takenAt := now
+	if !input.TakenAt.IsZero() {
+		takenAt = input.TakenAt.In(now.Location())
+	}
+
+	if takenAt.After(now) {
+		return nil, ErrInvalidInput
+	}
+
+	schedule, err := uc.scheduleRepo.GetByID(ctx, input.UserID, input.ScheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	if !schedule.IsPlannedAt(plannedAt) {
+		return nil, ErrTakingNotPlanned
+	}
+
+	intake := &entities.Intake{
+		ScheduleID: schedule.ID,
+		UserID:     schedule.UserID,
+		PlannedAt:  plannedAt,
+		TakenAt:    takenAt,
+	}
+
+	entry, err := newAudit(ctx, entities.AuditIntakeRecorded, schedule.ID, schedule.UserID, nil, intake.Snapshot())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uc.intakeRepo.Create(ctx, intake, entry)
+	if err != nil {
+		if errors.Is(err, repository.ErrIntakeExists) {
+			return nil, ErrIntakeExists
+		}
+		return nil, fmt.Errorf("failed to record intake: %w", err)
+	}
+	intake.ID = id
+
+	taking, err := uc.takingRepo.Get(ctx, schedule.ID, plannedAt)
+	if err != nil && !errors.Is(err, repository.ErrTakingNotFound) {
+		return nil, fmt.Errorf("failed to get planned taking: %w", err)
+	}
+	if taking == nil {
+		taking = entities.NewPlannedTaking(schedule, plannedAt)
+	}
+	taking.Status = entities.TakingTaken
+	taking.SnoozedUntil = nil
+	if _, err := uc.takingRepo.Save(ctx, taking); err != nil {
+		return nil, fmt.Errorf("failed to save planned taking: %w", err)
+	}
+
+	return &IntakeOutput{
+		ID:           id,
+		ScheduleID:   schedule.ID,
+		MedicineName: schedule.MedicineName,
+		PlannedAt:    plannedAt,
+		TakenAt:      takenAt,
+	}, nil
+}
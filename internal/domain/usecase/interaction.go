@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"sort"
+)
+
+var (
+	// ErrContraindicated is returned by the creations of a schedule of a
+	// medicine contraindicated with one of the active schedules, unless
+	// overridden.
+	ErrContraindicated = errors.New("medicine is contraindicated with an active schedule")
+	// ErrSeparationUnsatisfiable is returned by the changes of the schedules
+	// whose takings can't be kept apart from the takings of an interacting
+	// medicine within the waking window. It wraps the
+	// *entities.SeparationError telling the interacting schedule.
+	ErrSeparationUnsatisfiable = errors.New("taking times can't be separated")
+)
+
+var severityRanks = map[entities.InteractionSeverity]int{
+	entities.SeverityMinor:           1,
+	entities.SeverityModerate:        2,
+	entities.SeverityMajor:           3,
+	entities.SeverityContraindicated: 4,
+}
+
+// InteractionWarning tells that the medicine of a new schedule interacts with
+// the medicine of an active schedule of the user.
+type InteractionWarning struct {
+	ScheduleID   int64
+	MedicineID   string
+	MedicineName string
+	Severity     entities.InteractionSeverity
+	Description  string
+}
+
+// InteractionChecker finds the interactions of the catalog medicines in the
+// bundled dataset. Custom medicines interact with nothing.
+type InteractionChecker struct {
+	interactions map[[2]string]entities.Interaction
+}
+
+func NewInteractionChecker(interactions []entities.Interaction) *InteractionChecker {
+	checker := &InteractionChecker{
+		interactions: make(map[[2]string]entities.Interaction, 2*len(interactions)),
+	}
+	for _, interaction := range interactions {
+		a, b := interaction.MedicineIDs[0], interaction.MedicineIDs[1]
+		checker.interactions[[2]string{a, b}] = interaction
+		checker.interactions[[2]string{b, a}] = interaction
+	}
+	return checker
+}
+
+// Check returns the warnings of the interactions of the medicine with the
+// medicines of the schedules, the most severe first.
+func (c *InteractionChecker) Check(medicineID string, schedules []entities.Schedule) []InteractionWarning {
+	if c == nil || medicineID == "" {
+		return nil
+	}
+
+	var warnings []InteractionWarning
+	for _, schedule := range schedules {
+		interaction, ok := c.interactions[[2]string{medicineID, schedule.MedicineID}]
+		if !ok {
+			continue
+		}
+		warnings = append(warnings, InteractionWarning{
+			ScheduleID:   schedule.ID,
+			MedicineID:   schedule.MedicineID,
+			MedicineName: schedule.MedicineName,
+			Severity:     interaction.Severity,
+			Description:  interaction.Description,
+		})
+	}
+
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return severityRanks[warnings[i].Severity] > severityRanks[warnings[j].Severity]
+	})
+	return warnings
+}
+
+// Separations returns the constraints of the taking times of the medicine
+// against the schedules of the medicines it has to be taken apart from.
+func (c *InteractionChecker) Separations(medicineID string, schedules []entities.Schedule) []entities.SeparationConstraint {
+	if c == nil || medicineID == "" {
+		return nil
+	}
+
+	var constraints []entities.SeparationConstraint
+	for _, schedule := range schedules {
+		interaction, ok := c.interactions[[2]string{medicineID, schedule.MedicineID}]
+		if !ok || interaction.MinSeparation == 0 {
+			continue
+		}
+		constraints = append(constraints, entities.SeparationConstraint{
+			ScheduleID:    schedule.ID,
+			MedicineID:    schedule.MedicineID,
+			MedicineName:  schedule.MedicineName,
+			MinSeparation: interaction.MinSeparation,
+			TakingTimes:   schedule.TakingTimes,
+		})
+	}
+	return constraints
+}
+
+// separateTakings shifts the taking times of the schedule apart from the
+// takings of the other schedules, see entities.SeparateTakingTimes.
+func (c *scheduleChecks) separateTakings(schedule *entities.Schedule, others []entities.Schedule) error {
+	if c.interactions == nil || schedule.MedicineID == "" {
+		return nil
+	}
+
+	times, err := entities.SeparateTakingTimes(schedule.TakingTimes, c.interactions.Separations(schedule.MedicineID, others))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSeparationUnsatisfiable, err)
+	}
+	schedule.TakingTimes = times
+	return nil
+}
+
+// contraindicated reports whether one of the warnings forbids the creation.
+func contraindicated(warnings []InteractionWarning) bool {
+	for _, warning := range warnings {
+		if warning.Severity == entities.SeverityContraindicated {
+			return true
+		}
+	}
+	return false
+}
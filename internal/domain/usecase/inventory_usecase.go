@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/i18n"
+)
+
+var ErrPackNotFound = errors.New("pack was not found")
+
+type RefillInput struct {
+	UserID        int64
+	ScheduleID    int64
+	Quantity      int
+	DosePerTaking int
+}
+
+type InventoryOutput struct {
+	ScheduleID    int64
+	MedicineName  string
+	Quantity      int
+	DosePerTaking int
+	Remaining     int
+	DaysLeft      float64
+	RefilledAt    string
+}
+
+type InventoryUseCase struct {
+	scheduleRepo  repository.ScheduleRepository
+	inventoryRepo repository.InventoryRepository
+	policy        *AccessPolicy
+}
+
+func NewInventoryUseCase(scheduleRepo repository.ScheduleRepository, inventoryRepo repository.InventoryRepository,
+	policy *AccessPolicy) *InventoryUseCase {
+	return &InventoryUseCase{
+		scheduleRepo:  scheduleRepo,
+		inventoryRepo: inventoryRepo,
+		policy:        policy,
+	}
+}
+
+// RecordRefill resets the pack count of the schedule, which also re-arms the
+// low-stock notification.
+func (uc *InventoryUseCase) RecordRefill(ctx context.Context, input RefillInput) (*InventoryOutput, error) {
+	if input.UserID <= 0 || input.ScheduleID <= 0 || input.Quantity < 1 || input.DosePerTaking < 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "inventory.refill", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	if input.DosePerTaking == 0 {
+		input.DosePerTaking = 1
+	}
+
+	schedule, err := uc.scheduleRepo.GetByID(ctx, input.UserID, input.ScheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	pack, err := entities.NewPack(schedule.ID, schedule.UserID, input.Quantity, input.DosePerTaking)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+
+	id, err := uc.inventoryRepo.Refill(ctx, pack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record refill: %w", err)
+	}
+	pack.ID = id
+
+	return inventoryOutput(ctx, schedule, pack), nil
+}
+
+func (uc *InventoryUseCase) GetInventory(ctx context.Context, userID, scheduleID int64) (*InventoryOutput, error) {
+	if userID <= 0 || scheduleID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "inventory.get", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	schedule, err := uc.scheduleRepo.GetByID(ctx, userID, scheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	pack, err := uc.inventoryRepo.GetByScheduleID(ctx, userID, scheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPackNotFound) {
+			return nil, ErrPackNotFound
+		}
+		return nil, fmt.Errorf("failed to get pack: %w", err)
+	}
+
+	return inventoryOutput(ctx, schedule, pack), nil
+}
+
+func inventoryOutput(ctx context.Context, schedule *entities.Schedule, pack *entities.Pack) *InventoryOutput {
+	now := TimeNow()
+	return &InventoryOutput{
+		ScheduleID:    schedule.ID,
+		MedicineName:  schedule.MedicineName,
+		Quantity:      pack.Quantity,
+		DosePerTaking: pack.DosePerTaking,
+		Remaining:     pack.Remaining(schedule, now),
+		DaysLeft:      pack.DaysLeft(schedule, now),
+		RefilledAt:    i18n.FormatDateTime(i18n.FromContext(ctx), pack.RefilledAt),
+	}
+}
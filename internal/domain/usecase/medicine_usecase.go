@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/medicine"
+)
+
+const (
+	// DefaultMedicineSearchLimit and MaxMedicineSearchLimit bound the number
+	// of the medicines a search returns.
+	DefaultMedicineSearchLimit = 10
+	MaxMedicineSearchLimit     = 50
+)
+
+var ErrMedicineNotFound = errors.New("medicine was not found")
+
+type MedicineOutput struct {
+	ID           string
+	Name         string
+	Ingredient   string
+	Synonyms     []string
+	MaxDailyDose float64
+}
+
+type MedicineUseCase struct {
+	medicineRepo repository.MedicineRepository
+}
+
+func NewMedicineUseCase(medicineRepo repository.MedicineRepository) *MedicineUseCase {
+	return &MedicineUseCase{
+		medicineRepo: medicineRepo,
+	}
+}
+
+// SearchMedicines autocompletes the query with the catalog. A medicine is
+// found by a word of its name or synonyms starting with the query, in any
+// case and in Cyrillic or Latin letters.
+func (uc *MedicineUseCase) SearchMedicines(ctx context.Context, query string, limit int) ([]MedicineOutput, error) {
+	prefix := medicine.Normalize(query)
+	if prefix == "" || limit < 0 {
+		return nil, ErrInvalidInput
+	}
+	if limit == 0 {
+		limit = DefaultMedicineSearchLimit
+	}
+	limit = min(limit, MaxMedicineSearchLimit)
+
+	medicines, err := uc.medicineRepo.Search(ctx, prefix, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search medicines: %w", err)
+	}
+
+	output := make([]MedicineOutput, len(medicines))
+	for i, m := range medicines {
+		output[i] = MedicineOutput{
+			ID:           m.ID,
+			Name:         m.Name,
+			Ingredient:   m.Ingredient,
+			Synonyms:     m.Synonyms,
+			MaxDailyDose: m.MaxDailyDose,
+		}
+		if output[i].Synonyms == nil {
+			output[i].Synonyms = []string{}
+		}
+	}
+	return output, nil
+}
+
+// resolveMedicine returns the catalog entry and the name of the medicine of a
+// schedule. The given entry has to exist and names the medicine when no name
+// is given. Otherwise the entry is found by the name, the medicines missing
+// from the catalog are custom ones with no entry.
+func resolveMedicine(ctx context.Context, medicineRepo repository.MedicineRepository,
+	medicineID, name string) (string, string, error) {
+	if medicineID != "" {
+		m, err := medicineRepo.Get(ctx, medicineID)
+		if err != nil {
+			if errors.Is(err, repository.ErrMedicineNotFound) {
+				return "", "", ErrMedicineNotFound
+			}
+			return "", "", fmt.Errorf("failed to get medicine: %w", err)
+		}
+		if name == "" {
+			name = m.Name
+		}
+		return m.ID, name, nil
+	}
+
+	if medicine.Normalize(name) == "" {
+		return "", "", ErrInvalidInput
+	}
+	m, err := medicineRepo.FindByName(ctx, medicine.Normalize(name))
+	if err != nil {
+		if errors.Is(err, repository.ErrMedicineNotFound) {
+			return "", name, nil
+		}
+		return "", "", fmt.Errorf("failed to find medicine: %w", err)
+	}
+	return m.ID, name, nil
+}
+
+// medicineKeys returns the medicine keys of the schedules, see
+// entities.MedicineKey.
+func medicineKeys(schedules []entities.Schedule) map[string]*entities.Schedule {
+	keys := make(map[string]*entities.Schedule, len(schedules))
+	for i := range schedules {
+		keys[schedules[i].MedicineKey()] = &schedules[i]
+	}
+	return keys
+}
@@ -0,0 +1,10 @@
+package usecase
+
+import (
+	"context"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+type Notifier interface {
+	Notify(ctx context.Context, notification entities.Notification) error
+}
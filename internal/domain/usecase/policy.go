@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/mw"
+)
+
+// AccessPolicy decides whether the actor of the request may access another
+// user's data, based on the actor's role and their caregiver link to the
+// user. Every decision about another user's data is kept in the access log.
+type AccessPolicy struct {
+	roleRepo      repository.RoleRepository
+	caregiverRepo repository.CaregiverRepository
+	accessLogRepo repository.AccessLogRepository
+}
+
+func NewAccessPolicy(roleRepo repository.RoleRepository, caregiverRepo repository.CaregiverRepository,
+	accessLogRepo repository.AccessLogRepository) *AccessPolicy {
+	return &AccessPolicy{
+		roleRepo:      roleRepo,
+		caregiverRepo: caregiverRepo,
+		accessLogRepo: accessLogRepo,
+	}
+}
+
+// Authorize returns ErrPermissionDenied unless the actor is the user, has no
+// identity (authentication is disabled) or is allowed the permission by their
+// role. Action names the operation in the access log.
+func (p *AccessPolicy) Authorize(ctx context.Context, userID int64, action string, permission entities.Permission) error {
+	actorID, ok := mw.GetActorID(ctx)
+	if !ok || actorID == userID {
+		return nil
+	}
+
+	role, err := p.roleRepo.Get(ctx, actorID)
+	if err != nil {
+		return fmt.Errorf("failed to get actor role: %w", err)
+	}
+
+	var link *entities.CaregiverLink
+	if role != entities.RoleAdmin {
+		link, err = p.caregiverRepo.Get(ctx, userID, actorID)
+		if err != nil && !errors.Is(err, repository.ErrLinkNotFound) {
+			return fmt.Errorf("failed to get caregiver link: %w", err)
+		}
+	}
+
+	allowed := role.Allows(link, permission)
+
+	err = p.accessLogRepo.Record(ctx, &entities.AccessRecord{
+		ActorID:   actorID,
+		UserID:    userID,
+		Action:    action,
+		Allowed:   allowed,
+		TraceID:   mw.GetTraceID(ctx),
+		CreatedAt: entities.TimeNow(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record access: %w", err)
+	}
+
+	if !allowed {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// IsAdmin reports whether the actor of the request is an admin. Requests
+// without an actor are treated as made by an admin.
+func (p *AccessPolicy) IsAdmin(ctx context.Context) (bool, error) {
+	actorID, ok := mw.GetActorID(ctx)
+	if !ok {
+		return true, nil
+	}
+
+	role, err := p.roleRepo.Get(ctx, actorID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get actor role: %w", err)
+	}
+	return role == entities.RoleAdmin, nil
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/i18n"
+)
+
+type ProfileInput struct {
+	UserID int64
+	Locale string
+}
+
+type ProfileOutput struct {
+	UserID int64
+	Locale string
+}
+
+type ProfileUseCase struct {
+	profileRepo repository.ProfileRepository
+	policy      *AccessPolicy
+}
+
+func NewProfileUseCase(profileRepo repository.ProfileRepository, policy *AccessPolicy) *ProfileUseCase {
+	return &ProfileUseCase{
+		profileRepo: profileRepo,
+		policy:      policy,
+	}
+}
+
+// SetProfile saves the preferences of the user. Caregivers with the edit
+// permission set them for their dependants.
+func (uc *ProfileUseCase) SetProfile(ctx context.Context, input ProfileInput) (*ProfileOutput, error) {
+	if input.UserID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	locale, ok := i18n.Parse(input.Locale)
+	if !ok {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "profile.update", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	profile := entities.NewProfile(input.UserID, string(locale))
+	if err := uc.profileRepo.Save(ctx, profile); err != nil {
+		return nil, fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	return profileOutput(profile), nil
+}
+
+// GetProfile returns the preferences of the user, users without a profile
+// get the default ones.
+func (uc *ProfileUseCase) GetProfile(ctx context.Context, userID int64) (*ProfileOutput, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "profile.get", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	profile, err := uc.profileRepo.Get(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrProfileNotFound) {
+			return nil, fmt.Errorf("failed to get profile: %w", err)
+		}
+		profile = &entities.Profile{UserID: userID, Locale: string(i18n.Default)}
+	}
+
+	return profileOutput(profile), nil
+}
+
+// Locale returns the locale the user chose, it reports false for users
+// without a profile. The middlewares use it to select the locale of the
+// requests, so the actor is not authorized.
+func (uc *ProfileUseCase) Locale(ctx context.Context, userID int64) (i18n.Locale, bool, error) {
+	return profileLocale(ctx, uc.profileRepo, userID)
+}
+
+func profileLocale(ctx context.Context, profileRepo repository.ProfileRepository, userID int64) (i18n.Locale, bool, error) {
+	profile, err := profileRepo.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProfileNotFound) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to get profile: %w", err)
+	}
+	locale, ok := i18n.Parse(profile.Locale)
+	return locale, ok, nil
+}
+
+func profileOutput(profile *entities.Profile) *ProfileOutput {
+	return &ProfileOutput{
+		UserID: profile.UserID,
+		Locale: profile.Locale,
+	}
+}
@@ -0,0 +1,544 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/i18n"
+	"time"
+)
+
+var (
+	ErrTakingNotPlanned = errors.New("taking is not planned at this time")
+	ErrTakingClosed     = errors.New("taking is already closed")
+	ErrSnoozeLimit      = errors.New("snooze limit reached, taking marked as missed")
+)
+
+type ReminderSettings struct {
+	Tick                time.Duration
+	RefillThresholdDays int
+	SnoozePeriod        time.Duration
+	MaxSnoozes          int
+	MissedGracePeriod   time.Duration
+	CaregiverDelay      time.Duration
+}
+
+type SnoozeInput struct {
+	UserID     int64
+	ScheduleID int64
+	PlannedAt  time.Time
+}
+
+type TakingStateOutput struct {
+	ScheduleID   int64
+	MedicineName string
+	PlannedAt    time.Time
+	Status       string
+	SnoozeCount  int
+	SnoozedUntil *time.Time
+}
+
+type EscalationInput struct {
+	UserID           int64
+	ScheduleID       int64
+	GracePeriod      time.Duration
+	CaregiverDelay   time.Duration
+	NotifyCaregivers bool
+}
+
+type EscalationOutput struct {
+	ScheduleID       int64
+	GracePeriod      time.Duration
+	CaregiverDelay   time.Duration
+	NotifyCaregivers bool
+}
+
+type ReminderUseCase struct {
+	scheduleRepo     repository.ScheduleRepository
+	inventoryRepo    repository.InventoryRepository
+	notificationRepo repository.NotificationRepository
+	takingRepo       repository.TakingRepository
+	intakeRepo       repository.IntakeRepository
+	caregiverRepo    repository.CaregiverRepository
+	escalationRepo   repository.EscalationRepository
+	profileRepo      repository.ProfileRepository
+	notifier         Notifier
+	policy           *AccessPolicy
+	settings         ReminderSettings
+}
+
+func NewReminderUseCase(
+	scheduleRepo repository.ScheduleRepository,
+	inventoryRepo repository.InventoryRepository,
+	notificationRepo repository.NotificationRepository,
+	takingRepo repository.TakingRepository,
+	intakeRepo repository.IntakeRepository,
+	caregiverRepo repository.CaregiverRepository,
+	escalationRepo repository.EscalationRepository,
+	profileRepo repository.ProfileRepository,
+	notifier Notifier,
+	policy *AccessPolicy,
+	settings ReminderSettings,
+) *ReminderUseCase {
+	return &ReminderUseCase{
+		scheduleRepo:     scheduleRepo,
+		inventoryRepo:    inventoryRepo,
+		notificationRepo: notificationRepo,
+		takingRepo:       takingRepo,
+		intakeRepo:       intakeRepo,
+		caregiverRepo:    caregiverRepo,
+		escalationRepo:   escalationRepo,
+		profileRepo:      profileRepo,
+		notifier:         notifier,
+		policy:           policy,
+		settings:         settings,
+	}
+}
+
+func (uc *ReminderUseCase) SetEscalationRule(ctx context.Context, input EscalationInput) (*EscalationOutput, error) {
+	if input.UserID <= 0 || input.ScheduleID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "escalation.set", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.scheduleRepo.GetByID(ctx, input.UserID, input.ScheduleID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	rule, err := entities.NewEscalationRule(input.ScheduleID, input.GracePeriod, input.CaregiverDelay, input.NotifyCaregivers)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+
+	if err := uc.escalationRepo.Save(ctx, rule); err != nil {
+		return nil, fmt.Errorf("failed to save escalation rule: %w", err)
+	}
+
+	return escalationOutput(rule), nil
+}
+
+// GetEscalationRule returns the rule of the schedule, falling back to the
+// configured defaults when the schedule has none.
+func (uc *ReminderUseCase) GetEscalationRule(ctx context.Context, userID, scheduleID int64) (*EscalationOutput, error) {
+	if userID <= 0 || scheduleID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, userID, "escalation.get", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.scheduleRepo.GetByID(ctx, userID, scheduleID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	rule, err := uc.escalationRepo.GetByScheduleID(ctx, scheduleID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrRuleNotFound) {
+			return nil, fmt.Errorf("failed to get escalation rule: %w", err)
+		}
+		rule = uc.defaultRule(scheduleID)
+	}
+
+	return escalationOutput(rule), nil
+}
+
+// SnoozeTaking postpones the reminder of a planned taking. The dispatcher
+// re-sends it once the snooze period is over.
+func (uc *ReminderUseCase) SnoozeTaking(ctx context.Context, input SnoozeInput) (*TakingStateOutput, error) {
+	if input.UserID <= 0 || input.ScheduleID <= 0 || input.PlannedAt.IsZero() {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "taking.snooze", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	now := TimeNow()
+	plannedAt := input.PlannedAt.In(now.Location())
+
+	schedule, err := uc.scheduleRepo.GetByID(ctx, input.UserID, input.ScheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	if !schedule.IsPlannedAt(plannedAt) {
+		return nil, ErrTakingNotPlanned
+	}
+
+	taking, err := uc.takingRepo.Get(ctx, schedule.ID, plannedAt)
+	if err != nil {
+		if !errors.Is(err, repository.ErrTakingNotFound) {
+			return nil, fmt.Errorf("failed to get planned taking: %w", err)
+		}
+		taking = entities.NewPlannedTaking(schedule, plannedAt)
+	}
+
+	snoozeErr := taking.Snooze(now, uc.settings.SnoozePeriod, uc.settings.MaxSnoozes)
+	if errors.Is(snoozeErr, entities.ErrTakingClosed) {
+		return nil, ErrTakingClosed
+	}
+
+	if _, err := uc.takingRepo.Save(ctx, taking); err != nil {
+		return nil, fmt.Errorf("failed to save planned taking: %w", err)
+	}
+
+	if errors.Is(snoozeErr, entities.ErrSnoozeLimit) {
+		return nil, ErrSnoozeLimit
+	}
+
+	return takingStateOutput(taking), nil
+}
+
+// Dispatch emits reminders for the takings planned from the point the last
+// dispatch reached up to the next tick, re-sends snoozed ones and notifies
+// about packs running low. A notification is stored as sent once the notifier
+// accepts it, so nothing is notified twice. A failed notification does not
+// stop the others, the errors are joined and the point stays before the
+// failed takings, so the next dispatch retries them.
+func (uc *ReminderUseCase) Dispatch(ctx context.Context) error {
+	now := TimeNow()
+	until := now.Add(uc.settings.Tick)
+
+	from, err := uc.notificationRepo.DispatchedUntil(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get dispatched time: %w", err)
+	}
+	// The takings are planned in the location of the clock, not of the
+	// stored time. The takings older than the grace period are escalated by
+	// dispatchMissed instead, even after a long stop.
+	from = from.In(now.Location())
+	if oldest := now.Add(-uc.settings.MissedGracePeriod); from.Before(oldest) {
+		from = oldest
+	}
+
+	schedules, err := uc.scheduleRepo.GetActive(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get active schedules: %w", err)
+	}
+
+	var errs []error
+	dispatched := until
+	byID := make(map[int64]*entities.Schedule, len(schedules))
+	for i := range schedules {
+		schedule := &schedules[i]
+		byID[schedule.ID] = schedule
+
+		for _, taking := range schedule.TakingsBetween(from, until) {
+			notification := entities.Notification{
+				Type:         entities.NotificationTaking,
+				UserID:       schedule.UserID,
+				ScheduleID:   schedule.ID,
+				MedicineName: taking.MedicineName,
+				PlannedAt:    taking.TakingTime,
+			}
+			if err := uc.emit(ctx, notification); err != nil {
+				errs = append(errs, err)
+				dispatched = minTime(dispatched, taking.TakingTime)
+			}
+		}
+	}
+
+	if err := uc.notificationRepo.SetDispatchedUntil(ctx, dispatched); err != nil {
+		errs = append(errs, fmt.Errorf("failed to save dispatched time: %w", err))
+	}
+
+	errs = append(errs, uc.dispatchRefills(ctx, now, byID))
+	errs = append(errs, uc.dispatchSnoozed(ctx, now))
+	errs = append(errs, uc.dispatchMissed(ctx, now, schedules))
+	return errors.Join(errs...)
+}
+
+func (uc *ReminderUseCase) dispatchRefills(ctx context.Context, now time.Time, byID map[int64]*entities.Schedule) error {
+	packs, err := uc.inventoryRepo.GetAwaitingRefill(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get packs: %w", err)
+	}
+
+	var errs []error
+	for _, pack := range packs {
+		schedule, ok := byID[pack.ScheduleID]
+		if !ok || !pack.NeedsRefill(schedule, now, uc.settings.RefillThresholdDays) {
+			continue
+		}
+
+		marked, err := uc.inventoryRepo.MarkRefillNotified(ctx, pack.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to mark pack as notified: %w", err))
+			continue
+		}
+		if !marked {
+			continue
+		}
+
+		notification := entities.Notification{
+			Type:         entities.NotificationRefill,
+			UserID:       schedule.UserID,
+			ScheduleID:   schedule.ID,
+			MedicineName: schedule.MedicineName,
+			PlannedAt:    now,
+		}
+		if err := uc.emit(ctx, notification); err != nil {
+			errs = append(errs, err)
+			// The flag only reserves the pack, the next dispatch retries it.
+			if err := uc.inventoryRepo.ClearRefillNotified(ctx, pack.ID); err != nil {
+				errs = append(errs, fmt.Errorf("failed to clear pack notification: %w", err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (uc *ReminderUseCase) dispatchSnoozed(ctx context.Context, now time.Time) error {
+	takings, err := uc.takingRepo.GetDueSnoozes(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to get due snoozes: %w", err)
+	}
+
+	var errs []error
+	for _, taking := range takings {
+		notification := entities.Notification{
+			Type:         entities.NotificationTaking,
+			UserID:       taking.UserID,
+			ScheduleID:   taking.ScheduleID,
+			MedicineName: taking.MedicineName,
+			PlannedAt:    taking.PlannedAt,
+			Attempt:      taking.SnoozeCount,
+		}
+		if err := uc.emit(ctx, notification); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		taking.Status = entities.TakingReminded
+		taking.SnoozedUntil = nil
+		if _, err := uc.takingRepo.Save(ctx, &taking); err != nil {
+			errs = append(errs, fmt.Errorf("failed to save planned taking: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// dispatchMissed walks the escalation chain of every planned taking which
+// has no intake after the grace period. Snoozed takings wait for the snooze.
+func (uc *ReminderUseCase) dispatchMissed(ctx context.Context, now time.Time, schedules []entities.Schedule) error {
+	rules, err := uc.escalationRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get escalation rules: %w", err)
+	}
+
+	rulesByID := make(map[int64]*entities.EscalationRule, len(rules))
+	lookback := uc.defaultRule(0).Lookback()
+	for i := range rules {
+		rulesByID[rules[i].ScheduleID] = &rules[i]
+		lookback = max(lookback, rules[i].Lookback())
+	}
+	lookback += uc.settings.Tick
+
+	intakes, err := uc.intakeRepo.GetPlannedBetween(ctx, now.Add(-lookback), now)
+	if err != nil {
+		return fmt.Errorf("failed to get intakes: %w", err)
+	}
+
+	type takingKey struct {
+		scheduleID int64
+		plannedAt  int64
+	}
+	taken := make(map[takingKey]bool, len(intakes))
+	for _, intake := range intakes {
+		taken[takingKey{intake.ScheduleID, intake.PlannedAt.Unix()}] = true
+	}
+
+	caregivers := make(map[int64][]entities.CaregiverLink)
+
+	var errs []error
+	for i := range schedules {
+		schedule := &schedules[i]
+
+		rule, ok := rulesByID[schedule.ID]
+		if !ok {
+			rule = uc.defaultRule(schedule.ID)
+		}
+
+		for _, planned := range schedule.TakingsBetween(now.Add(-rule.Lookback()-uc.settings.Tick), now) {
+			if taken[takingKey{schedule.ID, planned.TakingTime.Unix()}] {
+				continue
+			}
+
+			steps := rule.DueSteps(planned.TakingTime, now)
+			if len(steps) == 0 {
+				continue
+			}
+
+			taking, err := uc.takingRepo.Get(ctx, schedule.ID, planned.TakingTime)
+			if err != nil && !errors.Is(err, repository.ErrTakingNotFound) {
+				errs = append(errs, fmt.Errorf("failed to get planned taking: %w", err))
+				continue
+			}
+			if taking == nil {
+				taking = entities.NewPlannedTaking(schedule, planned.TakingTime)
+			}
+			if taking.Status == entities.TakingSnoozed || taking.Status == entities.TakingTaken {
+				continue
+			}
+
+			if taking.Status != entities.TakingMissed {
+				taking.Status = entities.TakingMissed
+				if _, err := uc.takingRepo.Save(ctx, taking); err != nil {
+					errs = append(errs, fmt.Errorf("failed to save planned taking: %w", err))
+					continue
+				}
+			}
+
+			for _, step := range steps {
+				if err := uc.escalate(ctx, step, taking, caregivers); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (uc *ReminderUseCase) escalate(
+	ctx context.Context,
+	step entities.EscalationStep,
+	taking *entities.PlannedTaking,
+	caregivers map[int64][]entities.CaregiverLink,
+) error {
+	notification := entities.Notification{
+		UserID:       taking.UserID,
+		ScheduleID:   taking.ScheduleID,
+		MedicineName: taking.MedicineName,
+		PlannedAt:    taking.PlannedAt,
+	}
+
+	switch step {
+	case entities.EscalationRemind:
+		notification.Type = entities.NotificationMissed
+		return uc.emit(ctx, notification)
+	case entities.EscalationCaregivers:
+		links, ok := caregivers[taking.UserID]
+		if !ok {
+			var err error
+			links, err = uc.caregiverRepo.GetCaregivers(ctx, taking.UserID)
+			if err != nil {
+				return fmt.Errorf("failed to get caregivers: %w", err)
+			}
+			caregivers[taking.UserID] = links
+		}
+
+		notification.Type = entities.NotificationEscalation
+		var errs []error
+		for _, link := range links {
+			if !link.Allows(entities.PermissionAlerts) {
+				continue
+			}
+			notification.RecipientID = link.CaregiverID
+			if err := uc.emit(ctx, notification); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+
+	return nil
+}
+
+func (uc *ReminderUseCase) defaultRule(scheduleID int64) *entities.EscalationRule {
+	return &entities.EscalationRule{
+		ScheduleID:       scheduleID,
+		GracePeriod:      uc.settings.MissedGracePeriod,
+		CaregiverDelay:   uc.settings.CaregiverDelay,
+		NotifyCaregivers: true,
+	}
+}
+
+// emit sends the notification unless it was sent before. It is reserved
+// before sending and stored as sent after, a failed one is sent again once its
+// reservation expires.
+func (uc *ReminderUseCase) emit(ctx context.Context, notification entities.Notification) error {
+	if notification.RecipientID == 0 {
+		notification.RecipientID = notification.UserID
+	}
+
+	reserved, err := uc.notificationRepo.Reserve(ctx, &notification)
+	if err != nil {
+		return fmt.Errorf("failed to save notification: %w", err)
+	}
+	if !reserved {
+		return nil
+	}
+
+	locale, ok, err := profileLocale(ctx, uc.profileRepo, notification.RecipientID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		locale = i18n.Default
+	}
+	notification.Text = notificationText(notification, locale)
+
+	if err := uc.notifier.Notify(ctx, notification); err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	if err := uc.notificationRepo.MarkSent(ctx, notification.ID); err != nil {
+		return fmt.Errorf("failed to mark notification as sent: %w", err)
+	}
+	return nil
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func notificationText(notification entities.Notification, locale i18n.Locale) string {
+	plannedAt := i18n.FormatDateTime(locale, notification.PlannedAt)
+	switch notification.Type {
+	case entities.NotificationRefill:
+		return i18n.Tf(locale, "%s is running low, time to refill", notification.MedicineName)
+	case entities.NotificationMissed:
+		return i18n.Tf(locale, "You missed %s planned at %s", notification.MedicineName, plannedAt)
+	case entities.NotificationEscalation:
+		return i18n.Tf(locale, "User %d missed %s planned at %s", notification.UserID, notification.MedicineName, plannedAt)
+	default:
+		return i18n.Tf(locale, "Time to take %s", notification.MedicineName)
+	}
+}
+
+func takingStateOutput(taking *entities.PlannedTaking) *TakingStateOutput {
+	return &TakingStateOutput{
+		ScheduleID:   taking.ScheduleID,
+		MedicineName: taking.MedicineName,
+		PlannedAt:    taking.PlannedAt,
+		Status:       string(taking.Status),
+		SnoozeCount:  taking.SnoozeCount,
+		SnoozedUntil: taking.SnoozedUntil,
+	}
+}
+
+func escalationOutput(rule *entities.EscalationRule) *EscalationOutput {
+	return &EscalationOutput{
+		ScheduleID:       rule.ScheduleID,
+		GracePeriod:      rule.GracePeriod,
+		CaregiverDelay:   rule.CaregiverDelay,
+		NotifyCaregivers: rule.NotifyCaregivers,
+	}
+}
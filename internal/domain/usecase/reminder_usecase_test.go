@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"testing"
+	"time"
+)
+
+type memoryInventory struct {
+	packs []entities.Pack
+}
+
+func (r *memoryInventory) Refill(context.Context, *entities.Pack) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (r *memoryInventory) GetByScheduleID(context.Context, int64, int64) (*entities.Pack, error) {
+	return nil, repository.ErrPackNotFound
+}
+
+func (r *memoryInventory) GetAwaitingRefill(context.Context) ([]entities.Pack, error) {
+	var packs []entities.Pack
+	for _, pack := range r.packs {
+		if !pack.RefillNotified {
+			packs = append(packs, pack)
+		}
+	}
+	return packs, nil
+}
+
+func (r *memoryInventory) MarkRefillNotified(_ context.Context, packID int64) (bool, error) {
+	return r.setNotified(packID, true), nil
+}
+
+func (r *memoryInventory) ClearRefillNotified(_ context.Context, packID int64) error {
+	r.setNotified(packID, false)
+	return nil
+}
+
+func (r *memoryInventory) setNotified(packID int64, notified bool) bool {
+	for i := range r.packs {
+		if r.packs[i].ID == packID && r.packs[i].RefillNotified != notified {
+			r.packs[i].RefillNotified = notified
+			return true
+		}
+	}
+	return false
+}
+
+type memoryNotifications struct {
+	reserved int64
+}
+
+func (r *memoryNotifications) Reserve(_ context.Context, notification *entities.Notification) (bool, error) {
+	r.reserved++
+	notification.ID = r.reserved
+	return true, nil
+}
+
+func (r *memoryNotifications) MarkSent(context.Context, int64) error {
+	return nil
+}
+
+func (r *memoryNotifications) DispatchedUntil(context.Context) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (r *memoryNotifications) SetDispatchedUntil(context.Context, time.Time) error {
+	return nil
+}
+
+type memoryProfiles struct{}
+
+func (memoryProfiles) Get(context.Context, int64) (*entities.Profile, error) {
+	return nil, repository.ErrProfileNotFound
+}
+
+func (memoryProfiles) Save(context.Context, *entities.Profile) error {
+	return nil
+}
+
+// flakyNotifier fails the given number of notifications, then records them.
+type flakyNotifier struct {
+	failures int
+	sent     []entities.Notification
+}
+
+func (n *flakyNotifier) Notify(_ context.Context, notification entities.Notification) error {
+	if n.failures > 0 {
+		n.failures--
+		return errors.New("notifier is unavailable")
+	}
+	n.sent = append(n.sent, notification)
+	return nil
+}
+
+func TestDispatchRefillsRetriesFailedNotification(t *testing.T) {
+	now := time.Date(2025, 6, 2, 14, 0, 0, 0, time.UTC)
+	schedule := &entities.Schedule{
+		ID:           1,
+		UserID:       1,
+		MedicineName: "Test Med",
+		TakingTimes: []entities.TakingTime{
+			{Time: time.Date(0, 0, 0, 15, 0, 0, 0, time.UTC)},
+		},
+		StartDate: now.AddDate(0, 0, -1),
+	}
+	inventory := &memoryInventory{packs: []entities.Pack{{
+		ID:            1,
+		ScheduleID:    schedule.ID,
+		UserID:        schedule.UserID,
+		Quantity:      2,
+		DosePerTaking: 1,
+		RefilledAt:    now,
+	}}}
+	notifier := &flakyNotifier{failures: 1}
+	uc := NewReminderUseCase(nil, inventory, &memoryNotifications{}, nil, nil, nil, nil, memoryProfiles{},
+		notifier, nil, ReminderSettings{RefillThresholdDays: 3})
+	byID := map[int64]*entities.Schedule{schedule.ID: schedule}
+
+	if err := uc.dispatchRefills(context.Background(), now, byID); err == nil {
+		t.Fatal("expected the failed notification to be reported")
+	}
+	if len(notifier.sent) != 0 || inventory.packs[0].RefillNotified {
+		t.Fatalf("expected the pack to await the refill reminder, sent %d", len(notifier.sent))
+	}
+
+	if err := uc.dispatchRefills(context.Background(), now.Add(time.Minute), byID); err != nil {
+		t.Fatalf("failed to dispatch refills: %v", err)
+	}
+	if len(notifier.sent) != 1 || notifier.sent[0].Type != entities.NotificationRefill {
+		t.Fatalf("expected the refill reminder on the next tick, got %+v", notifier.sent)
+	}
+	if !inventory.packs[0].RefillNotified {
+		t.Error("expected the pack to be marked as notified")
+	}
+
+	if err := uc.dispatchRefills(context.Background(), now.Add(2*time.Minute), byID); err != nil {
+		t.Fatalf("failed to dispatch refills: %v", err)
+	}
+	if len(notifier.sent) != 1 {
+		t.Errorf("expected the refill reminder once, got %d", len(notifier.sent))
+	}
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+)
+
+type RoleOutput struct {
+	UserID int64
+	Role   string
+}
+
+type RoleUseCase struct {
+	roleRepo repository.RoleRepository
+	policy   *AccessPolicy
+}
+
+func NewRoleUseCase(roleRepo repository.RoleRepository, policy *AccessPolicy) *RoleUseCase {
+	return &RoleUseCase{
+		roleRepo: roleRepo,
+		policy:   policy,
+	}
+}
+
+// SetRole assigns the role to the user, only admins may do it.
+func (uc *RoleUseCase) SetRole(ctx context.Context, userID int64, role string) (*RoleOutput, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	parsed, err := entities.ParseRole(role)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+
+	admin, err := uc.policy.IsAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !admin {
+		return nil, ErrPermissionDenied
+	}
+
+	if err := uc.roleRepo.Set(ctx, userID, parsed); err != nil {
+		return nil, fmt.Errorf("failed to set role: %w", err)
+	}
+
+	return &RoleOutput{UserID: userID, Role: string(parsed)}, nil
+}
+
+func (uc *RoleUseCase) GetRole(ctx context.Context, userID int64) (*RoleOutput, error) {
+	if userID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if !isSelf(ctx, userID) {
+		admin, err := uc.policy.IsAdmin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !admin {
+			return nil, ErrPermissionDenied
+		}
+	}
+
+	role, err := uc.roleRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	return &RoleOutput{UserID: userID, Role: string(role)}, nil
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+)
+
+// MaxBatchSize is the largest number of schedules created by one batch.
+const MaxBatchSize = 50
+
+type BatchMode string
+
+const (
+	// BatchAllOrNothing creates the schedules only if all of them can be
+	// created.
+	BatchAllOrNothing BatchMode = "all_or_nothing"
+	// BatchBestEffort creates the schedules which can be created.
+	BatchBestEffort BatchMode = "best_effort"
+)
+
+// Error codes of the schedules of a batch.
+const (
+	BatchInvalidInput            = "invalid_input"
+	BatchPermissionDenied        = "permission_denied"
+	BatchScheduleExists          = "schedule_exists"
+	BatchMedicineNotFound        = "medicine_not_found"
+	BatchDailyDoseExceeded       = "daily_dose_exceeded"
+	BatchSeparationUnsatisfiable = "separation_unsatisfiable"
+	// BatchContraindicated is a medicine contraindicated with an active
+	// schedule, unless the schedule overrides the interactions.
+	BatchContraindicated = "interaction_contraindicated"
+	// BatchDuplicate is a schedule of the same user and medicine as an
+	// earlier schedule of the batch.
+	BatchDuplicate = "duplicate"
+	// BatchAborted is a valid schedule of an all-or-nothing batch which was
+	// not created because of the others.
+	BatchAborted  = "aborted"
+	BatchInternal = "internal"
+)
+
+type BatchOutput struct {
+	// Created is the number of created schedules.
+	Created int
+	Items   []BatchItemOutput
+}
+
+// BatchItemOutput has the ID of the created schedule and the interactions of
+// its medicine, or the error code.
+type BatchItemOutput struct {
+	ID       int64
+	Error    string
+	Warnings []InteractionWarning
+}
+
+// CreateSchedules validates all schedules up front and creates them in one
+// transaction. The output has a result per input in the same order.
+func (uc *ScheduleUseCase) CreateSchedules(ctx context.Context, inputs []ScheduleInput, mode BatchMode) (*BatchOutput, error) {
+	if mode == "" {
+		mode = BatchAllOrNothing
+	}
+	if len(inputs) == 0 || len(inputs) > MaxBatchSize || (mode != BatchAllOrNothing && mode != BatchBestEffort) {
+		return nil, ErrInvalidInput
+	}
+
+	output := &BatchOutput{Items: make([]BatchItemOutput, len(inputs))}
+	schedules := make([]*entities.Schedule, 0, len(inputs))
+	warnings := make([][]InteractionWarning, 0, len(inputs))
+	indexes := make([]int, 0, len(inputs))
+	authorized := make(map[int64]error)
+	seen := make(map[string]bool)
+	active := make(map[int64][]entities.Schedule)
+	for i, input := range inputs {
+		code, schedule, scheduleWarnings, err := uc.batchSchedule(ctx, input, authorized, seen, active)
+		if err != nil {
+			return nil, err
+		}
+		if code != "" {
+			output.Items[i].Error = code
+			continue
+		}
+		schedules = append(schedules, schedule)
+		warnings = append(warnings, scheduleWarnings)
+		indexes = append(indexes, i)
+	}
+
+	if mode == BatchAllOrNothing && len(schedules) < len(inputs) {
+		for _, i := range indexes {
+			output.Items[i].Error = BatchAborted
+		}
+		return output, nil
+	}
+	if len(schedules) == 0 {
+		return output, nil
+	}
+
+	entries := make([]*entities.AuditEntry, len(schedules))
+	for j, schedule := range schedules {
+		var err error
+		entries[j], err = newAudit(ctx, entities.AuditScheduleCreated, 0, schedule.UserID, nil, schedule.Snapshot())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := uc.scheduleRepo.CreateBatch(ctx, schedules, entries, mode == BatchAllOrNothing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schedules: %w", err)
+	}
+
+	for j, result := range results {
+		i := indexes[j]
+		switch {
+		case errors.Is(result.Err, repository.ErrBatchAborted):
+			output.Items[i].Error = BatchAborted
+		case errors.Is(result.Err, repository.ErrAlreadyExists):
+			output.Items[i].Error = BatchScheduleExists
+		case result.Err != nil:
+			output.Items[i].Error = BatchInternal
+		default:
+			output.Items[i].ID = result.ID
+			output.Items[i].Warnings = warnings[j]
+			output.Created++
+		}
+	}
+
+	return output, nil
+}
+
+// batchSchedule returns the schedule of the input with the interactions of its
+// medicine, or the error code why it can not be created. Users are authorized once per batch and their active
+// schedules, which the schedules are checked against like by CreateSchedule,
+// are listed once into active. The accepted schedules are added to them, so
+// the later schedules of the batch are checked against the earlier ones too.
+func (uc *ScheduleUseCase) batchSchedule(ctx context.Context, input ScheduleInput, authorized map[int64]error,
+	seen map[string]bool, active map[int64][]entities.Schedule) (string, *entities.Schedule, []InteractionWarning, error) {
+	if (input.MedicineName == "" && input.MedicineID == "") || input.Frequency < 1 || input.Duration < 0 || input.UserID <= 0 || input.Frequency > 15 ||
+		input.Dose < 0 {
+		return BatchInvalidInput, nil, nil, nil
+	}
+
+	err, ok := authorized[input.UserID]
+	if !ok {
+		err = uc.policy.Authorize(ctx, input.UserID, "schedule.create", entities.PermissionEdit)
+		authorized[input.UserID] = err
+	}
+	if err != nil {
+		if errors.Is(err, ErrPermissionDenied) {
+			return BatchPermissionDenied, nil, nil, nil
+		}
+		return "", nil, nil, err
+	}
+
+	medicineID, medicineName, err := resolveMedicine(ctx, uc.medicineRepo, input.MedicineID, input.MedicineName)
+	switch {
+	case errors.Is(err, ErrMedicineNotFound):
+		return BatchMedicineNotFound, nil, nil, nil
+	case errors.Is(err, ErrInvalidInput):
+		return BatchInvalidInput, nil, nil, nil
+	case err != nil:
+		return "", nil, nil, err
+	}
+
+	schedule, err := entities.NewSchedule(medicineName, input.Frequency, input.Duration, input.UserID)
+	if err != nil {
+		return BatchInvalidInput, nil, nil, nil
+	}
+	schedule.MedicineID = medicineID
+	schedule.Dose = input.Dose
+
+	key := fmt.Sprintf("%d/%s", input.UserID, schedule.MedicineKey())
+	if seen[key] {
+		return BatchDuplicate, nil, nil, nil
+	}
+	seen[key] = true
+
+	exists, err := uc.scheduleExists(ctx, schedule)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if exists {
+		return BatchScheduleExists, nil, nil, nil
+	}
+
+	others, ok := active[input.UserID]
+	if !ok {
+		others, err = uc.checks.activeSchedules(ctx, input.UserID, 0)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		active[input.UserID] = others
+	}
+
+	warnings, err := uc.checks.checkSchedule(ctx, schedule, others, true, input.OverrideInteractions)
+	switch {
+	case errors.Is(err, ErrContraindicated):
+		return BatchContraindicated, nil, nil, nil
+	case errors.Is(err, ErrSeparationUnsatisfiable):
+		return BatchSeparationUnsatisfiable, nil, nil, nil
+	case errors.Is(err, ErrDailyDoseExceeded):
+		return BatchDailyDoseExceeded, nil, nil, nil
+	case err != nil:
+		return "", nil, nil, err
+	}
+	active[input.UserID] = append(others, *schedule)
+
+	return "", schedule, warnings, nil
+}
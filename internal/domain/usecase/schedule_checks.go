@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"slices"
+)
+
+// scheduleChecks check the new and the changed schedules of the creations,
+// the updates and the imports against the other schedules of the user.
+type scheduleChecks struct {
+	scheduleRepo  repository.ScheduleRepository
+	medicineRepo  repository.MedicineRepository
+	doseLimitRepo repository.DoseLimitRepository
+	interactions  *InteractionChecker
+}
+
+// activeSchedules returns the active schedules of the user but the one with
+// the id, the schedules a new or changed schedule is checked against. New
+// schedules pass 0.
+func (c *scheduleChecks) activeSchedules(ctx context.Context, userID, exceptID int64) ([]entities.Schedule, error) {
+	schedules, err := allSchedules(ctx, c.scheduleRepo, repository.ScheduleFilter{
+		UserID: userID,
+		Status: repository.ScheduleStatusActive,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(schedules, func(other entities.Schedule) bool {
+		return other.ID == exceptID
+	}), nil
+}
+
+// checkSchedule runs the checks of a new or changed schedule against the
+// other schedules of the user. With checkInteractions the interactions of its
+// medicine are returned and a contraindicated medicine is rejected with
+// ErrContraindicated unless overridden. Then the taking times are shifted
+// apart from the takings of the interacting medicines, or rejected with
+// ErrSeparationUnsatisfiable, and a dose over the daily limit is rejected with
+// ErrDailyDoseExceeded.
+func (c *scheduleChecks) checkSchedule(ctx context.Context, schedule *entities.Schedule, others []entities.Schedule,
+	checkInteractions, overrideInteractions bool) ([]InteractionWarning, error) {
+	var warnings []InteractionWarning
+	if checkInteractions {
+		warnings = c.interactions.Check(schedule.MedicineID, others)
+		if contraindicated(warnings) && !overrideInteractions {
+			return nil, ErrContraindicated
+		}
+	}
+
+	if err := c.separateTakings(schedule, others); err != nil {
+		return nil, err
+	}
+	if err := c.checkDailyDose(ctx, schedule, others); err != nil {
+		return nil, err
+	}
+	return warnings, nil
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+// ListSchedulesInput leaves zero values for the defaults: all schedules
+// sorted by ID in ascending order, 20 per page.
+type ListSchedulesInput struct {
+	UserID         int64
+	Status         string
+	MedicinePrefix string
+	From           *time.Time
+	To             *time.Time
+	Sort           string
+	Descending     bool
+	Limit          int
+	Cursor         string
+}
+
+type ScheduleListOutput struct {
+	Schedules []ScheduleOutput
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
+// listCursor is encoded into the opaque cursor returned to clients. It keeps
+// the sort so that the cursor can not be used with another order.
+type listCursor struct {
+	Sort       repository.ScheduleSort `json:"s"`
+	Descending bool                    `json:"d"`
+	Value      string                  `json:"v"`
+	ID         int64                   `json:"i"`
+}
+
+func (uc *ScheduleUseCase) ListSchedules(ctx context.Context, input ListSchedulesInput) (*ScheduleListOutput, error) {
+	filter, err := scheduleFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "schedule.list", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	// One extra schedule tells whether there is a next page.
+	limit := filter.Limit
+	filter.Limit++
+
+	schedules, err := uc.scheduleRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	output := &ScheduleListOutput{
+		Schedules: make([]ScheduleOutput, 0, min(len(schedules), limit)),
+	}
+	for i := range schedules {
+		if i == limit {
+			last := schedules[i-1]
+			output.NextCursor = encodeCursor(listCursor{
+				Sort:       filter.Sort,
+				Descending: filter.Descending,
+				Value:      cursorValue(&last, filter.Sort),
+				ID:         last.ID,
+			})
+			break
+		}
+		output.Schedules = append(output.Schedules, *scheduleOutput(ctx, &schedules[i]))
+	}
+
+	return output, nil
+}
+
+func scheduleFilter(input ListSchedulesInput) (repository.ScheduleFilter, error) {
+	filter := repository.ScheduleFilter{
+		UserID:         input.UserID,
+		Status:         repository.ScheduleStatus(input.Status),
+		MedicinePrefix: input.MedicinePrefix,
+		From:           input.From,
+		To:             input.To,
+		Sort:           repository.ScheduleSort(input.Sort),
+		Descending:     input.Descending,
+		Limit:          input.Limit,
+	}
+
+	if filter.UserID <= 0 || filter.Limit < 0 || filter.Limit > maxListLimit {
+		return filter, ErrInvalidInput
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return filter, ErrInvalidInput
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultListLimit
+	}
+
+	switch filter.Status {
+	case "":
+		filter.Status = repository.ScheduleStatusAll
+	case repository.ScheduleStatusAll, repository.ScheduleStatusActive, repository.ScheduleStatusPaused,
+		repository.ScheduleStatusFinished:
+	default:
+		return filter, ErrInvalidInput
+	}
+
+	switch filter.Sort {
+	case "":
+		filter.Sort = repository.ScheduleSortID
+	case repository.ScheduleSortID, repository.ScheduleSortMedicineName, repository.ScheduleSortStartDate:
+	default:
+		return filter, ErrInvalidInput
+	}
+
+	if input.Cursor != "" {
+		cursor, err := decodeCursor(input.Cursor)
+		if err != nil || cursor.Sort != filter.Sort || cursor.Descending != filter.Descending {
+			return filter, ErrInvalidInput
+		}
+		filter.After = &repository.ScheduleCursor{Value: cursor.Value, ID: cursor.ID}
+	}
+
+	return filter, nil
+}
+
+func cursorValue(schedule *entities.Schedule, sort repository.ScheduleSort) string {
+	switch sort {
+	case repository.ScheduleSortMedicineName:
+		return schedule.MedicineName
+	case repository.ScheduleSortStartDate:
+		return schedule.StartDate.Format("2006-01-02")
+	default:
+		return strconv.FormatInt(schedule.ID, 10)
+	}
+}
+
+func encodeCursor(cursor listCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (listCursor, error) {
+	var cursor listCursor
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor, err
+	}
+	err = json.Unmarshal(data, &cursor)
+	return cursor, err
+}
@@ -6,73 +6,195 @@ import (
 	"fmt"
 	"pills-taking-reminder/internal/domain/entities"
 	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/i18n"
+	"pills-taking-reminder/pkg/mw"
+	"sort"
 	"time"
 )
 
+const (
+	// MaxNextTakingsWithin and MaxNextTakingsLimit cap the window and the
+	// number of the next takings a request selects.
+	MaxNextTakingsWithin = 7 * 24 * time.Hour
+	MaxNextTakingsLimit  = 100
+)
+
 var (
 	ErrInvalidInput     = errors.New("invalid input parameters")
 	ErrScheduleNotFound = errors.New("schedule was not found")
 	ErrScheduleExists   = errors.New("schedule already exists")
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrVersionRequired is returned by updates which do not tell the version
+	// of the schedule they change.
+	ErrVersionRequired = errors.New("schedule version is required")
+	// ErrScheduleModified is returned by updates of an outdated version.
+	ErrScheduleModified = errors.New("schedule was modified")
 )
 
+// ScheduleInput creates a schedule of a catalog medicine or of a custom one.
+// MedicineID selects the catalog entry, with no MedicineName the schedule is
+// named after it. Otherwise the entry is found by MedicineName. Dose is in
+// milligrams, 0 when unknown.
 type ScheduleInput struct {
 	MedicineName string
+	MedicineID   string
+	Dose         float64
 	Frequency    int
 	Duration     int
 	UserID       int64
+	// OverrideInteractions creates the schedule even if the medicine is
+	// contraindicated with an active schedule.
+	OverrideInteractions bool
+}
+
+type UpdateScheduleInput struct {
+	ScheduleID   int64
+	MedicineName string
+	MedicineID   string
+	Dose         float64
+	Frequency    int
+	Duration     int
+	UserID       int64
+	// Version is the version of the schedule the update is based on.
+	Version int64
+	// OverrideInteractions updates the schedule even if its changed medicine
+	// is contraindicated with an active schedule.
+	OverrideInteractions bool
+}
+
+// PauseScheduleInput pauses the schedule or resumes it.
+type PauseScheduleInput struct {
+	ScheduleID int64
+	UserID     int64
+	Paused     bool
+	// OverrideInteractions resumes the schedule even if its medicine is
+	// contraindicated with an active schedule.
+	OverrideInteractions bool
 }
 
 type ScheduleOutput struct {
 	ID           int64
 	MedicineName string
+	MedicineID   string
+	Dose         float64
 	StartDate    string
 	EndDate      string
 	UserID       int64
 	TakingTimes  []string
+	Version      int64
+	Paused       bool
+	// Start, End and Times are the typed values of the formatted fields. End
+	// is nil for the schedules taken with no end, Times hold only the time of
+	// the day.
+	Start time.Time
+	End   *time.Time
+	Times []time.Time
+	// Warnings are the interactions of the medicine changed by an update
+	// with the active schedules, nil in the other outputs.
+	Warnings []InteractionWarning
+}
+
+// NextTakingsInput selects the next takings of the user. With no Within the
+// configured window is used, with no Limit all the takings in it are returned.
+type NextTakingsInput struct {
+	UserID int64
+	Within time.Duration
+	Limit  int
 }
 
 type TakingOutput struct {
 	MedicineName string
 	TakingTime   string
+	// At is the typed value of TakingTime.
+	At time.Time
 }
 
 type ScheduleUseCase struct {
 	scheduleRepo repository.ScheduleRepository
+	auditRepo    repository.AuditRepository
+	medicineRepo repository.MedicineRepository
+	checks       *scheduleChecks
+	policy       *AccessPolicy
 	interval     time.Duration
 }
 
-func NewScheduleUseCase(scheduleRepo repository.ScheduleRepository, interval time.Duration) *ScheduleUseCase {
+func NewScheduleUseCase(scheduleRepo repository.ScheduleRepository, auditRepo repository.AuditRepository,
+	medicineRepo repository.MedicineRepository, doseLimitRepo repository.DoseLimitRepository,
+	interactions *InteractionChecker, policy *AccessPolicy, interval time.Duration) *ScheduleUseCase {
 	return &ScheduleUseCase{
 		scheduleRepo: scheduleRepo,
-		interval:     interval,
+		auditRepo:    auditRepo,
+		medicineRepo: medicineRepo,
+		checks: &scheduleChecks{
+			scheduleRepo:  scheduleRepo,
+			medicineRepo:  medicineRepo,
+			doseLimitRepo: doseLimitRepo,
+			interactions:  interactions,
+		},
+		policy:   policy,
+		interval: interval,
 	}
 }
 
-func (uc *ScheduleUseCase) CreateSchedule(ctx context.Context, input ScheduleInput) (int64, error) {
-	if input.MedicineName == "" || input.Frequency < 1 || input.Duration < 0 || input.UserID <= 0 || input.Frequency > 15 {
-		return 0, ErrInvalidInput
+// CreateSchedule creates the schedule and returns the warnings of the
+// interactions of its medicine with the active schedules of the user. A
+// contraindicated medicine is rejected with ErrContraindicated unless the
+// input overrides the interactions, a dose over the daily limit with
+// ErrDailyDoseExceeded. The taking times are shifted apart from the takings of
+// the interacting medicines, or rejected with ErrSeparationUnsatisfiable.
+func (uc *ScheduleUseCase) CreateSchedule(ctx context.Context, input ScheduleInput) (int64, []InteractionWarning, error) {
+	if (input.MedicineName == "" && input.MedicineID == "") || input.Frequency < 1 || input.Duration < 0 || input.UserID <= 0 || input.Frequency > 15 ||
+		input.Dose < 0 {
+		return 0, nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "schedule.create", entities.PermissionEdit); err != nil {
+		return 0, nil, err
+	}
+
+	medicineID, medicineName, err := resolveMedicine(ctx, uc.medicineRepo, input.MedicineID, input.MedicineName)
+	if err != nil {
+		return 0, nil, err
 	}
 
-	schedule, err := entities.NewSchedule(input.MedicineName, input.Frequency, input.Duration, input.UserID)
+	schedule, err := entities.NewSchedule(medicineName, input.Frequency, input.Duration, input.UserID)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
+	schedule.MedicineID = medicineID
+	schedule.Dose = input.Dose
 
-	id, err := uc.scheduleRepo.Create(ctx, schedule)
+	others, err := uc.checks.activeSchedules(ctx, input.UserID, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	warnings, err := uc.checks.checkSchedule(ctx, schedule, others, true, input.OverrideInteractions)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	entry, err := newAudit(ctx, entities.AuditScheduleCreated, 0, schedule.UserID, nil, schedule.Snapshot())
+	if err != nil {
+		return 0, nil, err
+	}
+
+	id, err := uc.scheduleRepo.Create(ctx, schedule, entry)
 	if err != nil {
 		if errors.Is(err, repository.ErrAlreadyExists) {
-			return 0, ErrScheduleExists
+			return 0, nil, ErrScheduleExists
 		}
-		return 0, fmt.Errorf("failed to create a schedule: %w", err)
+		return 0, nil, fmt.Errorf("failed to create a schedule: %w", err)
 	}
 
-	return id, nil
+	return id, warnings, nil
 }
 
 func (uc *ScheduleUseCase) GetSchedule(ctx context.Context, userID, scheduleID int64) (*ScheduleOutput, error) {
 	if userID <= 0 || scheduleID <= 0 {
 		return nil, ErrInvalidInput
 	}
+	if err := uc.policy.Authorize(ctx, userID, "schedule.get", entities.PermissionView); err != nil {
+		return nil, err
+	}
 
 	schedule, err := uc.scheduleRepo.GetByID(ctx, userID, scheduleID)
 	if err != nil {
@@ -82,31 +204,183 @@ func (uc *ScheduleUseCase) GetSchedule(ctx context.Context, userID, scheduleID i
 		return nil, fmt.Errorf("failed to get schedule: %w", err)
 	}
 
+	return scheduleOutput(ctx, schedule), nil
+}
+
+// UpdateSchedule updates the schedule of the given version. A changed medicine
+// is checked for interactions like in CreateSchedule, the output warns about
+// them.
+func (uc *ScheduleUseCase) UpdateSchedule(ctx context.Context, input UpdateScheduleInput) (*ScheduleOutput, error) {
+	if (input.MedicineName == "" && input.MedicineID == "") || input.Frequency < 1 || input.Duration < 0 || input.UserID <= 0 || input.ScheduleID <= 0 || input.Frequency > 15 ||
+		input.Dose < 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "schedule.update", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+	if input.Version <= 0 {
+		return nil, ErrVersionRequired
+	}
+
+	schedule, err := uc.scheduleRepo.GetByID(ctx, input.UserID, input.ScheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	if schedule.Version != input.Version {
+		return nil, ErrScheduleModified
+	}
+
+	medicineID, medicineName, err := resolveMedicine(ctx, uc.medicineRepo, input.MedicineID, input.MedicineName)
+	if err != nil {
+		return nil, err
+	}
+
+	before := schedule.Snapshot()
+	medicineKey := schedule.MedicineKey()
+	if err := schedule.Update(medicineName, input.Frequency, input.Duration); err != nil {
+		return nil, ErrInvalidInput
+	}
+	schedule.MedicineID = medicineID
+	schedule.Dose = input.Dose
+
+	others, err := uc.checks.activeSchedules(ctx, input.UserID, schedule.ID)
+	if err != nil {
+		return nil, err
+	}
+	medicineChanged := schedule.MedicineKey() != medicineKey
+	warnings, err := uc.checks.checkSchedule(ctx, schedule, others, medicineChanged, input.OverrideInteractions)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := newAudit(ctx, entities.AuditScheduleUpdated, schedule.ID, schedule.UserID, before, schedule.Snapshot())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.scheduleRepo.Update(ctx, schedule, entry); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, ErrScheduleNotFound
+		case errors.Is(err, repository.ErrAlreadyExists):
+			return nil, ErrScheduleExists
+		case errors.Is(err, repository.ErrVersionMismatch):
+			return nil, ErrScheduleModified
+		default:
+			return nil, fmt.Errorf("failed to update schedule: %w", err)
+		}
+	}
+
+	output := scheduleOutput(ctx, schedule)
+	output.Warnings = warnings
+	return output, nil
+}
+
+// SetSchedulePaused pauses the schedule, which stops its takings and
+// reminders, or resumes it. The schedules paused meanwhile are not checked
+// against it, so a resumed schedule is checked against the active schedules
+// like a created one and the output warns about the interactions. Setting the
+// current state changes nothing.
+func (uc *ScheduleUseCase) SetSchedulePaused(ctx context.Context, input PauseScheduleInput) (*ScheduleOutput, error) {
+	if input.UserID <= 0 || input.ScheduleID <= 0 {
+		return nil, ErrInvalidInput
+	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "schedule.pause", entities.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	schedule, err := uc.scheduleRepo.GetByID(ctx, input.UserID, input.ScheduleID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+	if schedule.Paused == input.Paused {
+		return scheduleOutput(ctx, schedule), nil
+	}
+
+	before := schedule.Snapshot()
+	schedule.Paused = input.Paused
+	action := entities.AuditSchedulePaused
+
+	var warnings []InteractionWarning
+	if !input.Paused {
+		action = entities.AuditScheduleResumed
+		others, err := uc.checks.activeSchedules(ctx, input.UserID, schedule.ID)
+		if err != nil {
+			return nil, err
+		}
+		warnings, err = uc.checks.checkSchedule(ctx, schedule, others, true, input.OverrideInteractions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	entry, err := newAudit(ctx, action, schedule.ID, schedule.UserID, before, schedule.Snapshot())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.scheduleRepo.Update(ctx, schedule, entry); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, ErrScheduleNotFound
+		case errors.Is(err, repository.ErrVersionMismatch):
+			return nil, ErrScheduleModified
+		default:
+			return nil, fmt.Errorf("failed to update schedule: %w", err)
+		}
+	}
+
+	output := scheduleOutput(ctx, schedule)
+	output.Warnings = warnings
+	return output, nil
+}
+
+// scheduleOutput formats the dates in the locale of the request.
+func scheduleOutput(ctx context.Context, schedule *entities.Schedule) *ScheduleOutput {
+	locale := i18n.FromContext(ctx)
 	output := &ScheduleOutput{
 		ID:           schedule.ID,
 		MedicineName: schedule.MedicineName,
-		StartDate:    schedule.StartDate.Format("02 Jan 2006"),
+		MedicineID:   schedule.MedicineID,
+		Dose:         schedule.Dose,
+		StartDate:    i18n.FormatDate(locale, schedule.StartDate),
 		UserID:       schedule.UserID,
 		TakingTimes:  make([]string, len(schedule.TakingTimes)),
+		Version:      schedule.Version,
+		Paused:       schedule.Paused,
+		Start:        schedule.StartDate,
+		End:          schedule.EndDate,
+		Times:        make([]time.Time, len(schedule.TakingTimes)),
 	}
 
 	if schedule.EndDate != nil {
-		output.EndDate = schedule.EndDate.Format("02 Jan 2006")
+		output.EndDate = i18n.FormatDate(locale, *schedule.EndDate)
 	} else {
-		output.EndDate = "infinite"
+		output.EndDate = i18n.T(locale, "infinite")
 	}
 
 	for i, tt := range schedule.TakingTimes {
 		output.TakingTimes[i] = fmt.Sprintf("%02d:%02d", tt.Time.Hour(), tt.Time.Minute())
+		output.Times[i] = tt.Time
 	}
 
-	return output, nil
+	return output
 }
 
 func (uc *ScheduleUseCase) GetScheduleIDs(ctx context.Context, userID int64) ([]int64, error) {
 	if userID <= 0 {
 		return nil, ErrInvalidInput
 	}
+	if err := uc.policy.Authorize(ctx, userID, "schedule.list", entities.PermissionView); err != nil {
+		return nil, err
+	}
 
 	ids, err := uc.scheduleRepo.GetSchedulesIDs(ctx, userID)
 	if err != nil {
@@ -116,23 +390,67 @@ func (uc *ScheduleUseCase) GetScheduleIDs(ctx context.Context, userID int64) ([]
 	return ids, nil
 }
 
-func (uc *ScheduleUseCase) GetNextTakings(ctx context.Context, userID int64) ([]TakingOutput, error) {
-	if userID <= 0 {
+// GetNextTakings returns the takings of the user from now on sorted by time
+// across days.
+func (uc *ScheduleUseCase) GetNextTakings(ctx context.Context, input NextTakingsInput) ([]TakingOutput, error) {
+	if input.UserID <= 0 || input.Within < 0 || input.Limit < 0 {
 		return nil, ErrInvalidInput
 	}
+	if err := uc.policy.Authorize(ctx, input.UserID, "schedule.next_takings", entities.PermissionView); err != nil {
+		return nil, err
+	}
+
+	// A limit alone asks for the next takings however far they are.
+	within := input.Within
+	switch {
+	case within == 0 && input.Limit > 0:
+		within = MaxNextTakingsWithin
+	case within == 0:
+		within = uc.interval
+	}
+	within = min(within, MaxNextTakingsWithin)
+	limit := min(input.Limit, MaxNextTakingsLimit)
 
-	takings, err := uc.scheduleRepo.GetNextTakings(ctx, userID, uc.interval.String())
+	now := TimeNow()
+	end := now.Add(within)
+	schedules, err := allSchedules(ctx, uc.scheduleRepo, repository.ScheduleFilter{
+		UserID: input.UserID,
+		Status: repository.ScheduleStatusAll,
+		From:   &now,
+		To:     &end,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get next takings: %w", err)
 	}
 
+	var takings []entities.Taking
+	for i := range schedules {
+		takings = append(takings, schedules[i].TakingsBetween(now, end)...)
+	}
+	sort.SliceStable(takings, func(i, j int) bool {
+		if !takings[i].TakingTime.Equal(takings[j].TakingTime) {
+			return takings[i].TakingTime.Before(takings[j].TakingTime)
+		}
+		return takings[i].MedicineName < takings[j].MedicineName
+	})
+	if limit > 0 && len(takings) > limit {
+		takings = takings[:limit]
+	}
+
 	output := make([]TakingOutput, len(takings))
 	for i, taking := range takings {
 		output[i] = TakingOutput{
 			MedicineName: taking.MedicineName,
 			TakingTime:   taking.FormatTime(),
+			At:           taking.TakingTime,
 		}
 	}
 
 	return output, nil
 }
+
+// isSelf reports whether the request is made by the user themselves.
+func isSelf(ctx context.Context, userID int64) bool {
+	actorID, ok := mw.GetActorID(ctx)
+	return !ok || actorID == userID
+}
@@ -0,0 +1,21 @@
+package usecase
+
+// UseCases groups the use cases exposed through the HTTP and gRPC APIs.
+type UseCases struct {
+	Schedule  *ScheduleUseCase
+	Inventory *InventoryUseCase
+	Reminder  *ReminderUseCase
+	Intake    *IntakeUseCase
+	Caregiver *CaregiverUseCase
+	APIKey    *APIKeyUseCase
+	Role      *RoleUseCase
+	Calendar  *CalendarUseCase
+	Export    *ExportUseCase
+	Medicine  *MedicineUseCase
+	DoseLimit *DoseLimitUseCase
+	// Profile is optional, without it the locale is selected only by the
+	// Accept-Language header.
+	Profile *ProfileUseCase
+	// Idempotency is optional, without it idempotency keys are ignored.
+	Idempotency *IdempotencyUseCase
+}
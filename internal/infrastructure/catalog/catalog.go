@@ -0,0 +1,74 @@
+// Package catalog holds the bundled medicine catalog, the dataset the
+// medicines table is seeded from at start, and the interactions of its
+// medicines.
+package catalog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"pills-taking-reminder/internal/domain/entities"
+	"time"
+)
+
+//go:embed medicines.json
+var medicinesJSON []byte
+
+//go:embed interactions.json
+var interactionsJSON []byte
+
+type medicineRecord struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Ingredient string   `json:"ingredient"`
+	Synonyms   []string `json:"synonyms"`
+	// MaxDailyDose is in milligrams of the ingredient, 0 for no limit.
+	MaxDailyDose float64 `json:"max_daily_dose"`
+}
+
+// Medicines returns the medicines of the bundled dataset.
+func Medicines() ([]entities.Medicine, error) {
+	var records []medicineRecord
+	if err := json.Unmarshal(medicinesJSON, &records); err != nil {
+		return nil, fmt.Errorf("failed to read medicine catalog: %w", err)
+	}
+
+	medicines := make([]entities.Medicine, len(records))
+	for i, record := range records {
+		medicines[i] = entities.Medicine{
+			ID:           record.ID,
+			Name:         record.Name,
+			Ingredient:   record.Ingredient,
+			Synonyms:     record.Synonyms,
+			MaxDailyDose: record.MaxDailyDose,
+		}
+	}
+	return medicines, nil
+}
+
+type interactionRecord struct {
+	Medicines          [2]string `json:"medicines"`
+	Severity           string    `json:"severity"`
+	Description        string    `json:"description"`
+	MinSeparationHours float64   `json:"min_separation_hours"`
+}
+
+// Interactions returns the interactions of the bundled dataset, keyed by the
+// ids of the catalog medicines.
+func Interactions() ([]entities.Interaction, error) {
+	var records []interactionRecord
+	if err := json.Unmarshal(interactionsJSON, &records); err != nil {
+		return nil, fmt.Errorf("failed to read medicine interactions: %w", err)
+	}
+
+	interactions := make([]entities.Interaction, len(records))
+	for i, record := range records {
+		interactions[i] = entities.Interaction{
+			MedicineIDs:   record.Medicines,
+			Severity:      entities.InteractionSeverity(record.Severity),
+			Description:   record.Description,
+			MinSeparation: time.Duration(record.MinSeparationHours * float64(time.Hour)),
+		}
+	}
+	return interactions, nil
+}
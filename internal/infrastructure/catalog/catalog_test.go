@@ -0,0 +1,80 @@
+package catalog
+
+import (
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/pkg/medicine"
+	"testing"
+	"time"
+)
+
+func TestMedicinesNamesAreUnique(t *testing.T) {
+	medicines, err := Medicines()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(medicines) == 0 {
+		t.Fatal("expected a non-empty catalog")
+	}
+
+	ids := make(map[string]bool)
+	names := make(map[string]string)
+	for _, m := range medicines {
+		if m.ID == "" || m.Name == "" || m.Ingredient == "" || m.MaxDailyDose < 0 {
+			t.Errorf("incomplete medicine %+v", m)
+		}
+		if ids[m.ID] {
+			t.Errorf("duplicate medicine id %q", m.ID)
+		}
+		ids[m.ID] = true
+
+		for _, name := range append([]string{m.Name}, m.Synonyms...) {
+			normalized := medicine.Normalize(name)
+			if id, ok := names[normalized]; ok && id != m.ID {
+				t.Errorf("name %q of %q is already used by %q", name, m.ID, id)
+			}
+			names[normalized] = m.ID
+		}
+	}
+}
+
+func TestInteractionsReferCatalog(t *testing.T) {
+	medicines, err := Medicines()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ids := make(map[string]bool)
+	for _, m := range medicines {
+		ids[m.ID] = true
+	}
+
+	interactions, err := Interactions()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(interactions) == 0 {
+		t.Fatal("expected non-empty interactions")
+	}
+
+	pairs := make(map[[2]string]bool)
+	for _, interaction := range interactions {
+		a, b := interaction.MedicineIDs[0], interaction.MedicineIDs[1]
+		if !ids[a] || !ids[b] || a == b {
+			t.Errorf("interaction of unknown medicines %q and %q", a, b)
+		}
+		switch interaction.Severity {
+		case entities.SeverityMinor, entities.SeverityModerate, entities.SeverityMajor, entities.SeverityContraindicated:
+		default:
+			t.Errorf("interaction of %q and %q has unknown severity %q", a, b, interaction.Severity)
+		}
+		if interaction.Description == "" {
+			t.Errorf("interaction of %q and %q has no description", a, b)
+		}
+		if interaction.MinSeparation < 0 || interaction.MinSeparation >= 12*time.Hour {
+			t.Errorf("interaction of %q and %q has invalid separation %s", a, b, interaction.MinSeparation)
+		}
+		if pairs[[2]string{a, b}] || pairs[[2]string{b, a}] {
+			t.Errorf("duplicate interaction of %q and %q", a, b)
+		}
+		pairs[[2]string{a, b}] = true
+	}
+}
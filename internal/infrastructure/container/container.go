@@ -1,14 +1,22 @@
 package container
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	"pills-taking-reminder/internal/api/grpc"
 	httpHandler "pills-taking-reminder/internal/api/http"
 	"pills-taking-reminder/internal/config"
+	"pills-taking-reminder/internal/domain/entities"
 	"pills-taking-reminder/internal/domain/repository"
 	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/internal/infrastructure/catalog"
+	"pills-taking-reminder/internal/infrastructure/notifier"
 	"pills-taking-reminder/internal/infrastructure/postgres"
+	"pills-taking-reminder/internal/worker"
+	"pills-taking-reminder/pkg/jwt"
 	"pills-taking-reminder/pkg/logger"
+	"pills-taking-reminder/pkg/mw"
 )
 
 type Container struct {
@@ -17,6 +25,10 @@ type Container struct {
 	ScheduleUseCase *usecase.ScheduleUseCase
 	HTTPHandler     *httpHandler.ScheduleHandler
 	GRPCServer      *grpc.GRPCServer
+	Worker          *worker.Worker
+	Cleaner         *worker.Cleaner
+	// Authenticator is nil when authentication is disabled.
+	Authenticator mw.Authenticator
 }
 
 func New(cfg *config.Config) (*Container, error) {
@@ -45,11 +57,138 @@ func New(cfg *config.Config) (*Container, error) {
 	var scheduleRepo repository.ScheduleRepository
 	scheduleRepo = postgres.NewScheduleRepository(db, log, cfg.NearTakingInterval)
 
-	scheduleUseCase := usecase.NewScheduleUseCase(scheduleRepo, cfg.NearTakingInterval)
+	var inventoryRepo repository.InventoryRepository
+	inventoryRepo = postgres.NewInventoryRepository(db, log)
 
-	httpServer := httpHandler.NewScheduleHandler(scheduleUseCase, log)
+	var notificationRepo repository.NotificationRepository
+	notificationRepo = postgres.NewNotificationRepository(db, log)
 
-	grpcServer := grpc.NewGRPCServer(scheduleUseCase, log)
+	var takingRepo repository.TakingRepository
+	takingRepo = postgres.NewTakingRepository(db, log)
+
+	var intakeRepo repository.IntakeRepository
+	intakeRepo = postgres.NewIntakeRepository(db, log)
+
+	var caregiverRepo repository.CaregiverRepository
+	caregiverRepo = postgres.NewCaregiverRepository(db, log)
+
+	var escalationRepo repository.EscalationRepository
+	escalationRepo = postgres.NewEscalationRepository(db, log)
+
+	var apiKeyRepo repository.APIKeyRepository
+	apiKeyRepo = postgres.NewAPIKeyRepository(db, log)
+
+	var auditRepo repository.AuditRepository
+	auditRepo = postgres.NewAuditRepository(db, log)
+
+	var feedRepo repository.CalendarFeedRepository
+	feedRepo = postgres.NewCalendarFeedRepository(db, log)
+
+	var roleRepo repository.RoleRepository
+	roleRepo = postgres.NewRoleRepository(db, log)
+
+	var accessLogRepo repository.AccessLogRepository
+	accessLogRepo = postgres.NewAccessLogRepository(db, log)
+
+	var profileRepo repository.ProfileRepository
+	profileRepo = postgres.NewProfileRepository(db, log)
+
+	var idempotencyRepo repository.IdempotencyRepository
+	idempotencyRepo = postgres.NewIdempotencyRepository(db, log)
+
+	var medicineRepo repository.MedicineRepository
+	medicineRepo = postgres.NewMedicineRepository(db, log)
+
+	var doseLimitRepo repository.DoseLimitRepository
+	doseLimitRepo = postgres.NewDoseLimitRepository(db, log)
+
+	medicines, err := catalog.Medicines()
+	if err != nil {
+		return nil, err
+	}
+	if err := medicineRepo.Seed(context.Background(), medicines); err != nil {
+		return nil, err
+	}
+	interactions, err := catalog.Interactions()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, adminID := range cfg.Auth.AdminIDs {
+		if err := roleRepo.Set(context.Background(), adminID, entities.RoleAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	policy := usecase.NewAccessPolicy(roleRepo, caregiverRepo, accessLogRepo)
+
+	interactionChecker := usecase.NewInteractionChecker(interactions)
+	scheduleUseCase := usecase.NewScheduleUseCase(scheduleRepo, auditRepo, medicineRepo, doseLimitRepo,
+		interactionChecker, policy, cfg.NearTakingInterval)
+
+	inventoryUseCase := usecase.NewInventoryUseCase(scheduleRepo, inventoryRepo, policy)
+
+	reminderUseCase := usecase.NewReminderUseCase(scheduleRepo, inventoryRepo, notificationRepo, takingRepo,
+		intakeRepo, caregiverRepo, escalationRepo, profileRepo, notifier.NewLogNotifier(log), policy, usecase.ReminderSettings{
+			Tick:                cfg.Reminder.TickInterval,
+			RefillThresholdDays: cfg.Reminder.RefillThresholdDays,
+			SnoozePeriod:        cfg.Reminder.SnoozePeriod,
+			MaxSnoozes:          cfg.Reminder.MaxSnoozes,
+			MissedGracePeriod:   cfg.Reminder.MissedGracePeriod,
+			CaregiverDelay:      cfg.Reminder.CaregiverDelay,
+		})
+
+	apiKeyUseCase := usecase.NewAPIKeyUseCase(apiKeyRepo, policy)
+
+	exportUseCase := usecase.NewExportUseCase(scheduleRepo, intakeRepo, medicineRepo, doseLimitRepo,
+		interactionChecker, policy)
+
+	useCases := usecase.UseCases{
+		Schedule:  scheduleUseCase,
+		Inventory: inventoryUseCase,
+		Reminder:  reminderUseCase,
+		Intake:    usecase.NewIntakeUseCase(scheduleRepo, intakeRepo, takingRepo, policy),
+		Caregiver: usecase.NewCaregiverUseCase(caregiverRepo, policy),
+		APIKey:    apiKeyUseCase,
+		Role:      usecase.NewRoleUseCase(roleRepo, policy),
+		Calendar:  usecase.NewCalendarUseCase(scheduleRepo, intakeRepo, takingRepo, feedRepo, profileRepo, policy),
+		Export:    exportUseCase,
+		Profile:   usecase.NewProfileUseCase(profileRepo, policy),
+		Medicine:  usecase.NewMedicineUseCase(medicineRepo),
+		DoseLimit: usecase.NewDoseLimitUseCase(doseLimitRepo, medicineRepo, policy),
+
+		Idempotency: usecase.NewIdempotencyUseCase(idempotencyRepo, cfg.Idempotency.TTL, cfg.Idempotency.Lock),
+	}
+
+	httpServer := httpHandler.NewScheduleHandler(useCases, log)
+
+	grpcServer := grpc.NewGRPCServer(useCases, log)
+
+	var authenticator mw.Authenticator
+	if cfg.Auth.Enabled {
+		schemes := mw.Schemes{"ApiKey": apiKeyUseCase}
+
+		// Without a secret or JWKS only API keys are accepted.
+		verifier, err := jwt.NewVerifier(jwt.Config{
+			HMACSecret: cfg.Auth.HMACSecret,
+			JWKSFile:   cfg.Auth.JWKSFile,
+			Issuer:     cfg.Auth.Issuer,
+			Audience:   cfg.Auth.Audience,
+			Leeway:     cfg.Auth.Leeway,
+		})
+		switch {
+		case err == nil:
+			schemes["Bearer"] = mw.NewBearerAuthenticator(verifier)
+		case !errors.Is(err, jwt.ErrNoKeys):
+			return nil, err
+		}
+
+		authenticator = schemes
+		grpcServer.SetAuthenticator(authenticator)
+	}
+
+	reminderWorker := worker.NewWorker(reminderUseCase, log, cfg.Reminder.TickInterval)
+	cleaner := worker.NewCleaner(useCases.Idempotency, log, cfg.Idempotency.CleanupInterval)
 
 	return &Container{
 		Config:          cfg,
@@ -57,6 +196,9 @@ func New(cfg *config.Config) (*Container, error) {
 		ScheduleUseCase: scheduleUseCase,
 		HTTPHandler:     httpServer,
 		GRPCServer:      grpcServer,
+		Worker:          reminderWorker,
+		Cleaner:         cleaner,
+		Authenticator:   authenticator,
 	}, nil
 
 }
@@ -0,0 +1,31 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+// LogNotifier delivers notifications into the application log. It is used
+// until a push provider is wired in.
+type LogNotifier struct {
+	logger *slog.Logger
+}
+
+func NewLogNotifier(logger *slog.Logger) *LogNotifier {
+	return &LogNotifier{
+		logger: logger,
+	}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, notification entities.Notification) error {
+	n.logger.Info("notification sent",
+		slog.String("type", string(notification.Type)),
+		slog.Int64("user_id", notification.UserID),
+		slog.Int64("recipient_id", notification.RecipientID),
+		slog.Int64("schedule_id", notification.ScheduleID),
+		slog.String("medicine", notification.MedicineName),
+		slog.Time("planned_at", notification.PlannedAt),
+		slog.String("text", notification.Text))
+	return nil
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type APIKeyRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewAPIKeyRepository(db *sql.DB, logger *slog.Logger) *APIKeyRepository {
+	return &APIKeyRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, key *entities.APIKey) (int64, error) {
+	const operation = "postgres.APIKeyRepository.Create"
+
+	r.logger.Info("creating api key in db",
+		slog.String("operation", operation),
+		slog.Int64("user_id", key.UserID),
+		slog.String("prefix", key.Prefix))
+
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	var id int64
+	err := r.db.QueryRowContext(ctx, addAPIKeyQuery, key.UserID, key.Name, key.Prefix, key.Hash,
+		pq.Array(scopes), key.CreatedAt).Scan(&id)
+	if err != nil {
+		r.logger.Error("failed to insert api key",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return id, nil
+}
+
+func (r *APIKeyRepository) GetByHash(ctx context.Context, hash string) (*entities.APIKey, error) {
+	const operation = "postgres.APIKeyRepository.GetByHash"
+
+	key, err := scanAPIKey(r.db.QueryRowContext(ctx, getAPIKeyByHashQuery, hash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrAPIKeyNotFound
+		}
+		r.logger.Error("failed to get api key",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return key, nil
+}
+
+func (r *APIKeyRepository) GetByUserID(ctx context.Context, userID int64) ([]entities.APIKey, error) {
+	const operation = "postgres.APIKeyRepository.GetByUserID"
+
+	rows, err := r.db.QueryContext(ctx, getAPIKeysQuery, userID)
+	if err != nil {
+		r.logger.Error("failed to query api keys",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var keys []entities.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		keys = append(keys, *key)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return keys, nil
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, userID, id int64, at time.Time) error {
+	const operation = "postgres.APIKeyRepository.Revoke"
+
+	r.logger.Info("revoking api key in db",
+		slog.String("operation", operation),
+		slog.Int64("user_id", userID),
+		slog.Int64("key_id", id))
+
+	res, err := r.db.ExecContext(ctx, revokeAPIKeyQuery, id, userID, at)
+	if err != nil {
+		r.logger.Error("failed to revoke api key",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	if affected == 0 {
+		return repository.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+func scanAPIKey(row rowScanner) (*entities.APIKey, error) {
+	var (
+		key       entities.APIKey
+		scopes    []string
+		revokedAt sql.NullTime
+	)
+	err := row.Scan(&key.ID, &key.UserID, &key.Name, &key.Prefix, &key.Hash,
+		pq.Array(&scopes), &key.CreatedAt, &revokedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Scopes = make([]entities.Scope, len(scopes))
+	for i, scope := range scopes {
+		key.Scopes[i] = entities.Scope(scope)
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+
+	return &key, nil
+}
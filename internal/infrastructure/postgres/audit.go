@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+type AuditRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewAuditRepository(db *sql.DB, logger *slog.Logger) *AuditRepository {
+	return &AuditRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *AuditRepository) Append(ctx context.Context, entry *entities.AuditEntry) error {
+	return appendAuditEntry(ctx, r.db, r.logger, "postgres.AuditRepository.Append", entry)
+}
+
+// queryRower is a connection or a transaction.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// appendAuditEntry inserts the entry with the connection or in the
+// transaction of the change it records.
+func appendAuditEntry(ctx context.Context, q queryRower, logger *slog.Logger, operation string,
+	entry *entities.AuditEntry) error {
+	var actorID sql.NullInt64
+	if entry.ActorID != nil {
+		actorID = sql.NullInt64{Int64: *entry.ActorID, Valid: true}
+	}
+
+	err := q.QueryRowContext(ctx, addAuditEntryQuery, entry.ScheduleID, entry.UserID, actorID,
+		string(entry.Action), entry.TraceID, nullJSON(entry.Before), nullJSON(entry.After), entry.CreatedAt).Scan(&entry.ID)
+	if err != nil {
+		logger.Error("failed to insert audit entry",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
+
+func (r *AuditRepository) GetBySchedule(ctx context.Context, scheduleID int64) ([]entities.AuditEntry, error) {
+	const operation = "postgres.AuditRepository.GetBySchedule"
+
+	rows, err := r.db.QueryContext(ctx, getScheduleAuditQuery, scheduleID)
+	if err != nil {
+		r.logger.Error("failed to query audit entries",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var entries []entities.AuditEntry
+	for rows.Next() {
+		var (
+			entry         entities.AuditEntry
+			actorID       sql.NullInt64
+			action        string
+			before, after []byte
+		)
+		err := rows.Scan(&entry.ID, &entry.ScheduleID, &entry.UserID, &actorID, &action, &entry.TraceID,
+			&before, &after, &entry.CreatedAt)
+		if err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+
+		entry.Action = entities.AuditAction(action)
+		entry.Before = before
+		entry.After = after
+		if actorID.Valid {
+			entry.ActorID = &actorID.Int64
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return entries, nil
+}
+
+// nullJSON stores missing snapshots as NULL instead of an empty document.
+func nullJSON(data []byte) any {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+)
+
+type CalendarFeedRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewCalendarFeedRepository(db *sql.DB, logger *slog.Logger) *CalendarFeedRepository {
+	return &CalendarFeedRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *CalendarFeedRepository) Save(ctx context.Context, feed *entities.CalendarFeed) error {
+	const operation = "postgres.CalendarFeedRepository.Save"
+
+	r.logger.Info("saving calendar feed in db",
+		slog.String("operation", operation),
+		slog.Int64("user_id", feed.UserID))
+
+	_, err := r.db.ExecContext(ctx, saveCalendarFeedQuery, feed.UserID, feed.TokenHash, feed.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to upsert calendar feed",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
+
+func (r *CalendarFeedRepository) GetByHash(ctx context.Context, hash string) (*entities.CalendarFeed, error) {
+	const operation = "postgres.CalendarFeedRepository.GetByHash"
+
+	var feed entities.CalendarFeed
+	err := r.db.QueryRowContext(ctx, getCalendarFeedByHashQuery, hash).Scan(&feed.UserID, &feed.TokenHash, &feed.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrFeedNotFound
+		}
+		r.logger.Error("failed to get calendar feed",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return &feed, nil
+}
+
+func (r *CalendarFeedRepository) Delete(ctx context.Context, userID int64) error {
+	const operation = "postgres.CalendarFeedRepository.Delete"
+
+	r.logger.Info("deleting calendar feed in db",
+		slog.String("operation", operation),
+		slog.Int64("user_id", userID))
+
+	res, err := r.db.ExecContext(ctx, deleteCalendarFeedQuery, userID)
+	if err != nil {
+		r.logger.Error("failed to delete calendar feed",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	if affected == 0 {
+		return repository.ErrFeedNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+)
+
+type CaregiverRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewCaregiverRepository(db *sql.DB, logger *slog.Logger) *CaregiverRepository {
+	return &CaregiverRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *CaregiverRepository) Link(ctx context.Context, link *entities.CaregiverLink) error {
+	const operation = "postgres.CaregiverRepository.Link"
+
+	r.logger.Info("linking caregiver in db",
+		slog.String("operation", operation),
+		slog.Int64("user_id", link.UserID),
+		slog.Int64("caregiver_id", link.CaregiverID))
+
+	res, err := r.db.ExecContext(ctx, addCaregiverLinkQuery, link.UserID, link.CaregiverID,
+		link.Permissions.View, link.Permissions.Edit, link.Permissions.Alerts, link.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to insert caregiver link",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	if affected == 0 {
+		return repository.ErrLinkExists
+	}
+
+	return nil
+}
+
+func (r *CaregiverRepository) Update(ctx context.Context, link *entities.CaregiverLink) error {
+	const operation = "postgres.CaregiverRepository.Update"
+
+	r.logger.Info("updating caregiver permissions in db",
+		slog.String("operation", operation),
+		slog.Int64("user_id", link.UserID),
+		slog.Int64("caregiver_id", link.CaregiverID))
+
+	err := r.db.QueryRowContext(ctx, updateCaregiverLinkQuery, link.UserID, link.CaregiverID,
+		link.Permissions.View, link.Permissions.Edit, link.Permissions.Alerts).Scan(&link.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return repository.ErrLinkNotFound
+		}
+		r.logger.Error("failed to update caregiver link",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
+
+func (r *CaregiverRepository) Unlink(ctx context.Context, userID, caregiverID int64) error {
+	const operation = "postgres.CaregiverRepository.Unlink"
+
+	r.logger.Info("unlinking caregiver in db",
+		slog.String("operation", operation),
+		slog.Int64("user_id", userID),
+		slog.Int64("caregiver_id", caregiverID))
+
+	res, err := r.db.ExecContext(ctx, deleteCaregiverLinkQuery, userID, caregiverID)
+	if err != nil {
+		r.logger.Error("failed to delete caregiver link",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	if affected == 0 {
+		return repository.ErrLinkNotFound
+	}
+
+	return nil
+}
+
+func (r *CaregiverRepository) Get(ctx context.Context, userID, caregiverID int64) (*entities.CaregiverLink, error) {
+	const operation = "postgres.CaregiverRepository.Get"
+
+	link, err := scanCaregiverLink(r.db.QueryRowContext(ctx, getCaregiverLinkQuery, userID, caregiverID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrLinkNotFound
+		}
+		r.logger.Error("failed to get caregiver link",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return link, nil
+}
+
+func (r *CaregiverRepository) GetCaregivers(ctx context.Context, userID int64) ([]entities.CaregiverLink, error) {
+	return r.getLinks(ctx, "postgres.CaregiverRepository.GetCaregivers", getCaregiversQuery, userID)
+}
+
+func (r *CaregiverRepository) GetDependants(ctx context.Context, caregiverID int64) ([]entities.CaregiverLink, error) {
+	return r.getLinks(ctx, "postgres.CaregiverRepository.GetDependants", getDependantsQuery, caregiverID)
+}
+
+func (r *CaregiverRepository) getLinks(ctx context.Context, operation, query string, id int64) ([]entities.CaregiverLink, error) {
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		r.logger.Error("failed to query caregiver links",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var links []entities.CaregiverLink
+	for rows.Next() {
+		link, err := scanCaregiverLink(rows)
+		if err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		links = append(links, *link)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return links, nil
+}
+
+func scanCaregiverLink(row rowScanner) (*entities.CaregiverLink, error) {
+	var link entities.CaregiverLink
+	err := row.Scan(&link.UserID, &link.CaregiverID,
+		&link.Permissions.View, &link.Permissions.Edit, &link.Permissions.Alerts, &link.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
 	"time"
 )
 
@@ -67,6 +68,21 @@ func InitializeSchema(db *sql.DB, logger *slog.Logger) error {
 		return fmt.Errorf("%s: %w", operation, err)
 	}
 
+	if err := backfillMedicineKeys(db); err != nil {
+		logger.Error("failed to fill in medicine keys",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(constrainMedicineKeysQuery)
+	if err != nil {
+		logger.Error("failed to constrain medicine keys",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
 	_, err = db.Exec(createTakingsQuery)
 	if err != nil {
 		logger.Error("failed to create takings table",
@@ -75,7 +91,167 @@ func InitializeSchema(db *sql.DB, logger *slog.Logger) error {
 		return fmt.Errorf("%s: %w", operation, err)
 	}
 
+	_, err = db.Exec(createPacksQuery)
+	if err != nil {
+		logger.Error("failed to create packs table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createNotificationsQuery)
+	if err != nil {
+		logger.Error("failed to create notifications table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createPlannedTakingsQuery)
+	if err != nil {
+		logger.Error("failed to create planned takings table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createIntakesQuery)
+	if err != nil {
+		logger.Error("failed to create intakes table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createCaregiverLinksQuery)
+	if err != nil {
+		logger.Error("failed to create caregiver links table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createEscalationRulesQuery)
+	if err != nil {
+		logger.Error("failed to create escalation rules table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createAPIKeysQuery)
+	if err != nil {
+		logger.Error("failed to create api keys table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createCalendarFeedsQuery)
+	if err != nil {
+		logger.Error("failed to create calendar feeds table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createUserRolesQuery)
+	if err != nil {
+		logger.Error("failed to create user roles table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createUserProfilesQuery)
+	if err != nil {
+		logger.Error("failed to create user profiles table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createMedicinesQuery)
+	if err != nil {
+		logger.Error("failed to create medicines tables",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createAccessLogQuery)
+	if err != nil {
+		logger.Error("failed to create access log table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createScheduleAuditQuery)
+	if err != nil {
+		logger.Error("failed to create schedule audit table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	_, err = db.Exec(createIdempotencyKeysQuery)
+	if err != nil {
+		logger.Error("failed to create idempotency keys table",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
 	logger.Info("db schema initialized successfully", slog.String("operation", operation))
 	return nil
 
 }
+
+// backfillMedicineKeys fills in the medicine keys of the schedules created
+// before them. They are computed in Go, SQL can't normalize the names like
+// medicine.Normalize. Names which differ only in the case or the spelling had
+// separate schedules, all but the first of them get keys with their IDs to
+// stay unique.
+func backfillMedicineKeys(db *sql.DB) error {
+	rows, err := db.Query(getMedicineKeysQuery)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type schedule struct {
+		id     int64
+		userID int64
+		name   string
+	}
+	keys := make(map[string]bool)
+	var unkeyed []schedule
+	for rows.Next() {
+		var s schedule
+		var key string
+		if err := rows.Scan(&s.id, &s.userID, &s.name, &key); err != nil {
+			return err
+		}
+		if key == "" {
+			unkeyed = append(unkeyed, s)
+			continue
+		}
+		keys[fmt.Sprintf("%d/%s", s.userID, key)] = true
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, s := range unkeyed {
+		key := entities.MedicineKey("", s.name)
+		if keys[fmt.Sprintf("%d/%s", s.userID, key)] {
+			key = fmt.Sprintf("%s#%d", key, s.id)
+		}
+		keys[fmt.Sprintf("%d/%s", s.userID, key)] = true
+		if _, err := db.Exec(setMedicineKeyQuery, s.id, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+)
+
+type DoseLimitRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewDoseLimitRepository(db *sql.DB, logger *slog.Logger) *DoseLimitRepository {
+	return &DoseLimitRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *DoseLimitRepository) Get(ctx context.Context, userID int64, ingredient string) (*entities.DoseLimit, error) {
+	const operation = "postgres.DoseLimitRepository.Get"
+
+	var limit entities.DoseLimit
+	err := r.db.QueryRowContext(ctx, getDoseLimitQuery, userID, ingredient).
+		Scan(&limit.UserID, &limit.Ingredient, &limit.MaxDailyDose, &limit.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrDoseLimitNotFound
+		}
+		r.logger.Error("failed to get dose limit",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return &limit, nil
+}
+
+func (r *DoseLimitRepository) List(ctx context.Context, userID int64) ([]entities.DoseLimit, error) {
+	const operation = "postgres.DoseLimitRepository.List"
+
+	rows, err := r.db.QueryContext(ctx, getDoseLimitsQuery, userID)
+	if err != nil {
+		r.logger.Error("failed to get dose limits",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	limits := []entities.DoseLimit{}
+	for rows.Next() {
+		var limit entities.DoseLimit
+		if err := rows.Scan(&limit.UserID, &limit.Ingredient, &limit.MaxDailyDose, &limit.UpdatedAt); err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		limits = append(limits, limit)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return limits, nil
+}
+
+func (r *DoseLimitRepository) Save(ctx context.Context, limit *entities.DoseLimit) error {
+	const operation = "postgres.DoseLimitRepository.Save"
+
+	r.logger.Info("saving dose limit in db",
+		slog.String("operation", operation),
+		slog.Int64("user_id", limit.UserID),
+		slog.String("ingredient", limit.Ingredient))
+
+	_, err := r.db.ExecContext(ctx, saveDoseLimitQuery, limit.UserID, limit.Ingredient, limit.MaxDailyDose, limit.UpdatedAt)
+	if err != nil {
+		r.logger.Error("failed to upsert dose limit",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
+
+func (r *DoseLimitRepository) Delete(ctx context.Context, userID int64, ingredient string) (*entities.DoseLimit, error) {
+	const operation = "postgres.DoseLimitRepository.Delete"
+
+	var limit entities.DoseLimit
+	err := r.db.QueryRowContext(ctx, deleteDoseLimitQuery, userID, ingredient).
+		Scan(&limit.UserID, &limit.Ingredient, &limit.MaxDailyDose, &limit.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrDoseLimitNotFound
+		}
+		r.logger.Error("failed to delete dose limit",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return &limit, nil
+}
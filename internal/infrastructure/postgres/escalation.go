@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"time"
+)
+
+type EscalationRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewEscalationRepository(db *sql.DB, logger *slog.Logger) *EscalationRepository {
+	return &EscalationRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *EscalationRepository) Save(ctx context.Context, rule *entities.EscalationRule) error {
+	const operation = "postgres.EscalationRepository.Save"
+
+	r.logger.Info("saving escalation rule in db",
+		slog.String("operation", operation),
+		slog.Int64("schedule_id", rule.ScheduleID))
+
+	_, err := r.db.ExecContext(ctx, saveEscalationRuleQuery,
+		rule.ScheduleID, rule.GracePeriod.Seconds(), rule.CaregiverDelay.Seconds(), rule.NotifyCaregivers)
+	if err != nil {
+		r.logger.Error("failed to upsert escalation rule",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
+
+func (r *EscalationRepository) GetByScheduleID(ctx context.Context, scheduleID int64) (*entities.EscalationRule, error) {
+	const operation = "postgres.EscalationRepository.GetByScheduleID"
+
+	rule, err := scanEscalationRule(r.db.QueryRowContext(ctx, getEscalationRuleQuery, scheduleID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrRuleNotFound
+		}
+		r.logger.Error("failed to query escalation rule",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return rule, nil
+}
+
+func (r *EscalationRepository) GetAll(ctx context.Context) ([]entities.EscalationRule, error) {
+	const operation = "postgres.EscalationRepository.GetAll"
+
+	rows, err := r.db.QueryContext(ctx, getEscalationRulesQuery)
+	if err != nil {
+		r.logger.Error("failed to query escalation rules",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var rules []entities.EscalationRule
+	for rows.Next() {
+		rule, err := scanEscalationRule(rows)
+		if err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		rules = append(rules, *rule)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return rules, nil
+}
+
+func scanEscalationRule(row rowScanner) (*entities.EscalationRule, error) {
+	var rule entities.EscalationRule
+	var graceSeconds, delaySeconds int64
+
+	if err := row.Scan(&rule.ScheduleID, &graceSeconds, &delaySeconds, &rule.NotifyCaregivers); err != nil {
+		return nil, err
+	}
+
+	rule.GracePeriod = time.Duration(graceSeconds) * time.Second
+	rule.CaregiverDelay = time.Duration(delaySeconds) * time.Second
+	return &rule, nil
+}
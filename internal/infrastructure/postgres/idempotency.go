@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"time"
+)
+
+type IdempotencyRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewIdempotencyRepository(db *sql.DB, logger *slog.Logger) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *IdempotencyRepository) Reserve(ctx context.Context, record *entities.IdempotencyRecord) (bool, error) {
+	const operation = "postgres.IdempotencyRepository.Reserve"
+
+	res, err := r.db.ExecContext(ctx, reserveIdempotencyKeyQuery,
+		record.Key, record.RequestHash, record.CreatedAt, record.ExpiresAt, record.LockedUntil)
+	if err != nil {
+		r.logger.Error("failed to reserve idempotency key",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return false, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return affected > 0, nil
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, key string) (*entities.IdempotencyRecord, error) {
+	const operation = "postgres.IdempotencyRepository.Get"
+
+	var record entities.IdempotencyRecord
+	var statusCode sql.NullInt64
+	var headers []byte
+	err := r.db.QueryRowContext(ctx, getIdempotencyKeyQuery, key).Scan(&record.Key, &record.RequestHash,
+		&statusCode, &record.ContentType, &headers, &record.Body, &record.CreatedAt, &record.ExpiresAt,
+		&record.LockedUntil)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrIdempotencyKeyNotFound
+		}
+		r.logger.Error("failed to get idempotency key",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	record.Completed = statusCode.Valid
+	record.StatusCode = int(statusCode.Int64)
+	if len(headers) > 0 {
+		if err := json.Unmarshal(headers, &record.Headers); err != nil {
+			r.logger.Error("failed to decode idempotent response headers",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+	}
+
+	return &record, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, record *entities.IdempotencyRecord) error {
+	const operation = "postgres.IdempotencyRepository.Complete"
+
+	var encoded any
+	if len(record.Headers) > 0 {
+		data, err := json.Marshal(record.Headers)
+		if err != nil {
+			return fmt.Errorf("%s: %w", operation, err)
+		}
+		encoded = string(data)
+	}
+
+	res, err := r.db.ExecContext(ctx, completeIdempotencyKeyQuery, record.Key, record.StatusCode, record.ContentType,
+		encoded, record.Body, record.RequestHash, record.LockedUntil)
+	if err != nil {
+		r.logger.Error("failed to complete idempotency key",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	if affected == 0 {
+		return repository.ErrIdempotencyKeyNotFound
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) Delete(ctx context.Context, record *entities.IdempotencyRecord) error {
+	const operation = "postgres.IdempotencyRepository.Delete"
+
+	_, err := r.db.ExecContext(ctx, deleteIdempotencyKeyQuery, record.Key, record.RequestHash, record.LockedUntil)
+	if err != nil {
+		r.logger.Error("failed to delete idempotency key",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	const operation = "postgres.IdempotencyRepository.DeleteExpired"
+
+	res, err := r.db.ExecContext(ctx, deleteExpiredIdempotencyKeysQuery, now)
+	if err != nil {
+		r.logger.Error("failed to delete expired idempotency keys",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return deleted, nil
+}
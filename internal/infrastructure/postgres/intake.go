@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"time"
+)
+
+type IntakeRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewIntakeRepository(db *sql.DB, logger *slog.Logger) *IntakeRepository {
+	return &IntakeRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create inserts the intake and the audit entry of its recording, when given,
+// in one transaction.
+func (r *IntakeRepository) Create(ctx context.Context, intake *entities.Intake, entry *entities.AuditEntry) (int64, error) {
+	const operation = "postgres.IntakeRepository.Create"
+
+	r.logger.Info("recording an intake in db",
+		slog.String("operation", operation),
+		slog.Int64("schedule_id", intake.ScheduleID),
+		slog.Time("planned_at", intake.PlannedAt))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer tx.Rollback()
+
+	id, err := insertIntake(ctx, tx, r.logger, operation, intake, entry)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return id, nil
+}
+
+// insertIntake inserts the intake and the audit entry, when given, in the
+// transaction.
+func insertIntake(ctx context.Context, tx *sql.Tx, logger *slog.Logger, operation string,
+	intake *entities.Intake, entry *entities.AuditEntry) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, addIntakeQuery,
+		intake.ScheduleID, intake.UserID, intake.PlannedAt, intake.TakenAt).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("intake already recorded", slog.String("operation", operation))
+			return 0, repository.ErrIntakeExists
+		}
+		logger.Error("failed to insert intake",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	if entry != nil {
+		if err := appendAuditEntry(ctx, tx, logger, operation, entry); err != nil {
+			return 0, err
+		}
+	}
+
+	return id, nil
+}
+
+func (r *IntakeRepository) GetPlannedBetween(ctx context.Context, from, to time.Time) ([]entities.Intake, error) {
+	const operation = "postgres.IntakeRepository.GetPlannedBetween"
+
+	rows, err := r.db.QueryContext(ctx, getIntakesBetweenQuery, from, to)
+	if err != nil {
+		r.logger.Error("failed to query intakes",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var intakes []entities.Intake
+	for rows.Next() {
+		var intake entities.Intake
+		if err := rows.Scan(&intake.ID, &intake.ScheduleID, &intake.UserID, &intake.PlannedAt, &intake.TakenAt); err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		intakes = append(intakes, intake)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return intakes, nil
+}
+
+// GetUserPlannedBetween returns the intakes of the user planned in the
+// [from, to) range.
+func (r *IntakeRepository) GetUserPlannedBetween(ctx context.Context, userID int64, from, to time.Time) ([]entities.Intake, error) {
+	const operation = "postgres.IntakeRepository.GetUserPlannedBetween"
+
+	rows, err := r.db.QueryContext(ctx, getUserIntakesBetweenQuery, userID, from, to)
+	if err != nil {
+		r.logger.Error("failed to query intakes",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var intakes []entities.Intake
+	for rows.Next() {
+		var intake entities.Intake
+		if err := rows.Scan(&intake.ID, &intake.ScheduleID, &intake.UserID, &intake.PlannedAt, &intake.TakenAt); err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		intakes = append(intakes, intake)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return intakes, nil
+}
+
+func (r *IntakeRepository) GetByUser(ctx context.Context, userID int64) ([]entities.Intake, error) {
+	const operation = "postgres.IntakeRepository.GetByUser"
+
+	rows, err := r.db.QueryContext(ctx, getUserIntakesQuery, userID)
+	if err != nil {
+		r.logger.Error("failed to query intakes",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var intakes []entities.Intake
+	for rows.Next() {
+		var intake entities.Intake
+		if err := rows.Scan(&intake.ID, &intake.ScheduleID, &intake.UserID, &intake.PlannedAt, &intake.TakenAt); err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		intakes = append(intakes, intake)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return intakes, nil
+}
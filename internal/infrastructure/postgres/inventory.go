@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+)
+
+type InventoryRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewInventoryRepository(db *sql.DB, logger *slog.Logger) *InventoryRepository {
+	return &InventoryRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *InventoryRepository) Refill(ctx context.Context, pack *entities.Pack) (int64, error) {
+	const operation = "postgres.InventoryRepository.Refill"
+
+	r.logger.Info("recording a refill in db",
+		slog.String("operation", operation),
+		slog.Int64("schedule_id", pack.ScheduleID),
+		slog.Int("quantity", pack.Quantity))
+
+	var id int64
+	err := r.db.QueryRowContext(ctx, refillPackQuery,
+		pack.ScheduleID, pack.UserID, pack.Quantity, pack.DosePerTaking, pack.RefilledAt).Scan(&id)
+	if err != nil {
+		r.logger.Error("failed to upsert pack",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return id, nil
+}
+
+func (r *InventoryRepository) GetByScheduleID(ctx context.Context, userID, scheduleID int64) (*entities.Pack, error) {
+	const operation = "postgres.InventoryRepository.GetByScheduleID"
+
+	r.logger.Info("getting pack by schedule ID",
+		slog.String("operation", operation),
+		slog.Int64("user_id", userID),
+		slog.Int64("schedule_id", scheduleID))
+
+	var pack entities.Pack
+	err := r.db.QueryRowContext(ctx, getPackQuery, userID, scheduleID).Scan(
+		&pack.ID, &pack.ScheduleID, &pack.UserID, &pack.Quantity, &pack.DosePerTaking, &pack.RefilledAt, &pack.RefillNotified)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.logger.Info("pack was not found", slog.String("operation", operation))
+			return nil, repository.ErrPackNotFound
+		}
+		r.logger.Error("failed to query pack",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return &pack, nil
+}
+
+func (r *InventoryRepository) GetAwaitingRefill(ctx context.Context) ([]entities.Pack, error) {
+	const operation = "postgres.InventoryRepository.GetAwaitingRefill"
+
+	rows, err := r.db.QueryContext(ctx, getPacksQuery)
+	if err != nil {
+		r.logger.Error("failed to query packs",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var packs []entities.Pack
+	for rows.Next() {
+		var pack entities.Pack
+		if err := rows.Scan(&pack.ID, &pack.ScheduleID, &pack.UserID, &pack.Quantity, &pack.DosePerTaking, &pack.RefilledAt, &pack.RefillNotified); err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		packs = append(packs, pack)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return packs, nil
+}
+
+// MarkRefillNotified flips the one-shot refill flag and reports whether
+// this call was the one that flipped it.
+func (r *InventoryRepository) MarkRefillNotified(ctx context.Context, packID int64) (bool, error) {
+	const operation = "postgres.InventoryRepository.MarkRefillNotified"
+
+	res, err := r.db.ExecContext(ctx, markRefillNotifiedQuery, packID)
+	if err != nil {
+		r.logger.Error("failed to mark pack as notified",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return false, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return affected > 0, nil
+}
+
+// ClearRefillNotified resets the refill flag, so that the reminder is sent
+// again on the next dispatch.
+func (r *InventoryRepository) ClearRefillNotified(ctx context.Context, packID int64) error {
+	const operation = "postgres.InventoryRepository.ClearRefillNotified"
+
+	if _, err := r.db.ExecContext(ctx, clearRefillNotifiedQuery, packID); err != nil {
+		r.logger.Error("failed to clear pack notification",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
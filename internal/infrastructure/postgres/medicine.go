@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"pills-taking-reminder/pkg/medicine"
+
+	"github.com/lib/pq"
+)
+
+type MedicineRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewMedicineRepository(db *sql.DB, logger *slog.Logger) *MedicineRepository {
+	return &MedicineRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Seed saves the medicines in one transaction. The names of every medicine
+// are replaced by its name and synonyms.
+func (r *MedicineRepository) Seed(ctx context.Context, medicines []entities.Medicine) error {
+	const operation = "postgres.MedicineRepository.Seed"
+
+	r.logger.Info("seeding medicine catalog in db",
+		slog.String("operation", operation),
+		slog.Int("count", len(medicines)))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	defer tx.Rollback()
+
+	for _, m := range medicines {
+		if _, err := tx.ExecContext(ctx, saveMedicineQuery, m.ID, m.Name, m.Ingredient, m.MaxDailyDose); err != nil {
+			r.logger.Error("failed to save medicine",
+				slog.String("operation", operation),
+				slog.String("medicine_id", m.ID),
+				slog.String("error", err.Error()))
+			return fmt.Errorf("%s: %w", operation, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, deleteMedicineNamesQuery, m.ID); err != nil {
+			r.logger.Error("failed to delete medicine names",
+				slog.String("operation", operation),
+				slog.String("medicine_id", m.ID),
+				slog.String("error", err.Error()))
+			return fmt.Errorf("%s: %w", operation, err)
+		}
+
+		for _, name := range append([]string{m.Name}, m.Synonyms...) {
+			normalized := medicine.Normalize(name)
+			if normalized == "" {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, addMedicineNameQuery, normalized, m.ID, name); err != nil {
+				r.logger.Error("failed to add medicine name",
+					slog.String("operation", operation),
+					slog.String("medicine_id", m.ID),
+					slog.String("error", err.Error()))
+				return fmt.Errorf("%s: %w", operation, err)
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
+
+func (r *MedicineRepository) Get(ctx context.Context, id string) (*entities.Medicine, error) {
+	const operation = "postgres.MedicineRepository.Get"
+
+	return r.getMedicine(ctx, operation, getMedicineQuery, id)
+}
+
+func (r *MedicineRepository) FindByName(ctx context.Context, name string) (*entities.Medicine, error) {
+	const operation = "postgres.MedicineRepository.FindByName"
+
+	return r.getMedicine(ctx, operation, findMedicineQuery, name)
+}
+
+func (r *MedicineRepository) getMedicine(ctx context.Context, operation, query string, arg string) (*entities.Medicine, error) {
+	var m entities.Medicine
+	err := r.db.QueryRowContext(ctx, query, arg).
+		Scan(&m.ID, &m.Name, &m.Ingredient, &m.MaxDailyDose, pq.Array(&m.Synonyms))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrMedicineNotFound
+		}
+		r.logger.Error("failed to get medicine",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return &m, nil
+}
+
+func (r *MedicineRepository) Search(ctx context.Context, prefix string, limit int) ([]entities.Medicine, error) {
+	const operation = "postgres.MedicineRepository.Search"
+
+	rows, err := r.db.QueryContext(ctx, searchMedicinesQuery, prefix, limit)
+	if err != nil {
+		r.logger.Error("failed to search medicines",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	medicines := []entities.Medicine{}
+	for rows.Next() {
+		var m entities.Medicine
+		if err := rows.Scan(&m.ID, &m.Name, &m.Ingredient, &m.MaxDailyDose, pq.Array(&m.Synonyms)); err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		medicines = append(medicines, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return medicines, nil
+}
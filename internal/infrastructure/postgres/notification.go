@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"time"
+)
+
+// notificationLease is how long a reserved notification waits to be sent
+// before it can be reserved again.
+const notificationLease = 5 * time.Minute
+
+type NotificationRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewNotificationRepository(db *sql.DB, logger *slog.Logger) *NotificationRepository {
+	return &NotificationRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Reserve stores the notification unless the same one was already sent or
+// reserved less than notificationLease ago and reports whether it was stored.
+func (r *NotificationRepository) Reserve(ctx context.Context, n *entities.Notification) (bool, error) {
+	const operation = "postgres.NotificationRepository.Reserve"
+
+	err := r.db.QueryRowContext(ctx, addNotificationQuery,
+		string(n.Type), n.UserID, n.RecipientID, n.ScheduleID, n.MedicineName, n.PlannedAt, n.Attempt,
+		notificationLease.Seconds()).Scan(&n.ID, &n.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		r.logger.Error("failed to insert notification",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return false, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return true, nil
+}
+
+func (r *NotificationRepository) MarkSent(ctx context.Context, id int64) error {
+	const operation = "postgres.NotificationRepository.MarkSent"
+
+	if _, err := r.db.ExecContext(ctx, markNotificationSentQuery, id); err != nil {
+		r.logger.Error("failed to mark notification as sent",
+			slog.String("operation", operation),
+			slog.Int64("id", id),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
+
+func (r *NotificationRepository) DispatchedUntil(ctx context.Context) (time.Time, error) {
+	const operation = "postgres.NotificationRepository.DispatchedUntil"
+
+	var until time.Time
+	err := r.db.QueryRowContext(ctx, getDispatchedUntilQuery).Scan(&until)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		r.logger.Error("failed to get dispatched time",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return time.Time{}, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return until, nil
+}
+
+func (r *NotificationRepository) SetDispatchedUntil(ctx context.Context, until time.Time) error {
+	const operation = "postgres.NotificationRepository.SetDispatchedUntil"
+
+	if _, err := r.db.ExecContext(ctx, setDispatchedUntilQuery, until); err != nil {
+		r.logger.Error("failed to save dispatched time",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
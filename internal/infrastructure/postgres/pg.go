@@ -7,9 +7,10 @@ import (
 	"fmt"
 	"log/slog"
 	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 var (
@@ -31,7 +32,10 @@ func NewScheduleRepository(db *sql.DB, logger *slog.Logger, interval time.Durati
 	}
 }
 
-func (r *ScheduleRepository) Create(ctx context.Context, schedule *entities.Schedule) (int64, error) {
+// Create inserts the schedule and the audit entry of its creation, when given,
+// in one transaction.
+func (r *ScheduleRepository) Create(ctx context.Context, schedule *entities.Schedule,
+	entry *entities.AuditEntry) (int64, error) {
 	const operation = "postgres.ScheduleRepository.Create"
 
 	r.logger.Info("creating a schedule in db",
@@ -47,23 +51,129 @@ func (r *ScheduleRepository) Create(ctx context.Context, schedule *entities.Sche
 	}
 	defer tx.Rollback()
 
+	id, err := r.insertSchedule(ctx, tx, operation, schedule, entry)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	r.logger.Info("schedule was created successfully",
+		slog.String("operation", operation),
+		slog.Int64("id", id))
+
+	return id, nil
+}
+
+// CreateBatch inserts the schedules with their audit entries in one
+// transaction. In the best-effort mode every schedule is inserted under a
+// savepoint, so a failed one does not abort the transaction.
+func (r *ScheduleRepository) CreateBatch(ctx context.Context, schedules []*entities.Schedule,
+	entries []*entities.AuditEntry, atomic bool) ([]repository.BatchResult, error) {
+	const operation = "postgres.ScheduleRepository.CreateBatch"
+
+	r.logger.Info("creating a batch of schedules in db",
+		slog.String("operation", operation),
+		slog.Int("count", len(schedules)),
+		slog.Bool("atomic", atomic))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer tx.Rollback()
+
+	savepoint := func(query string) error {
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			r.logger.Error("failed to use savepoint",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return fmt.Errorf("%s: %w", operation, err)
+		}
+		return nil
+	}
+
+	results := make([]repository.BatchResult, len(schedules))
+	for i, schedule := range schedules {
+		if !atomic {
+			if err := savepoint(batchSavepointQuery); err != nil {
+				return nil, err
+			}
+		}
+
+		var entry *entities.AuditEntry
+		if entries != nil {
+			entry = entries[i]
+		}
+		id, err := r.insertSchedule(ctx, tx, operation, schedule, entry)
+		if err != nil {
+			if atomic {
+				for j := range results {
+					results[j] = repository.BatchResult{Err: repository.ErrBatchAborted}
+				}
+				results[i].Err = err
+				return results, nil
+			}
+
+			results[i].Err = err
+			if err := savepoint(rollbackBatchSavepointQuery); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if !atomic {
+			if err := savepoint(releaseBatchSavepointQuery); err != nil {
+				return nil, err
+			}
+		}
+		results[i].ID = id
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	r.logger.Info("batch of schedules was created successfully",
+		slog.String("operation", operation))
+
+	return results, nil
+}
+
+// insertSchedule inserts the schedule with its taking times and the audit
+// entry, when given, in the transaction.
+func (r *ScheduleRepository) insertSchedule(ctx context.Context, tx *sql.Tx, operation string,
+	schedule *entities.Schedule, entry *entities.AuditEntry) (int64, error) {
 	var id int64
 	var query string
 	var args []any
 
 	if schedule.EndDate == nil {
 		query = addInfiniteScheduleQuery
-		args = []any{schedule.MedicineName, schedule.StartDate.Format("2006-01-02"), schedule.UserID}
+		args = []any{schedule.MedicineName, schedule.StartDate.Format("2006-01-02"), schedule.UserID,
+			schedule.MedicineID, schedule.MedicineKey(), schedule.Dose}
 	} else {
 		query = addTemporaryScheduleQuery
-		args = []any{schedule.MedicineName, schedule.StartDate.Format("2006-01-02"), schedule.EndDate.Format("2006-01-02"), schedule.UserID}
+		args = []any{schedule.MedicineName, schedule.StartDate.Format("2006-01-02"), schedule.EndDate.Format("2006-01-02"), schedule.UserID,
+			schedule.MedicineID, schedule.MedicineKey(), schedule.Dose}
 	}
 
-	err = tx.QueryRowContext(ctx, query, args...).Scan(&id)
+	err := tx.QueryRowContext(ctx, query, args...).Scan(&id)
 	if err != nil {
 		if isPgUniqueViolation(err) {
 			r.logger.Info("schedule already exists", slog.String("operation", operation))
-			return 0, ErrAlreadyExists
+			return 0, repository.ErrAlreadyExists
 		}
 		r.logger.Error("failed to insert schedule",
 			slog.String("operation", operation),
@@ -83,17 +193,13 @@ func (r *ScheduleRepository) Create(ctx context.Context, schedule *entities.Sche
 		}
 	}
 
-	if err = tx.Commit(); err != nil {
-		r.logger.Error("failed to commit transaction",
-			slog.String("operation", operation),
-			slog.String("error", err.Error()))
-		return 0, fmt.Errorf("%s: %w", operation, err)
+	if entry != nil {
+		entry.ScheduleID = id
+		if err := appendAuditEntry(ctx, tx, r.logger, operation, entry); err != nil {
+			return 0, err
+		}
 	}
 
-	r.logger.Info("schedule was created successfully",
-		slog.String("operation", operation),
-		slog.Int64("id", id))
-
 	return id, nil
 }
 
@@ -135,73 +241,6 @@ func (r *ScheduleRepository) GetSchedulesIDs(ctx context.Context, userID int64)
 	return ids, nil
 }
 
-func (r *ScheduleRepository) GetNextTakings(ctx context.Context, userID int64, interval string) ([]entities.Taking, error) {
-	const operation = "postgres.ScheduleRepository.GetNextTakings"
-
-	r.logger.Info("getting next takings for user",
-		slog.String("operation", operation),
-		slog.Int64("user_id", userID))
-
-	now := TimeNow()
-	currentTime := now.Format("15:04")
-
-	intervalDuration, err := time.ParseDuration(interval)
-	if err != nil {
-		r.logger.Error("failed to parse interval",
-			slog.String("operation", operation),
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("%s: %w", operation, err)
-	}
-
-	endTime := now.Add(intervalDuration).Format("15:04")
-
-	rows, err := r.db.QueryContext(ctx, getNextTakingsQuery, userID, currentTime, endTime, now.Format("2006-01-02"))
-	if err != nil {
-		r.logger.Error("failed to get next takings",
-			slog.String("operation", operation),
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("%s: %w", operation, err)
-	}
-	defer rows.Close()
-
-	var takings []entities.Taking
-	for rows.Next() {
-		var medicineName string
-		var takingTimeStr string
-
-		if err := rows.Scan(&medicineName, &takingTimeStr); err != nil {
-			r.logger.Error("failed to scan row",
-				slog.String("operation", operation),
-				slog.String("error", err.Error()))
-			return nil, fmt.Errorf("%s: %w", operation, err)
-		}
-
-		tt, err := time.Parse("15:04", takingTimeStr)
-		if err != nil {
-			r.logger.Error("failed to parse taking time",
-				slog.String("operation", operation),
-				slog.String("error", err.Error()))
-			return nil, fmt.Errorf("%s: %w", operation, err)
-		}
-
-		takingTime := time.Date(now.Year(), now.Month(), now.Day(), tt.Hour(), tt.Minute(), 0, 0, now.Location())
-
-		takings = append(takings, entities.Taking{
-			MedicineName: medicineName,
-			TakingTime:   takingTime,
-		})
-
-	}
-	if err := rows.Err(); err != nil {
-		r.logger.Error("error in rows",
-			slog.String("operation", operation),
-			slog.String("error", err.Error()))
-		return nil, fmt.Errorf("%s: %w", operation, err)
-	}
-
-	return takings, nil
-}
-
 func (r *ScheduleRepository) GetByID(ctx context.Context, userID, scheduleID int64) (*entities.Schedule, error) {
 	const operation = "postgres.ScheduleRepository.GetByID"
 
@@ -234,12 +273,16 @@ func (r *ScheduleRepository) GetByID(ctx context.Context, userID, scheduleID int
 
 		var id int64
 		var medicineName string
+		var medicineID string
+		var dose float64
 		var startDate time.Time
 		var endDate sql.NullTime
 		var userId int64
+		var version int64
+		var paused bool
 		var takingTime time.Time
 
-		if err := rows.Scan(&id, &medicineName, &startDate, &endDate, &userId, &takingTime); err != nil {
+		if err := rows.Scan(&id, &medicineName, &medicineID, &dose, &startDate, &endDate, &userId, &version, &paused, &takingTime); err != nil {
 			r.logger.Error("failed to scan row",
 				slog.String("operation", operation),
 				slog.String("error", err.Error()))
@@ -247,7 +290,11 @@ func (r *ScheduleRepository) GetByID(ctx context.Context, userID, scheduleID int
 
 		if count == 0 {
 			schedule.MedicineName = medicineName
+			schedule.MedicineID = medicineID
+			schedule.Dose = dose
 			schedule.StartDate = startDate
+			schedule.Version = version
+			schedule.Paused = paused
 			if endDate.Valid {
 				schedule.EndDate = &endDate.Time
 			}
@@ -267,14 +314,195 @@ func (r *ScheduleRepository) GetByID(ctx context.Context, userID, scheduleID int
 	}
 	if schedule == nil {
 		r.logger.Info("schedule was not found", slog.String("operation", operation))
-		return nil, ErrNotFound
+		return nil, repository.ErrNotFound
 	}
 
 	schedule.TakingTimes = takingTimes
 	return schedule, nil
 }
 
+func (r *ScheduleRepository) GetOwnerID(ctx context.Context, scheduleID int64) (int64, error) {
+	const operation = "postgres.ScheduleRepository.GetOwnerID"
+
+	var userID int64
+	err := r.db.QueryRowContext(ctx, getScheduleOwnerQuery, scheduleID).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.logger.Info("schedule was not found", slog.String("operation", operation))
+			return 0, repository.ErrNotFound
+		}
+		r.logger.Error("failed to get schedule owner",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return userID, nil
+}
+
+// Update saves the schedule and the audit entry of the update, when given, in
+// one transaction.
+func (r *ScheduleRepository) Update(ctx context.Context, schedule *entities.Schedule, entry *entities.AuditEntry) error {
+	const operation = "postgres.ScheduleRepository.Update"
+
+	r.logger.Info("updating a schedule in db",
+		slog.String("operation", operation),
+		slog.Any("schedule", schedule))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	defer tx.Rollback()
+
+	version, err := r.updateSchedule(ctx, tx, operation, schedule, entry)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	schedule.Version = version
+
+	return nil
+}
+
+// updateSchedule updates the schedule with its taking times and inserts the
+// audit entry, when given, in the transaction. It returns the new version.
+func (r *ScheduleRepository) updateSchedule(ctx context.Context, tx *sql.Tx, operation string,
+	schedule *entities.Schedule, entry *entities.AuditEntry) (int64, error) {
+	var endDate any
+	if schedule.EndDate != nil {
+		endDate = schedule.EndDate.Format("2006-01-02")
+	}
+
+	var version int64
+	err := tx.QueryRowContext(ctx, updateScheduleQuery, schedule.UserID, schedule.ID, schedule.MedicineName, endDate,
+		schedule.StartDate.Format("2006-01-02"), schedule.Version, schedule.MedicineID, schedule.MedicineKey(), schedule.Dose, schedule.Paused).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, r.updateMismatch(ctx, tx, operation, schedule)
+	}
+	if err != nil {
+		if isPgUniqueViolation(err) {
+			r.logger.Info("schedule already exists", slog.String("operation", operation))
+			return 0, repository.ErrAlreadyExists
+		}
+		r.logger.Error("failed to update schedule",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteTakingTimesQuery, schedule.ID); err != nil {
+		r.logger.Error("failed to delete taking times",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	for _, tt := range schedule.TakingTimes {
+		takingTime := fmt.Sprintf("%02d:%02d", tt.Time.Hour(), tt.Time.Minute())
+		_, err = tx.ExecContext(ctx,
+			addTakingTimeQuery, schedule.ID, takingTime)
+		if err != nil {
+			r.logger.Error("failed to insert taking time",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return 0, fmt.Errorf("%s: %w", operation, err)
+		}
+	}
+
+	if entry != nil {
+		if err := appendAuditEntry(ctx, tx, r.logger, operation, entry); err != nil {
+			return 0, err
+		}
+	}
+
+	return version, nil
+}
+
+// updateMismatch tells why no schedule was updated: it does not exist or has
+// another version.
+func (r *ScheduleRepository) updateMismatch(ctx context.Context, tx *sql.Tx, operation string,
+	schedule *entities.Schedule) error {
+	var version int64
+	err := tx.QueryRowContext(ctx, getScheduleVersionQuery, schedule.UserID, schedule.ID).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get schedule version",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	r.logger.Info("schedule version does not match",
+		slog.String("operation", operation),
+		slog.Int64("version", version),
+		slog.Int64("expected_version", schedule.Version))
+	return repository.ErrVersionMismatch
+}
+
+func (r *ScheduleRepository) GetActive(ctx context.Context) ([]entities.Schedule, error) {
+	const operation = "postgres.ScheduleRepository.GetActive"
+
+	r.logger.Info("getting active schedules", slog.String("operation", operation))
+
+	rows, err := r.db.QueryContext(ctx, getActiveSchedulesQuery, TimeNow().Format("2006-01-02"))
+	if err != nil {
+		r.logger.Error("failed to query active schedules",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var schedules []entities.Schedule
+	for rows.Next() {
+		var schedule entities.Schedule
+		var endDate sql.NullTime
+		var takingTime time.Time
+
+		if err := rows.Scan(&schedule.ID, &schedule.MedicineName, &schedule.MedicineID, &schedule.Dose, &schedule.StartDate, &endDate,
+			&schedule.UserID, &takingTime); err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+
+		if len(schedules) == 0 || schedules[len(schedules)-1].ID != schedule.ID {
+			if endDate.Valid {
+				schedule.EndDate = &endDate.Time
+			}
+			schedules = append(schedules, schedule)
+		}
+
+		last := &schedules[len(schedules)-1]
+		last.TakingTimes = append(last.TakingTimes, entities.TakingTime{
+			Time: time.Date(0, 0, 0, takingTime.Hour(), takingTime.Minute(), 0, 0, time.UTC),
+		})
+		last.Frequency = len(last.TakingTimes)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return schedules, nil
+}
+
 func isPgUniqueViolation(err error) bool {
-	return err != nil && (err.Error() == "pq: duplicate key value violates unique constraint" ||
-		err.Error() == "ERROR: duplicate key value violates unique constraint")
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
 }
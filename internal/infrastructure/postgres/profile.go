@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+)
+
+type ProfileRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewProfileRepository(db *sql.DB, logger *slog.Logger) *ProfileRepository {
+	return &ProfileRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *ProfileRepository) Get(ctx context.Context, userID int64) (*entities.Profile, error) {
+	const operation = "postgres.ProfileRepository.Get"
+
+	var profile entities.Profile
+	err := r.db.QueryRowContext(ctx, getUserProfileQuery, userID).
+		Scan(&profile.UserID, &profile.Locale, &profile.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrProfileNotFound
+		}
+		r.logger.Error("failed to get user profile",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return &profile, nil
+}
+
+func (r *ProfileRepository) Save(ctx context.Context, profile *entities.Profile) error {
+	const operation = "postgres.ProfileRepository.Save"
+
+	r.logger.Info("saving user profile in db",
+		slog.String("operation", operation),
+		slog.Int64("user_id", profile.UserID),
+		slog.String("locale", profile.Locale))
+
+	_, err := r.db.ExecContext(ctx, saveUserProfileQuery, profile.UserID, profile.Locale, profile.UpdatedAt)
+	if err != nil {
+		r.logger.Error("failed to upsert user profile",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
@@ -8,8 +8,28 @@ const (
 	    start_date DATE NOT NULL,
 	    end_date DATE,
 	    user_id INTEGER,
-	    UNIQUE(medicine_name, user_id)
-	)`
+	    version INTEGER NOT NULL DEFAULT 1
+	);
+
+	ALTER TABLE schedules ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+	ALTER TABLE schedules ADD COLUMN IF NOT EXISTS medicine_id TEXT;
+	ALTER TABLE schedules ADD COLUMN IF NOT EXISTS medicine_key TEXT;
+	ALTER TABLE schedules ADD COLUMN IF NOT EXISTS dose DOUBLE PRECISION NOT NULL DEFAULT 0;
+	ALTER TABLE schedules ADD COLUMN IF NOT EXISTS paused BOOLEAN NOT NULL DEFAULT FALSE;
+	ALTER TABLE schedules DROP CONSTRAINT IF EXISTS schedules_medicine_name_user_id_key`
+
+	// The medicine keys of the schedules created before them are filled in by
+	// backfillMedicineKeys before the constraints are added.
+	getMedicineKeysQuery = `
+		SELECT id, user_id, medicine_name, COALESCE(medicine_key, '')
+		FROM schedules
+		ORDER BY id`
+
+	setMedicineKeyQuery = `UPDATE schedules SET medicine_key = $2 WHERE id = $1`
+
+	constrainMedicineKeysQuery = `
+	ALTER TABLE schedules ALTER COLUMN medicine_key SET NOT NULL;
+	CREATE UNIQUE INDEX IF NOT EXISTS schedules_user_medicine_key ON schedules(user_id, medicine_key)`
 
 	createTakingsQuery = `
 CREATE TABLE IF NOT EXISTS takings(
@@ -20,14 +40,14 @@ CREATE TABLE IF NOT EXISTS takings(
 	)`
 
 	addInfiniteScheduleQuery = `
-		INSERT INTO schedules(medicine_name, start_date, user_id)
-		VALUES ($1, $2, $3)
+		INSERT INTO schedules(medicine_name, start_date, user_id, medicine_id, medicine_key, dose)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)
 		RETURNING id
 		`
 
 	addTemporaryScheduleQuery = `
-		INSERT INTO schedules(medicine_name, start_date, end_date, user_id)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO schedules(medicine_name, start_date, end_date, user_id, medicine_id, medicine_key, dose)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7)
 		RETURNING id
 		`
 
@@ -35,25 +55,624 @@ CREATE TABLE IF NOT EXISTS takings(
 INSERT INTO takings(schedule_id, taking_time)
 VALUES ($1, $2)`
 
-	getNextTakingsQuery = `
-		SELECT s.medicine_name, TO_CHAR(t.taking_time, 'HH24:MI') AS time
-		FROM takings t
-		JOIN schedules s ON t.schedule_id = s.id
-		WHERE s.user_id = $1 
-		  AND t.taking_time::time BETWEEN $2::time AND $3::time
-		  AND (s.end_date > $4 OR s.end_date IS NULL)
-		ORDER BY t.taking_time
-	`
+	batchSavepointQuery         = `SAVEPOINT batch_item`
+	releaseBatchSavepointQuery  = `RELEASE SAVEPOINT batch_item`
+	rollbackBatchSavepointQuery = `ROLLBACK TO SAVEPOINT batch_item`
 
 	getScheduleQuery = `
-		SELECT s.id, s.medicine_name, s.start_date, s.end_date, s.user_id, t.taking_time 
+		SELECT s.id, s.medicine_name, COALESCE(s.medicine_id, ''), s.dose, s.start_date, s.end_date, s.user_id, s.version,
+		       s.paused, t.taking_time
 		FROM schedules s
 		JOIN takings t ON s.id = t.schedule_id
 		WHERE s.user_id = $1 AND s.id = $2
 	`
 
+	updateScheduleQuery = `
+		UPDATE schedules
+		SET medicine_name = $3, end_date = $4, start_date = $5, version = version + 1,
+		    medicine_id = NULLIF($7, ''), medicine_key = $8, dose = $9, paused = $10
+		WHERE user_id = $1 AND id = $2 AND version = $6
+		RETURNING version
+		`
+
+	getScheduleVersionQuery = `
+		SELECT version
+		FROM schedules
+		WHERE user_id = $1 AND id = $2
+		`
+
+	deleteTakingTimesQuery = `
+DELETE FROM takings
+WHERE schedule_id = $1`
+
+	getScheduleOwnerQuery = `
+		SELECT user_id FROM schedules WHERE id = $1
+		`
+
 	getSchedulesQuery = `
 		SELECT id FROM schedules
 		WHERE user_id = $1 AND (end_date > $2 or end_date IS NULL)
 		`
+
+	getActiveSchedulesQuery = `
+		SELECT s.id, s.medicine_name, COALESCE(s.medicine_id, ''), s.dose, s.start_date, s.end_date, s.user_id, t.taking_time
+		FROM schedules s
+		JOIN takings t ON s.id = t.schedule_id
+		WHERE (s.end_date > $1 OR s.end_date IS NULL) AND NOT s.paused
+		ORDER BY s.id, t.taking_time
+		`
+
+	listSchedulesQuery = `
+		SELECT s.id, s.medicine_name, COALESCE(s.medicine_id, ''), s.dose, s.start_date, s.end_date, s.user_id, s.version,
+		       s.paused, COALESCE(ARRAY_AGG(TO_CHAR(t.taking_time, 'HH24:MI') ORDER BY t.taking_time)
+		                FILTER (WHERE t.id IS NOT NULL), '{}')
+		FROM schedules s
+		LEFT JOIN takings t ON t.schedule_id = s.id
+		WHERE %s
+		GROUP BY s.id
+		ORDER BY %s
+		LIMIT %d
+		`
+
+	createPacksQuery = `
+	CREATE TABLE IF NOT EXISTS packs(
+	    id SERIAL PRIMARY KEY,
+	    schedule_id INTEGER NOT NULL UNIQUE,
+	    user_id INTEGER NOT NULL,
+	    quantity INTEGER NOT NULL,
+	    dose_per_taking INTEGER NOT NULL DEFAULT 1,
+	    refilled_at TIMESTAMPTZ NOT NULL,
+	    refill_notified BOOLEAN NOT NULL DEFAULT FALSE,
+	    FOREIGN KEY(schedule_id) REFERENCES schedules(id)
+	)`
+
+	createNotificationsQuery = `
+	CREATE TABLE IF NOT EXISTS notifications(
+	    id SERIAL PRIMARY KEY,
+	    type TEXT NOT NULL,
+	    user_id INTEGER NOT NULL,
+	    recipient_id INTEGER NOT NULL,
+	    schedule_id INTEGER NOT NULL,
+	    medicine_name TEXT NOT NULL,
+	    planned_at TIMESTAMPTZ NOT NULL,
+	    attempt INTEGER NOT NULL DEFAULT 0,
+	    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	    sent_at TIMESTAMPTZ,
+	    UNIQUE(schedule_id, type, planned_at, attempt, recipient_id),
+	    FOREIGN KEY(schedule_id) REFERENCES schedules(id)
+	);
+	CREATE TABLE IF NOT EXISTS dispatch_state(
+	    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+	    dispatched_until TIMESTAMPTZ NOT NULL
+	)`
+
+	refillPackQuery = `
+		INSERT INTO packs(schedule_id, user_id, quantity, dose_per_taking, refilled_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (schedule_id) DO UPDATE
+		SET quantity = EXCLUDED.quantity,
+		    dose_per_taking = EXCLUDED.dose_per_taking,
+		    refilled_at = EXCLUDED.refilled_at,
+		    refill_notified = FALSE
+		RETURNING id
+		`
+
+	getPackQuery = `
+		SELECT id, schedule_id, user_id, quantity, dose_per_taking, refilled_at, refill_notified
+		FROM packs
+		WHERE user_id = $1 AND schedule_id = $2
+		`
+
+	getPacksQuery = `
+		SELECT id, schedule_id, user_id, quantity, dose_per_taking, refilled_at, refill_notified
+		FROM packs
+		WHERE refill_notified = FALSE
+		`
+
+	clearRefillNotifiedQuery = `
+		UPDATE packs SET refill_notified = FALSE
+		WHERE id = $1`
+
+	markRefillNotifiedQuery = `
+		UPDATE packs SET refill_notified = TRUE
+		WHERE id = $1 AND refill_notified = FALSE
+		`
+
+	addNotificationQuery = `
+		INSERT INTO notifications(type, user_id, recipient_id, schedule_id, medicine_name, planned_at, attempt)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (schedule_id, type, planned_at, attempt, recipient_id) DO UPDATE
+		SET created_at = NOW()
+		WHERE notifications.sent_at IS NULL AND notifications.created_at < NOW() - $8 * INTERVAL '1 second'
+		RETURNING id, created_at
+		`
+
+	markNotificationSentQuery = `
+		UPDATE notifications SET sent_at = NOW() WHERE id = $1
+		`
+
+	getDispatchedUntilQuery = `
+		SELECT dispatched_until FROM dispatch_state
+		`
+
+	setDispatchedUntilQuery = `
+		INSERT INTO dispatch_state(dispatched_until) VALUES ($1)
+		ON CONFLICT (id) DO UPDATE SET dispatched_until = EXCLUDED.dispatched_until
+		`
+
+	createPlannedTakingsQuery = `
+	CREATE TABLE IF NOT EXISTS planned_takings(
+	    id SERIAL PRIMARY KEY,
+	    schedule_id INTEGER NOT NULL,
+	    user_id INTEGER NOT NULL,
+	    medicine_name TEXT NOT NULL,
+	    planned_at TIMESTAMPTZ NOT NULL,
+	    status TEXT NOT NULL,
+	    snooze_count INTEGER NOT NULL DEFAULT 0,
+	    snoozed_until TIMESTAMPTZ,
+	    UNIQUE(schedule_id, planned_at),
+	    FOREIGN KEY(schedule_id) REFERENCES schedules(id)
+	)`
+
+	savePlannedTakingQuery = `
+		INSERT INTO planned_takings(schedule_id, user_id, medicine_name, planned_at, status, snooze_count, snoozed_until)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (schedule_id, planned_at) DO UPDATE
+		SET status = EXCLUDED.status,
+		    snooze_count = EXCLUDED.snooze_count,
+		    snoozed_until = EXCLUDED.snoozed_until
+		RETURNING id
+		`
+
+	getPlannedTakingQuery = `
+		SELECT id, schedule_id, user_id, medicine_name, planned_at, status, snooze_count, snoozed_until
+		FROM planned_takings
+		WHERE schedule_id = $1 AND planned_at = $2
+		`
+
+	getDueSnoozesQuery = `
+		SELECT id, schedule_id, user_id, medicine_name, planned_at, status, snooze_count, snoozed_until
+		FROM planned_takings
+		WHERE status = 'snoozed' AND snoozed_until <= $1
+		ORDER BY snoozed_until
+		`
+
+	getUserPlannedTakingsQuery = `
+		SELECT id, schedule_id, user_id, medicine_name, planned_at, status, snooze_count, snoozed_until
+		FROM planned_takings
+		WHERE user_id = $1 AND planned_at >= $2 AND planned_at < $3
+		`
+
+	createIntakesQuery = `
+	CREATE TABLE IF NOT EXISTS intakes(
+	    id SERIAL PRIMARY KEY,
+	    schedule_id INTEGER NOT NULL,
+	    user_id INTEGER NOT NULL,
+	    planned_at TIMESTAMPTZ NOT NULL,
+	    taken_at TIMESTAMPTZ NOT NULL,
+	    UNIQUE(schedule_id, planned_at),
+	    FOREIGN KEY(schedule_id) REFERENCES schedules(id)
+	)`
+
+	createCaregiverLinksQuery = `
+	CREATE TABLE IF NOT EXISTS caregiver_links(
+	    user_id INTEGER NOT NULL,
+	    caregiver_id INTEGER NOT NULL,
+	    can_view BOOLEAN NOT NULL DEFAULT TRUE,
+	    can_edit BOOLEAN NOT NULL DEFAULT FALSE,
+	    receive_alerts BOOLEAN NOT NULL DEFAULT TRUE,
+	    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	    PRIMARY KEY(user_id, caregiver_id)
+	)`
+
+	createEscalationRulesQuery = `
+	CREATE TABLE IF NOT EXISTS escalation_rules(
+	    schedule_id INTEGER PRIMARY KEY,
+	    grace_period INTERVAL NOT NULL,
+	    caregiver_delay INTERVAL NOT NULL,
+	    notify_caregivers BOOLEAN NOT NULL,
+	    FOREIGN KEY(schedule_id) REFERENCES schedules(id)
+	)`
+
+	addIntakeQuery = `
+		INSERT INTO intakes(schedule_id, user_id, planned_at, taken_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (schedule_id, planned_at) DO NOTHING
+		RETURNING id
+		`
+
+	getIntakesBetweenQuery = `
+		SELECT id, schedule_id, user_id, planned_at, taken_at
+		FROM intakes
+		WHERE planned_at BETWEEN $1 AND $2
+		`
+
+	getUserIntakesBetweenQuery = `
+		SELECT id, schedule_id, user_id, planned_at, taken_at
+		FROM intakes
+		WHERE user_id = $1 AND planned_at >= $2 AND planned_at < $3
+		`
+
+	getUserIntakesQuery = `
+		SELECT id, schedule_id, user_id, planned_at, taken_at
+		FROM intakes
+		WHERE user_id = $1
+		ORDER BY planned_at
+		`
+
+	addCaregiverLinkQuery = `
+		INSERT INTO caregiver_links(user_id, caregiver_id, can_view, can_edit, receive_alerts, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, caregiver_id) DO NOTHING
+		`
+
+	updateCaregiverLinkQuery = `
+		UPDATE caregiver_links
+		SET can_view = $3, can_edit = $4, receive_alerts = $5
+		WHERE user_id = $1 AND caregiver_id = $2
+		RETURNING created_at
+		`
+
+	getCaregiverLinkQuery = `
+		SELECT user_id, caregiver_id, can_view, can_edit, receive_alerts, created_at
+		FROM caregiver_links
+		WHERE user_id = $1 AND caregiver_id = $2
+		`
+
+	deleteCaregiverLinkQuery = `
+		DELETE FROM caregiver_links
+		WHERE user_id = $1 AND caregiver_id = $2
+		`
+
+	getCaregiversQuery = `
+		SELECT user_id, caregiver_id, can_view, can_edit, receive_alerts, created_at
+		FROM caregiver_links
+		WHERE user_id = $1
+		ORDER BY created_at
+		`
+
+	getDependantsQuery = `
+		SELECT user_id, caregiver_id, can_view, can_edit, receive_alerts, created_at
+		FROM caregiver_links
+		WHERE caregiver_id = $1
+		ORDER BY created_at
+		`
+
+	saveEscalationRuleQuery = `
+		INSERT INTO escalation_rules(schedule_id, grace_period, caregiver_delay, notify_caregivers)
+		VALUES ($1, make_interval(secs => $2), make_interval(secs => $3), $4)
+		ON CONFLICT (schedule_id) DO UPDATE
+		SET grace_period = EXCLUDED.grace_period,
+		    caregiver_delay = EXCLUDED.caregiver_delay,
+		    notify_caregivers = EXCLUDED.notify_caregivers
+		`
+
+	getEscalationRuleQuery = `
+		SELECT schedule_id, EXTRACT(EPOCH FROM grace_period)::BIGINT, EXTRACT(EPOCH FROM caregiver_delay)::BIGINT, notify_caregivers
+		FROM escalation_rules
+		WHERE schedule_id = $1
+		`
+
+	getEscalationRulesQuery = `
+		SELECT schedule_id, EXTRACT(EPOCH FROM grace_period)::BIGINT, EXTRACT(EPOCH FROM caregiver_delay)::BIGINT, notify_caregivers
+		FROM escalation_rules
+		`
+
+	createAPIKeysQuery = `
+	CREATE TABLE IF NOT EXISTS api_keys(
+	    id SERIAL PRIMARY KEY,
+	    user_id INTEGER NOT NULL,
+	    name TEXT NOT NULL,
+	    prefix TEXT NOT NULL,
+	    key_hash TEXT NOT NULL UNIQUE,
+	    scopes TEXT[] NOT NULL,
+	    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	    revoked_at TIMESTAMPTZ
+	)`
+
+	addAPIKeyQuery = `
+		INSERT INTO api_keys(user_id, name, prefix, key_hash, scopes, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+		`
+
+	getAPIKeyByHashQuery = `
+		SELECT id, user_id, name, prefix, key_hash, scopes, created_at, revoked_at
+		FROM api_keys
+		WHERE key_hash = $1
+		`
+
+	getAPIKeysQuery = `
+		SELECT id, user_id, name, prefix, key_hash, scopes, created_at, revoked_at
+		FROM api_keys
+		WHERE user_id = $1
+		ORDER BY created_at
+		`
+
+	revokeAPIKeyQuery = `
+		UPDATE api_keys
+		SET revoked_at = $3
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+		`
+
+	createCalendarFeedsQuery = `
+	CREATE TABLE IF NOT EXISTS calendar_feeds(
+	    user_id INTEGER PRIMARY KEY,
+	    token_hash TEXT NOT NULL UNIQUE,
+	    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`
+
+	saveCalendarFeedQuery = `
+		INSERT INTO calendar_feeds(user_id, token_hash, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET token_hash = EXCLUDED.token_hash,
+		    created_at = EXCLUDED.created_at
+		`
+
+	getCalendarFeedByHashQuery = `
+		SELECT user_id, token_hash, created_at
+		FROM calendar_feeds
+		WHERE token_hash = $1
+		`
+
+	deleteCalendarFeedQuery = `
+		DELETE FROM calendar_feeds
+		WHERE user_id = $1
+		`
+
+	createUserRolesQuery = `
+	CREATE TABLE IF NOT EXISTS user_roles(
+	    user_id INTEGER PRIMARY KEY,
+	    role TEXT NOT NULL
+	)`
+
+	createAccessLogQuery = `
+	CREATE TABLE IF NOT EXISTS access_log(
+	    id SERIAL PRIMARY KEY,
+	    actor_id INTEGER NOT NULL,
+	    user_id INTEGER NOT NULL,
+	    action TEXT NOT NULL,
+	    allowed BOOLEAN NOT NULL,
+	    trace_id TEXT NOT NULL,
+	    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`
+
+	getUserRoleQuery = `
+		SELECT role
+		FROM user_roles
+		WHERE user_id = $1
+		`
+
+	setUserRoleQuery = `
+		INSERT INTO user_roles(user_id, role)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET role = EXCLUDED.role
+		`
+
+	createUserProfilesQuery = `
+	CREATE TABLE IF NOT EXISTS user_profiles(
+	    user_id INTEGER PRIMARY KEY,
+	    locale TEXT NOT NULL,
+	    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`
+
+	createMedicinesQuery = `
+	CREATE TABLE IF NOT EXISTS medicines(
+	    id TEXT PRIMARY KEY,
+	    name TEXT NOT NULL,
+	    ingredient TEXT NOT NULL,
+	    max_daily_dose DOUBLE PRECISION NOT NULL DEFAULT 0
+	);
+
+	CREATE TABLE IF NOT EXISTS medicine_names(
+	    normalized TEXT PRIMARY KEY,
+	    medicine_id TEXT NOT NULL,
+	    name TEXT NOT NULL,
+	    FOREIGN KEY(medicine_id) REFERENCES medicines(id)
+	);
+
+	CREATE TABLE IF NOT EXISTS dose_limits(
+	    user_id INTEGER NOT NULL,
+	    ingredient TEXT NOT NULL,
+	    max_daily_dose DOUBLE PRECISION NOT NULL,
+	    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	    PRIMARY KEY(user_id, ingredient)
+	)`
+
+	saveMedicineQuery = `
+		INSERT INTO medicines(id, name, ingredient, max_daily_dose)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE
+		SET name = EXCLUDED.name,
+		    ingredient = EXCLUDED.ingredient,
+		    max_daily_dose = EXCLUDED.max_daily_dose
+		`
+
+	deleteMedicineNamesQuery = `
+		DELETE FROM medicine_names
+		WHERE medicine_id = $1
+		`
+
+	addMedicineNameQuery = `
+		INSERT INTO medicine_names(normalized, medicine_id, name)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (normalized) DO UPDATE
+		SET medicine_id = EXCLUDED.medicine_id,
+		    name = EXCLUDED.name
+		`
+
+	getMedicineQuery = `
+		SELECT m.id, m.name, m.ingredient, m.max_daily_dose,
+		       COALESCE(ARRAY_AGG(n.name ORDER BY n.name) FILTER (WHERE n.name <> m.name), '{}')
+		FROM medicines m
+		LEFT JOIN medicine_names n ON n.medicine_id = m.id
+		WHERE m.id = $1
+		GROUP BY m.id
+		`
+
+	findMedicineQuery = `
+		SELECT m.id, m.name, m.ingredient, m.max_daily_dose,
+		       COALESCE(ARRAY_AGG(n.name ORDER BY n.name) FILTER (WHERE n.name <> m.name), '{}')
+		FROM medicines m
+		LEFT JOIN medicine_names n ON n.medicine_id = m.id
+		WHERE m.id = (SELECT medicine_id FROM medicine_names WHERE normalized = $1)
+		GROUP BY m.id
+		`
+
+	searchMedicinesQuery = `
+		SELECT m.id, m.name, m.ingredient, m.max_daily_dose,
+		       COALESCE(ARRAY_AGG(n.name ORDER BY n.name) FILTER (WHERE n.name <> m.name), '{}')
+		FROM medicines m
+		LEFT JOIN medicine_names n ON n.medicine_id = m.id
+		WHERE m.id IN (
+		    SELECT medicine_id FROM medicine_names
+		    WHERE normalized LIKE $1 || '%' OR normalized LIKE '% ' || $1 || '%'
+		)
+		GROUP BY m.id
+		ORDER BY m.name
+		LIMIT $2
+		`
+
+	getDoseLimitQuery = `
+		SELECT user_id, ingredient, max_daily_dose, updated_at
+		FROM dose_limits
+		WHERE user_id = $1 AND ingredient = $2
+		`
+
+	getDoseLimitsQuery = `
+		SELECT user_id, ingredient, max_daily_dose, updated_at
+		FROM dose_limits
+		WHERE user_id = $1
+		ORDER BY ingredient
+		`
+
+	saveDoseLimitQuery = `
+		INSERT INTO dose_limits(user_id, ingredient, max_daily_dose, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, ingredient) DO UPDATE
+		SET max_daily_dose = EXCLUDED.max_daily_dose,
+		    updated_at = EXCLUDED.updated_at
+		`
+
+	deleteDoseLimitQuery = `
+		DELETE FROM dose_limits
+		WHERE user_id = $1 AND ingredient = $2
+		RETURNING user_id, ingredient, max_daily_dose, updated_at
+		`
+
+	getUserProfileQuery = `
+		SELECT user_id, locale, updated_at
+		FROM user_profiles
+		WHERE user_id = $1
+		`
+
+	saveUserProfileQuery = `
+		INSERT INTO user_profiles(user_id, locale, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET locale = EXCLUDED.locale,
+		    updated_at = EXCLUDED.updated_at
+		`
+
+	addAccessRecordQuery = `
+		INSERT INTO access_log(actor_id, user_id, action, allowed, trace_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		`
+
+	createScheduleAuditQuery = `
+	CREATE TABLE IF NOT EXISTS schedule_audit(
+	    id SERIAL PRIMARY KEY,
+	    schedule_id INTEGER NOT NULL,
+	    user_id INTEGER NOT NULL,
+	    actor_id INTEGER,
+	    action TEXT NOT NULL,
+	    trace_id TEXT NOT NULL,
+	    before JSONB,
+	    after JSONB,
+	    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS schedule_audit_schedule_id_idx ON schedule_audit(schedule_id);
+
+	CREATE OR REPLACE FUNCTION reject_schedule_audit_change() RETURNS TRIGGER AS $$
+	BEGIN
+	    RAISE EXCEPTION 'schedule_audit is append-only';
+	END;
+	$$ LANGUAGE plpgsql;
+
+	DROP TRIGGER IF EXISTS schedule_audit_append_only ON schedule_audit;
+	CREATE TRIGGER schedule_audit_append_only
+	    BEFORE UPDATE OR DELETE ON schedule_audit
+	    FOR EACH ROW EXECUTE FUNCTION reject_schedule_audit_change()`
+
+	addAuditEntryQuery = `
+		INSERT INTO schedule_audit(schedule_id, user_id, actor_id, action, trace_id, before, after, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+		`
+
+	getScheduleAuditQuery = `
+		SELECT id, schedule_id, user_id, actor_id, action, trace_id, before, after, created_at
+		FROM schedule_audit
+		WHERE schedule_id = $1
+		ORDER BY created_at, id
+		`
+
+	createIdempotencyKeysQuery = `
+	CREATE TABLE IF NOT EXISTS idempotency_keys(
+	    key TEXT PRIMARY KEY,
+	    request_hash TEXT NOT NULL,
+	    status_code INTEGER,
+	    content_type TEXT NOT NULL DEFAULT '',
+	    headers JSONB,
+	    body BYTEA,
+	    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	    expires_at TIMESTAMPTZ NOT NULL,
+	    locked_until TIMESTAMPTZ NOT NULL
+	);
+
+	CREATE INDEX IF NOT EXISTS idempotency_keys_expires_at_idx ON idempotency_keys(expires_at)`
+
+	deleteExpiredIdempotencyKeysQuery = `
+		DELETE FROM idempotency_keys
+		WHERE expires_at <= $1
+		`
+
+	reserveIdempotencyKeyQuery = `
+		INSERT INTO idempotency_keys(key, request_hash, created_at, expires_at, locked_until)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+		    status_code = NULL,
+		    content_type = '',
+		    headers = NULL,
+		    body = NULL,
+		    created_at = EXCLUDED.created_at,
+		    expires_at = EXCLUDED.expires_at,
+		    locked_until = EXCLUDED.locked_until
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+		   OR (idempotency_keys.status_code IS NULL AND idempotency_keys.locked_until <= EXCLUDED.created_at)
+		`
+
+	getIdempotencyKeyQuery = `
+		SELECT key, request_hash, status_code, content_type, headers, body, created_at, expires_at, locked_until
+		FROM idempotency_keys
+		WHERE key = $1
+		`
+
+	completeIdempotencyKeyQuery = `
+		UPDATE idempotency_keys
+		SET status_code = $2,
+		    content_type = $3,
+		    headers = $4,
+		    body = $5
+		WHERE key = $1 AND request_hash = $6 AND locked_until = $7 AND status_code IS NULL
+		`
+
+	deleteIdempotencyKeyQuery = `
+		DELETE FROM idempotency_keys
+		WHERE key = $1 AND request_hash = $2 AND locked_until = $3 AND status_code IS NULL
+		`
 )
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+)
+
+type RoleRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewRoleRepository(db *sql.DB, logger *slog.Logger) *RoleRepository {
+	return &RoleRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *RoleRepository) Get(ctx context.Context, userID int64) (entities.Role, error) {
+	const operation = "postgres.RoleRepository.Get"
+
+	var role string
+	err := r.db.QueryRowContext(ctx, getUserRoleQuery, userID).Scan(&role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.RolePatient, nil
+		}
+		r.logger.Error("failed to get user role",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return "", fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return entities.ParseRole(role)
+}
+
+func (r *RoleRepository) Set(ctx context.Context, userID int64, role entities.Role) error {
+	const operation = "postgres.RoleRepository.Set"
+
+	r.logger.Info("setting user role in db",
+		slog.String("operation", operation),
+		slog.Int64("user_id", userID),
+		slog.String("role", string(role)))
+
+	if _, err := r.db.ExecContext(ctx, setUserRoleQuery, userID, string(role)); err != nil {
+		r.logger.Error("failed to set user role",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
+
+type AccessLogRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewAccessLogRepository(db *sql.DB, logger *slog.Logger) *AccessLogRepository {
+	return &AccessLogRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *AccessLogRepository) Record(ctx context.Context, record *entities.AccessRecord) error {
+	const operation = "postgres.AccessLogRepository.Record"
+
+	_, err := r.db.ExecContext(ctx, addAccessRecordQuery, record.ActorID, record.UserID, record.Action,
+		record.Allowed, record.TraceID, record.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to insert access record",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return nil
+}
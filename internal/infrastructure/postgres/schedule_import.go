@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/repository"
+)
+
+// Import writes the changes in one transaction, the versions of the updated
+// schedules are set once it is committed.
+func (r *ScheduleRepository) Import(ctx context.Context, changes []repository.ImportChange) error {
+	const operation = "postgres.ScheduleRepository.Import"
+
+	r.logger.Info("importing schedules in db",
+		slog.String("operation", operation),
+		slog.Int("count", len(changes)))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("failed to begin transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	defer tx.Rollback()
+
+	versions := make([]int64, len(changes))
+	for i, change := range changes {
+		schedule := change.Schedule
+		switch {
+		case schedule.ID == 0:
+			id, err := r.insertSchedule(ctx, tx, operation, schedule, change.Entry)
+			if err != nil {
+				return err
+			}
+			schedule.ID = id
+		case change.Entry != nil:
+			if versions[i], err = r.updateSchedule(ctx, tx, operation, schedule, change.Entry); err != nil {
+				return err
+			}
+		}
+
+		for _, imported := range change.Intakes {
+			imported.Intake.ScheduleID = schedule.ID
+			if imported.Entry != nil {
+				imported.Entry.ScheduleID = schedule.ID
+			}
+			id, err := insertIntake(ctx, tx, r.logger, operation, imported.Intake, imported.Entry)
+			if errors.Is(err, repository.ErrIntakeExists) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			imported.Intake.ID = id
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	for i, change := range changes {
+		if versions[i] != 0 {
+			change.Schedule.Version = versions[i]
+		}
+	}
+
+	r.logger.Info("schedules were imported successfully",
+		slog.String("operation", operation))
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var sortColumns = map[repository.ScheduleSort]string{
+	repository.ScheduleSortID:           "s.id",
+	repository.ScheduleSortMedicineName: "s.medicine_name",
+	repository.ScheduleSortStartDate:    "s.start_date",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// List returns a page of the user's schedules with their taking times in one
+// query, paginated by the keyset of the sort column and the schedule ID.
+func (r *ScheduleRepository) List(ctx context.Context, filter repository.ScheduleFilter) ([]entities.Schedule, error) {
+	const operation = "postgres.ScheduleRepository.List"
+
+	r.logger.Info("listing schedules",
+		slog.String("operation", operation),
+		slog.Int64("user_id", filter.UserID),
+		slog.String("status", string(filter.Status)),
+		slog.String("sort", string(filter.Sort)))
+
+	column, ok := sortColumns[filter.Sort]
+	if !ok {
+		return nil, fmt.Errorf("%s: unknown sort %q", operation, filter.Sort)
+	}
+
+	conditions := []string{"s.user_id = $1"}
+	args := []any{filter.UserID}
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	today := TimeNow().Format("2006-01-02")
+	switch filter.Status {
+	case repository.ScheduleStatusActive:
+		conditions = append(conditions, fmt.Sprintf("(s.end_date > %s OR s.end_date IS NULL) AND NOT s.paused", arg(today)))
+	case repository.ScheduleStatusPaused:
+		conditions = append(conditions, fmt.Sprintf("(s.end_date > %s OR s.end_date IS NULL) AND s.paused", arg(today)))
+	case repository.ScheduleStatusFinished:
+		conditions = append(conditions, fmt.Sprintf("s.end_date <= %s", arg(today)))
+	}
+
+	if filter.MedicinePrefix != "" {
+		conditions = append(conditions, fmt.Sprintf("LOWER(s.medicine_name) LIKE LOWER(%s::text) || '%%'",
+			arg(likeEscaper.Replace(filter.MedicinePrefix))))
+	}
+	if filter.From != nil {
+		conditions = append(conditions, fmt.Sprintf("(s.end_date >= %s OR s.end_date IS NULL)",
+			arg(filter.From.Format("2006-01-02"))))
+	}
+	if filter.To != nil {
+		conditions = append(conditions, fmt.Sprintf("s.start_date <= %s", arg(filter.To.Format("2006-01-02"))))
+	}
+
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if filter.After != nil {
+		if filter.Sort == repository.ScheduleSortID {
+			conditions = append(conditions, fmt.Sprintf("s.id %s %s", comparison, arg(filter.After.ID)))
+		} else {
+			value := arg(filter.After.Value)
+			if filter.Sort == repository.ScheduleSortStartDate {
+				value += "::date"
+			}
+			conditions = append(conditions, fmt.Sprintf("(%s, s.id) %s (%s, %s)",
+				column, comparison, value, arg(filter.After.ID)))
+		}
+	}
+
+	order := fmt.Sprintf("%s %s", column, direction)
+	if filter.Sort != repository.ScheduleSortID {
+		order += fmt.Sprintf(", s.id %s", direction)
+	}
+
+	query := fmt.Sprintf(listSchedulesQuery, strings.Join(conditions, " AND "), order, filter.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("failed to query schedules",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var schedules []entities.Schedule
+	for rows.Next() {
+		var schedule entities.Schedule
+		var endDate sql.NullTime
+		var takingTimes []string
+
+		err := rows.Scan(&schedule.ID, &schedule.MedicineName, &schedule.MedicineID, &schedule.Dose, &schedule.StartDate, &endDate,
+			&schedule.UserID, &schedule.Version, &schedule.Paused, pq.Array(&takingTimes))
+		if err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+
+		if endDate.Valid {
+			schedule.EndDate = &endDate.Time
+		}
+		for _, value := range takingTimes {
+			takingTime, err := time.Parse("15:04", value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", operation, err)
+			}
+			schedule.TakingTimes = append(schedule.TakingTimes, entities.TakingTime{
+				Time: time.Date(0, 0, 0, takingTime.Hour(), takingTime.Minute(), 0, 0, time.UTC),
+			})
+		}
+		schedule.Frequency = len(schedule.TakingTimes)
+
+		schedules = append(schedules, schedule)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return schedules, nil
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/repository"
+	"time"
+)
+
+type TakingRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewTakingRepository(db *sql.DB, logger *slog.Logger) *TakingRepository {
+	return &TakingRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *TakingRepository) Get(ctx context.Context, scheduleID int64, plannedAt time.Time) (*entities.PlannedTaking, error) {
+	const operation = "postgres.TakingRepository.Get"
+
+	taking, err := scanPlannedTaking(r.db.QueryRowContext(ctx, getPlannedTakingQuery, scheduleID, plannedAt))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrTakingNotFound
+		}
+		r.logger.Error("failed to query planned taking",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return taking, nil
+}
+
+func (r *TakingRepository) Save(ctx context.Context, taking *entities.PlannedTaking) (int64, error) {
+	const operation = "postgres.TakingRepository.Save"
+
+	r.logger.Info("saving planned taking state",
+		slog.String("operation", operation),
+		slog.Int64("schedule_id", taking.ScheduleID),
+		slog.Time("planned_at", taking.PlannedAt),
+		slog.String("status", string(taking.Status)))
+
+	var snoozedUntil sql.NullTime
+	if taking.SnoozedUntil != nil {
+		snoozedUntil = sql.NullTime{Time: *taking.SnoozedUntil, Valid: true}
+	}
+
+	var id int64
+	err := r.db.QueryRowContext(ctx, savePlannedTakingQuery,
+		taking.ScheduleID, taking.UserID, taking.MedicineName, taking.PlannedAt,
+		string(taking.Status), taking.SnoozeCount, snoozedUntil).Scan(&id)
+	if err != nil {
+		r.logger.Error("failed to upsert planned taking",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return 0, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return id, nil
+}
+
+func (r *TakingRepository) GetDueSnoozes(ctx context.Context, now time.Time) ([]entities.PlannedTaking, error) {
+	const operation = "postgres.TakingRepository.GetDueSnoozes"
+
+	rows, err := r.db.QueryContext(ctx, getDueSnoozesQuery, now)
+	if err != nil {
+		r.logger.Error("failed to query due snoozes",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var takings []entities.PlannedTaking
+	for rows.Next() {
+		taking, err := scanPlannedTaking(rows)
+		if err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		takings = append(takings, *taking)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return takings, nil
+}
+
+// GetUserBetween returns the saved taking states of the user planned in the
+// [from, to) range.
+func (r *TakingRepository) GetUserBetween(ctx context.Context, userID int64, from, to time.Time) ([]entities.PlannedTaking, error) {
+	const operation = "postgres.TakingRepository.GetUserBetween"
+
+	rows, err := r.db.QueryContext(ctx, getUserPlannedTakingsQuery, userID, from, to)
+	if err != nil {
+		r.logger.Error("failed to query planned takings",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rows.Close()
+
+	var takings []entities.PlannedTaking
+	for rows.Next() {
+		taking, err := scanPlannedTaking(rows)
+		if err != nil {
+			r.logger.Error("failed to scan row",
+				slog.String("operation", operation),
+				slog.String("error", err.Error()))
+			return nil, fmt.Errorf("%s: %w", operation, err)
+		}
+		takings = append(takings, *taking)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error("error in rows",
+			slog.String("operation", operation),
+			slog.String("error", err.Error()))
+		return nil, fmt.Errorf("%s: %w", operation, err)
+	}
+
+	return takings, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPlannedTaking(row rowScanner) (*entities.PlannedTaking, error) {
+	var taking entities.PlannedTaking
+	var status string
+	var snoozedUntil sql.NullTime
+
+	if err := row.Scan(&taking.ID, &taking.ScheduleID, &taking.UserID, &taking.MedicineName,
+		&taking.PlannedAt, &status, &taking.SnoozeCount, &snoozedUntil); err != nil {
+		return nil, err
+	}
+
+	taking.Status = entities.TakingStatus(status)
+	if snoozedUntil.Valid {
+		taking.SnoozedUntil = &snoozedUntil.Time
+	}
+	return &taking, nil
+}
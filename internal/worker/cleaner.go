@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/usecase"
+	"time"
+)
+
+// Cleaner removes the expired idempotent responses, which are not removed
+// when the keys are reserved.
+type Cleaner struct {
+	idempotencyUseCase *usecase.IdempotencyUseCase
+	logger             *slog.Logger
+	interval           time.Duration
+}
+
+func NewCleaner(idempotencyUseCase *usecase.IdempotencyUseCase, logger *slog.Logger, interval time.Duration) *Cleaner {
+	return &Cleaner{
+		idempotencyUseCase: idempotencyUseCase,
+		logger:             logger,
+		interval:           interval,
+	}
+}
+
+// Run cleans up on every tick until the context is cancelled.
+func (c *Cleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.logger.Info("cleaner started", slog.Duration("interval", c.interval))
+
+	for {
+		c.cleanup(ctx)
+
+		select {
+		case <-ctx.Done():
+			c.logger.Info("cleaner stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Cleaner) cleanup(ctx context.Context) {
+	deleted, err := c.idempotencyUseCase.Cleanup(ctx)
+	if err != nil {
+		c.logger.Error("failed to clean up idempotency keys", slog.String("error", err.Error()))
+		return
+	}
+	c.logger.Info("idempotency keys were cleaned up", slog.Int64("deleted", deleted))
+}
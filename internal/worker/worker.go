@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"pills-taking-reminder/internal/domain/usecase"
+	"time"
+)
+
+type Worker struct {
+	reminderUseCase *usecase.ReminderUseCase
+	logger          *slog.Logger
+	interval        time.Duration
+}
+
+func NewWorker(reminderUseCase *usecase.ReminderUseCase, logger *slog.Logger, interval time.Duration) *Worker {
+	return &Worker{
+		reminderUseCase: reminderUseCase,
+		logger:          logger,
+		interval:        interval,
+	}
+}
+
+// Run dispatches reminders on every tick until the context is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	w.logger.Info("reminder worker started", slog.Duration("interval", w.interval))
+
+	for {
+		w.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("reminder worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) dispatch(ctx context.Context) {
+	if err := w.reminderUseCase.Dispatch(ctx); err != nil {
+		w.logger.Error("failed to dispatch reminders", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,189 @@
+// Package fhir holds the parts of the HL7 FHIR R4 medication resources the
+// service exchanges with clinics.
+package fhir
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ContentType is the media type of FHIR resources in JSON.
+const ContentType = "application/fhir+json"
+
+// Date and time layouts of the FHIR date, dateTime and time types.
+const (
+	DateLayout     = "2006-01-02"
+	DateTimeLayout = "2006-01-02T15:04:05Z07:00"
+	TimeLayout     = "15:04:05"
+)
+
+var ErrInvalidResource = errors.New("invalid FHIR resource")
+
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+// Name returns the text of the concept or the display of its first coding
+// which has one.
+func (c *CodeableConcept) Name() string {
+	if c == nil {
+		return ""
+	}
+	if c.Text != "" {
+		return c.Text
+	}
+	for _, coding := range c.Coding {
+		if coding.Display != "" {
+			return coding.Display
+		}
+	}
+	return ""
+}
+
+type Reference struct {
+	Reference string `json:"reference,omitempty"`
+	Display   string `json:"display,omitempty"`
+}
+
+// Period is a range of dates or date-times, both ends are inclusive.
+type Period struct {
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+}
+
+type Duration struct {
+	Value  float64 `json:"value"`
+	Unit   string  `json:"unit,omitempty"`
+	System string  `json:"system,omitempty"`
+	Code   string  `json:"code,omitempty"`
+}
+
+type Annotation struct {
+	Text string `json:"text"`
+}
+
+type TimingRepeat struct {
+	BoundsDuration *Duration `json:"boundsDuration,omitempty"`
+	BoundsPeriod   *Period   `json:"boundsPeriod,omitempty"`
+	// Count is the total number of takings.
+	Count        int      `json:"count,omitempty"`
+	Frequency    int      `json:"frequency,omitempty"`
+	FrequencyMax int      `json:"frequencyMax,omitempty"`
+	Period       float64  `json:"period,omitempty"`
+	PeriodUnit   string   `json:"periodUnit,omitempty"`
+	DayOfWeek    []string `json:"dayOfWeek,omitempty"`
+	TimeOfDay    []string `json:"timeOfDay,omitempty"`
+	When         []string `json:"when,omitempty"`
+	// Offset is the number of minutes from the When events.
+	Offset int `json:"offset,omitempty"`
+}
+
+type Timing struct {
+	Event  []string         `json:"event,omitempty"`
+	Repeat *TimingRepeat    `json:"repeat,omitempty"`
+	Code   *CodeableConcept `json:"code,omitempty"`
+}
+
+type Dosage struct {
+	Sequence        int     `json:"sequence,omitempty"`
+	Text            string  `json:"text,omitempty"`
+	Timing          *Timing `json:"timing,omitempty"`
+	AsNeededBoolean bool    `json:"asNeededBoolean,omitempty"`
+}
+
+type MedicationRequest struct {
+	ResourceType              string           `json:"resourceType"`
+	ID                        string           `json:"id,omitempty"`
+	Status                    string           `json:"status"`
+	Intent                    string           `json:"intent"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *Reference       `json:"medicationReference,omitempty"`
+	Subject                   Reference        `json:"subject"`
+	AuthoredOn                string           `json:"authoredOn,omitempty"`
+	DosageInstruction         []Dosage         `json:"dosageInstruction,omitempty"`
+}
+
+// MedicationName returns the name of the coded or the referenced medication.
+func (r *MedicationRequest) MedicationName() string {
+	if name := r.MedicationCodeableConcept.Name(); name != "" {
+		return name
+	}
+	if r.MedicationReference != nil {
+		return r.MedicationReference.Display
+	}
+	return ""
+}
+
+type MedicationStatement struct {
+	ResourceType              string           `json:"resourceType"`
+	ID                        string           `json:"id,omitempty"`
+	Status                    string           `json:"status"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept"`
+	Subject                   Reference        `json:"subject"`
+	EffectivePeriod           *Period          `json:"effectivePeriod,omitempty"`
+	DateAsserted              string           `json:"dateAsserted,omitempty"`
+	Dosage                    []Dosage         `json:"dosage,omitempty"`
+}
+
+type MedicationAdministration struct {
+	ResourceType              string           `json:"resourceType"`
+	ID                        string           `json:"id,omitempty"`
+	Status                    string           `json:"status"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept"`
+	Subject                   Reference        `json:"subject"`
+	SupportingInformation     []Reference      `json:"supportingInformation,omitempty"`
+	EffectiveDateTime         string           `json:"effectiveDateTime"`
+	Note                      []Annotation     `json:"note,omitempty"`
+}
+
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	Type         string        `json:"type"`
+	Timestamp    string        `json:"timestamp,omitempty"`
+	Entry        []BundleEntry `json:"entry"`
+}
+
+type BundleEntry struct {
+	Resource any `json:"resource"`
+}
+
+// NewBundle returns a collection bundle of the resources.
+func NewBundle[T any](resources []T, timestamp string) *Bundle {
+	bundle := &Bundle{
+		ResourceType: "Bundle",
+		Type:         "collection",
+		Timestamp:    timestamp,
+		Entry:        make([]BundleEntry, len(resources)),
+	}
+	for i := range resources {
+		bundle.Entry[i] = BundleEntry{Resource: &resources[i]}
+	}
+	return bundle
+}
+
+// DecodeMedicationRequest reads a MedicationRequest in JSON. Errors of the
+// reader are returned as is, the others wrap ErrInvalidResource.
+func DecodeMedicationRequest(r io.Reader) (*MedicationRequest, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var request MedicationRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidResource, err)
+	}
+	if request.ResourceType != "MedicationRequest" {
+		return nil, fmt.Errorf("%w: resource type is %q, want MedicationRequest", ErrInvalidResource, request.ResourceType)
+	}
+	return &request, nil
+}
@@ -0,0 +1,132 @@
+package fhir_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"pills-taking-reminder/pkg/fhir"
+	"strings"
+	"testing"
+	"time"
+)
+
+func decodeSample(t *testing.T, name string) *fhir.MedicationRequest {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to open sample: %v", err)
+	}
+	defer file.Close()
+
+	request, err := fhir.DecodeMedicationRequest(file)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return request
+}
+
+func TestSampleMedicationRequests(t *testing.T) {
+	start := time.Date(2025, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		sample    string
+		name      string
+		times     string
+		frequency int
+		wantStart string
+		wantEnd   string
+	}{
+		{"medication-request-time-of-day.json", "Amoxicillin 250 MG Oral Capsule", "[{8 0} {14 0} {20 0}]", 3, "2025-05-10", "2025-05-17"},
+		{"medication-request-when.json", "Metformin 500 mg", "[{7 45} {18 45}]", 2, "2025-05-11", "2025-06-11"},
+		{"medication-request-frequency.json", "Ibuprofen 400 mg", "[]", 3, "2025-05-10", "2025-05-13"},
+	}
+
+	for _, tt := range tests {
+		request := decodeSample(t, tt.sample)
+		if name := request.MedicationName(); name != tt.name {
+			t.Errorf("%s: got medication %q, want %q", tt.sample, name, tt.name)
+		}
+
+		timing := request.DosageInstruction[0].Timing
+		daily, err := fhir.Daily(timing)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.sample, err)
+			continue
+		}
+		if times := fmt.Sprint(daily.Times); times != tt.times || daily.Frequency != tt.frequency {
+			t.Errorf("%s: got times %s and frequency %d, want %s and %d", tt.sample, times, daily.Frequency, tt.times, tt.frequency)
+		}
+
+		first, end, err := timing.Repeat.Bounds(start, daily.Frequency)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.sample, err)
+			continue
+		}
+		if first.Format(fhir.DateLayout) != tt.wantStart || end == nil || end.Format(fhir.DateLayout) != tt.wantEnd {
+			t.Errorf("%s: got bounds %v - %v, want %s - %s", tt.sample, first, end, tt.wantStart, tt.wantEnd)
+		}
+	}
+}
+
+func TestDailyUnsupported(t *testing.T) {
+	request := decodeSample(t, "medication-request-weekly.json")
+	if _, err := fhir.Daily(request.DosageInstruction[0].Timing); !errors.Is(err, fhir.ErrUnsupportedTiming) {
+		t.Errorf("got error %v, want %v", err, fhir.ErrUnsupportedTiming)
+	}
+
+	for _, repeat := range []fhir.TimingRepeat{
+		{Frequency: 1, Period: 2, PeriodUnit: "d"},
+		{Frequency: 1, Period: 5, PeriodUnit: "h"},
+		{When: []string{"MORN"}, Period: 1, PeriodUnit: "wk"},
+		{When: []string{"BREAKFAST"}},
+	} {
+		if _, err := fhir.Daily(&fhir.Timing{Repeat: &repeat}); !errors.Is(err, fhir.ErrUnsupportedTiming) {
+			t.Errorf("%+v: got error %v, want %v", repeat, err, fhir.ErrUnsupportedTiming)
+		}
+	}
+}
+
+func TestDailyWhen(t *testing.T) {
+	daily, err := fhir.Daily(&fhir.Timing{Repeat: &fhir.TimingRepeat{When: []string{"PC", "HS"}, Offset: 60}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if times := fmt.Sprint(daily.Times); times != "[{9 0} {14 0} {20 0} {21 0}]" {
+		t.Errorf("got times %s", times)
+	}
+}
+
+func TestDecodeMedicationRequestInvalid(t *testing.T) {
+	for _, data := range []string{
+		`{"resourceType": "Patient", "id": "1"}`,
+		`{"resourceType": "MedicationRequest", "dosageInstruction": {}}`,
+		`not json`,
+	} {
+		if _, err := fhir.DecodeMedicationRequest(strings.NewReader(data)); !errors.Is(err, fhir.ErrInvalidResource) {
+			t.Errorf("%s: got error %v, want %v", data, err, fhir.ErrInvalidResource)
+		}
+	}
+}
+
+func TestNewBundle(t *testing.T) {
+	statements := []fhir.MedicationStatement{{
+		ResourceType:              "MedicationStatement",
+		Status:                    "active",
+		MedicationCodeableConcept: &fhir.CodeableConcept{Text: "Aspirin"},
+		Subject:                   fhir.Reference{Reference: "Patient/1"},
+	}}
+
+	data, err := json.Marshal(fhir.NewBundle(statements, "2025-05-11T08:00:00Z"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `{"resourceType":"Bundle","type":"collection","timestamp":"2025-05-11T08:00:00Z","entry":[` +
+		`{"resource":{"resourceType":"MedicationStatement","status":"active",` +
+		`"medicationCodeableConcept":{"text":"Aspirin"},"subject":{"reference":"Patient/1"}}}]}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+}
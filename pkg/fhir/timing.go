@@ -0,0 +1,246 @@
+package fhir
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+var ErrUnsupportedTiming = errors.New("timing does not repeat every day")
+
+const minutesPerDay = 24 * 60
+
+// Times of the day of the When events, in minutes from midnight. The event
+// codes are from the HL7 v3 TimingEvent and the FHIR event-timing systems.
+var eventTimes = map[string]int{
+	"WAKE":       7 * 60,
+	"MORN.early": 6 * 60,
+	"MORN":       8 * 60,
+	"MORN.late":  10 * 60,
+	"NOON":       12 * 60,
+	"AFT.early":  13 * 60,
+	"AFT":        14 * 60,
+	"AFT.late":   16 * 60,
+	"EVE.early":  18 * 60,
+	"EVE":        19 * 60,
+	"EVE.late":   20 * 60,
+	"NIGHT":      22 * 60,
+	"HS":         22 * 60,
+	"PHS":        7 * 60,
+	"CM":         8 * 60,
+	"CD":         13 * 60,
+	"CV":         19 * 60,
+}
+
+// Meal events are taken before (AC) or after (PC) the meal by the offset or
+// by half an hour when the offset is not set.
+var mealEvents = map[string]string{
+	"ACM": "CM", "ACD": "CD", "ACV": "CV",
+	"PCM": "CM", "PCD": "CD", "PCV": "CV",
+}
+
+// Events of any meal stand for all three of them.
+var allMeals = map[string][]string{
+	"C":  {"CM", "CD", "CV"},
+	"AC": {"ACM", "ACD", "ACV"},
+	"PC": {"PCM", "PCD", "PCV"},
+}
+
+const defaultMealOffset = 30
+
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+// DailyTiming is a timing repeating every day.
+type DailyTiming struct {
+	// Times are the times of the day, they are empty when the timing only
+	// tells how many times a day.
+	Times []TimeOfDay
+	// Frequency is the number of takings a day.
+	Frequency int
+}
+
+// Daily maps the timing to the times of the day. The timing has to repeat
+// every day: timeOfDay or when events taken daily, or a frequency per day or
+// per a number of hours which divides the day.
+func Daily(timing *Timing) (DailyTiming, error) {
+	if timing == nil || timing.Repeat == nil {
+		return DailyTiming{}, fmt.Errorf("%w: timing has no repeat", ErrUnsupportedTiming)
+	}
+	if len(timing.Event) > 0 {
+		return DailyTiming{}, fmt.Errorf("%w: timing has event dates", ErrUnsupportedTiming)
+	}
+	repeat := timing.Repeat
+	if len(repeat.DayOfWeek) > 0 {
+		return DailyTiming{}, fmt.Errorf("%w: timing repeats on days of week", ErrUnsupportedTiming)
+	}
+	if len(repeat.TimeOfDay) > 0 && len(repeat.When) > 0 {
+		return DailyTiming{}, fmt.Errorf("%w: timing has both timeOfDay and when", ErrInvalidResource)
+	}
+
+	if len(repeat.TimeOfDay) > 0 || len(repeat.When) > 0 {
+		if repeat.PeriodUnit != "" && (repeat.PeriodUnit != "d" || repeat.Period != 1) {
+			return DailyTiming{}, fmt.Errorf("%w: times of the day repeat every %v %s",
+				ErrUnsupportedTiming, repeat.Period, repeat.PeriodUnit)
+		}
+
+		var minutes []int
+		var err error
+		if len(repeat.TimeOfDay) > 0 {
+			minutes, err = timeOfDayMinutes(repeat.TimeOfDay)
+		} else {
+			minutes, err = whenMinutes(repeat.When, repeat.Offset)
+		}
+		if err != nil {
+			return DailyTiming{}, err
+		}
+
+		slices.Sort(minutes)
+		minutes = slices.Compact(minutes)
+		times := make([]TimeOfDay, len(minutes))
+		for i, minute := range minutes {
+			times[i] = TimeOfDay{Hour: minute / 60, Minute: minute % 60}
+		}
+		return DailyTiming{Times: times, Frequency: len(times)}, nil
+	}
+
+	frequency := repeat.Frequency
+	if frequency == 0 {
+		frequency = 1
+	}
+	switch {
+	case repeat.PeriodUnit == "d" && repeat.Period == 1:
+		return DailyTiming{Frequency: frequency}, nil
+	case repeat.PeriodUnit == "h" && repeat.Period >= 1 && repeat.Period == math.Trunc(repeat.Period) &&
+		24%int(repeat.Period) == 0:
+		return DailyTiming{Frequency: frequency * 24 / int(repeat.Period)}, nil
+	default:
+		return DailyTiming{}, fmt.Errorf("%w: timing repeats every %v %s",
+			ErrUnsupportedTiming, repeat.Period, repeat.PeriodUnit)
+	}
+}
+
+// Bounds returns the first day of the timing and the day after its last one,
+// the end is nil for a timing without bounds. The start is used when the
+// bounds do not set one, the dates are in its location. Count bounds need the
+// number of takings a day.
+func (r *TimingRepeat) Bounds(start time.Time, perDay int) (time.Time, *time.Time, error) {
+	loc := start.Location()
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+
+	switch {
+	case r.BoundsPeriod != nil:
+		if r.BoundsPeriod.Start != "" {
+			day, err := ParseDate(r.BoundsPeriod.Start, loc)
+			if err != nil {
+				return time.Time{}, nil, err
+			}
+			start = day
+		}
+		if r.BoundsPeriod.End == "" {
+			return start, nil, nil
+		}
+		last, err := ParseDate(r.BoundsPeriod.End, loc)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		// The end of a period is inclusive.
+		end := last.AddDate(0, 0, 1)
+		return start, &end, nil
+	case r.BoundsDuration != nil:
+		value := r.BoundsDuration.Value
+		if value <= 0 || value != math.Trunc(value) {
+			return time.Time{}, nil, fmt.Errorf("%w: bounds duration %v is not a whole number", ErrInvalidResource, value)
+		}
+		unit := r.BoundsDuration.Code
+		if unit == "" {
+			unit = r.BoundsDuration.Unit
+		}
+		var end time.Time
+		switch unit {
+		case "d", "day", "days":
+			end = start.AddDate(0, 0, int(value))
+		case "wk", "week", "weeks":
+			end = start.AddDate(0, 0, 7*int(value))
+		case "mo", "month", "months":
+			end = start.AddDate(0, int(value), 0)
+		default:
+			return time.Time{}, nil, fmt.Errorf("%w: bounds duration unit %q", ErrUnsupportedTiming, unit)
+		}
+		return start, &end, nil
+	case r.Count > 0 && perDay > 0:
+		end := start.AddDate(0, 0, (r.Count+perDay-1)/perDay)
+		return start, &end, nil
+	default:
+		return start, nil, nil
+	}
+}
+
+// ParseDate parses a FHIR date or dateTime and returns the start of its day in
+// the location.
+func ParseDate(value string, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(DateLayout, value, loc)
+	if err != nil {
+		t, err = time.Parse(DateTimeLayout, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: invalid date %q", ErrInvalidResource, value)
+		}
+		t = t.In(loc)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc), nil
+}
+
+func timeOfDayMinutes(values []string) ([]int, error) {
+	minutes := make([]int, 0, len(values))
+	for _, value := range values {
+		t, err := time.Parse(TimeLayout, value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid time of day %q", ErrInvalidResource, value)
+		}
+		minutes = append(minutes, t.Hour()*60+t.Minute())
+	}
+	return minutes, nil
+}
+
+func whenMinutes(events []string, offset int) ([]int, error) {
+	var expanded []string
+	for _, event := range events {
+		if meals, ok := allMeals[event]; ok {
+			expanded = append(expanded, meals...)
+			continue
+		}
+		expanded = append(expanded, event)
+	}
+
+	minutes := make([]int, 0, len(expanded))
+	for _, event := range expanded {
+		var minute int
+		if meal, ok := mealEvents[event]; ok {
+			mealOffset := offset
+			if mealOffset == 0 {
+				mealOffset = defaultMealOffset
+			}
+			if event[0] == 'A' {
+				mealOffset = -mealOffset
+			}
+			minute = eventTimes[meal] + mealOffset
+		} else {
+			eventTime, ok := eventTimes[event]
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown event %q", ErrUnsupportedTiming, event)
+			}
+			// Hour of sleep is the only event taken before.
+			if event == "HS" {
+				minute = eventTime - offset
+			} else {
+				minute = eventTime + offset
+			}
+		}
+		minutes = append(minutes, (minute%minutesPerDay+minutesPerDay)%minutesPerDay)
+	}
+	return minutes, nil
+}
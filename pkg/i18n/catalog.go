@@ -0,0 +1,137 @@
+package i18n
+
+import "fmt"
+
+// catalogs translate the English messages, which are the keys. Messages
+// missing from a catalog are returned as they are.
+var catalogs = map[Locale]map[string]string{
+	Ru: ru,
+}
+
+// T returns the message in the locale.
+func T(locale Locale, message string) string {
+	if translated, ok := catalogs[locale][message]; ok {
+		return translated
+	}
+	return message
+}
+
+// Tf formats the arguments with the message in the locale.
+func Tf(locale Locale, message string, args ...any) string {
+	return fmt.Sprintf(T(locale, message), args...)
+}
+
+var ru = map[string]string{
+	// Reasons of the HTTP statuses, the titles of the problems.
+	"Bad Request":              "Некорректный запрос",
+	"Unauthorized":             "Требуется аутентификация",
+	"Forbidden":                "Доступ запрещён",
+	"Not Found":                "Не найдено",
+	"Conflict":                 "Конфликт",
+	"Precondition Failed":      "Условие не выполнено",
+	"Request Entity Too Large": "Слишком большой объём данных",
+	"Unprocessable Entity":     "Запрос не может быть обработан",
+	"Precondition Required":    "Требуется условие",
+	"Internal Server Error":    "Внутренняя ошибка сервера",
+
+	"Invalid input parameters":   "Некорректные параметры",
+	"Invalid request parameters": "Некорректные параметры запроса",
+	"Invalid request format":     "Некорректный формат запроса",
+	"Invalid request body":       "Некорректное тело запроса",
+	"Permission denied":          "Недостаточно прав",
+	"Unauthenticated":            "Требуется аутентификация",
+	"Insufficient scope":         "Ключ API не даёт доступа к этому методу",
+	"Internal server error":      "Внутренняя ошибка сервера",
+
+	"Schedule was not found":                           "Расписание не найдено",
+	"Medicine was not found":                           "Лекарство не найдено",
+	"Schedule already exists":                          "Расписание уже существует",
+	"Medicine is contraindicated with another one":     "Лекарство несовместимо с другим принимаемым лекарством",
+	"Daily dose exceeds the limit":                     "Суточная доза превышает допустимую",
+	"Taking times can't be kept apart":                 "Приёмы нельзя разнести по времени",
+	"Dose limit was not found":                         "Предел суточной дозы не найден",
+	"Schedule was modified since the given version":    "Расписание изменилось после указанной версии",
+	"Schedule was modified during the import":          "Расписание изменилось во время загрузки",
+	"Schedule version is required":                     "Требуется версия расписания",
+	"Taking is not planned at this time":               "Приём на это время не запланирован",
+	"Taking is already closed":                         "Приём уже завершён",
+	"Snooze limit reached, taking marked as missed":    "Достигнут предел откладываний, приём отмечен пропущенным",
+	"Intake already recorded":                          "Приём уже записан",
+	"Pack was not found":                               "Упаковка не найдена",
+	"Caregiver link was not found":                     "Связь с опекуном не найдена",
+	"Caregiver already linked":                         "Опекун уже добавлен",
+	"Calendar feed was not found":                      "Календарь не найден",
+	"API key was not found":                            "Ключ API не найден",
+	"Dosage can not be mapped to a daily schedule":     "Дозировку нельзя преобразовать в ежедневное расписание",
+	"Resource is too large":                            "Ресурс слишком большой",
+	"Export is too large":                              "Выгрузка слишком большая",
+	"Calendar is too large":                            "Календарь слишком большой",
+	"Idempotency key is too long":                      "Ключ идемпотентности слишком длинный",
+	"Request with this idempotency key is in progress": "Запрос с этим ключом идемпотентности ещё выполняется",
+	"Idempotency key was used for another request":     "Ключ идемпотентности уже использован для другого запроса",
+
+	"Failed to create schedule":                   "Не удалось создать расписание",
+	"Failed to create schedules":                  "Не удалось создать расписания",
+	"Failed to get schedule":                      "Не удалось получить расписание",
+	"Failed to get schedule IDs":                  "Не удалось получить список расписаний",
+	"Failed to get next takings":                  "Не удалось получить ближайшие приёмы",
+	"Failed to update schedule":                   "Не удалось изменить расписание",
+	"Failed to pause schedule":                    "Не удалось приостановить расписание",
+	"Failed to list schedules":                    "Не удалось получить список расписаний",
+	"Failed to get schedule history":              "Не удалось получить историю расписания",
+	"Failed to record refill":                     "Не удалось записать пополнение",
+	"Failed to get inventory":                     "Не удалось получить запас",
+	"Failed to snooze taking":                     "Не удалось отложить приём",
+	"Failed to record intake":                     "Не удалось записать приём",
+	"Failed to set escalation rule":               "Не удалось сохранить правило эскалации",
+	"Failed to get escalation rule":               "Не удалось получить правило эскалации",
+	"Failed to link caregiver":                    "Не удалось добавить опекуна",
+	"Failed to update caregiver":                  "Не удалось изменить права опекуна",
+	"Failed to unlink caregiver":                  "Не удалось удалить опекуна",
+	"Failed to get caregivers":                    "Не удалось получить опекунов",
+	"Failed to get dependants":                    "Не удалось получить подопечных",
+	"Failed to create api key":                    "Не удалось создать ключ API",
+	"Failed to get api keys":                      "Не удалось получить ключи API",
+	"Failed to revoke api key":                    "Не удалось отозвать ключ API",
+	"Failed to set role":                          "Не удалось назначить роль",
+	"Failed to get role":                          "Не удалось получить роль",
+	"Failed to get calendar":                      "Не удалось получить календарь",
+	"Failed to create calendar feed":              "Не удалось создать календарь",
+	"Failed to get calendar feed":                 "Не удалось получить календарь",
+	"Failed to revoke calendar feed":              "Не удалось отозвать календарь",
+	"Failed to export schedules":                  "Не удалось выгрузить расписания",
+	"Failed to import schedules":                  "Не удалось загрузить расписания",
+	"Failed to export user data":                  "Не удалось выгрузить данные",
+	"Failed to import user data":                  "Не удалось загрузить данные",
+	"Failed to import medication request":         "Не удалось загрузить назначение",
+	"Failed to export medication statements":      "Не удалось выгрузить сведения о лекарствах",
+	"Failed to export medication administrations": "Не удалось выгрузить историю приёмов",
+	"Failed to set profile":                       "Не удалось сохранить профиль",
+	"Failed to get profile":                       "Не удалось получить профиль",
+	"Failed to search medicines":                  "Не удалось найти лекарства",
+	"Failed to set dose limit":                    "Не удалось сохранить предел суточной дозы",
+	"Failed to get dose limits":                   "Не удалось получить пределы суточной дозы",
+	"Failed to delete dose limit":                 "Не удалось удалить предел суточной дозы",
+
+	// Invalid fields.
+	"is required":         "обязательное поле",
+	"has invalid format":  "неверный формат",
+	"must be at least %s": "должно быть не меньше %s",
+	"must be at most %s":  "должно быть не больше %s",
+	"must be one of %s":   "должно быть одним из %s",
+	"failed the %s rule":  "не прошло проверку %s",
+
+	// Schedules.
+	"infinite": "бессрочно",
+	"Taking times can't be kept %g h apart from %s between %02d:00 and %02d:00": "Приёмы нельзя разнести на %g ч от %s между %02d:00 и %02d:00",
+
+	// Notifications.
+	"Time to take %s":                   "Пора принять %s",
+	"%s is running low, time to refill": "%s скоро закончится, пора пополнить запас",
+	"You missed %s planned at %s":       "Вы пропустили приём %s, запланированный на %s",
+	"User %d missed %s planned at %s":   "Пользователь %d пропустил приём %s, запланированный на %s",
+
+	// Calendar.
+	"Medicines": "Лекарства",
+	"Take %s":   "Принять %s",
+}
@@ -0,0 +1,28 @@
+package i18n
+
+import (
+	"fmt"
+	"time"
+)
+
+// ruMonths are the Russian month names in the genitive case, as dates use
+// them.
+var ruMonths = [...]string{
+	"января", "февраля", "марта", "апреля", "мая", "июня",
+	"июля", "августа", "сентября", "октября", "ноября", "декабря",
+}
+
+// FormatDate formats the date like 02 Jan 2006 in English.
+func FormatDate(locale Locale, t time.Time) string {
+	switch locale {
+	case Ru:
+		return fmt.Sprintf("%02d %s %d", t.Day(), ruMonths[t.Month()-1], t.Year())
+	default:
+		return t.Format("02 Jan 2006")
+	}
+}
+
+// FormatDateTime formats the date like FormatDate followed by the time.
+func FormatDateTime(locale Locale, t time.Time) string {
+	return FormatDate(locale, t) + " " + t.Format("15:04")
+}
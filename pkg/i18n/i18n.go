@@ -0,0 +1,76 @@
+// Package i18n translates the messages of the API and the reminders and
+// formats dates for the locale of the user.
+package i18n
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type Locale string
+
+const (
+	En Locale = "en"
+	Ru Locale = "ru"
+
+	// Default is the locale of the requests which do not select one.
+	Default = En
+)
+
+// Supported lists the locales having a message catalog.
+var Supported = []Locale{En, Ru}
+
+// Parse returns the supported locale of the language tag, like ru or ru-RU.
+func Parse(tag string) (Locale, bool) {
+	language, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+	locale := Locale(strings.ToLower(language))
+	if !slices.Contains(Supported, locale) {
+		return "", false
+	}
+	return locale, true
+}
+
+// Match returns the supported locale the Accept-Language header prefers.
+func Match(acceptLanguage string) (Locale, bool) {
+	best, bestQuality := Locale(""), 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		locale, ok := Parse(tag)
+		if ok && quality > bestQuality {
+			best, bestQuality = locale, quality
+		}
+	}
+	return best, best != ""
+}
+
+type contextKey struct{}
+
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext returns the locale of the request, Default when none was
+// selected.
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := ctx.Value(contextKey{}).(Locale); ok {
+		return locale
+	}
+	return Default
+}
+
+// Selected reports whether the locale of the request was selected.
+func Selected(ctx context.Context) bool {
+	_, ok := ctx.Value(contextKey{}).(Locale)
+	return ok
+}
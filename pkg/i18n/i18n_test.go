@@ -0,0 +1,73 @@
+package i18n_test
+
+import (
+	"context"
+	"pills-taking-reminder/pkg/i18n"
+	"testing"
+	"time"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		header string
+		want   i18n.Locale
+		wantOK bool
+	}{
+		{"ru", i18n.Ru, true},
+		{"ru-RU,ru;q=0.9,en-US;q=0.8,en;q=0.7", i18n.Ru, true},
+		{"de-DE, en;q=0.5, ru;q=0.8", i18n.Ru, true},
+		{"EN-gb", i18n.En, true},
+		{"ru;q=0, en;q=0.1", i18n.En, true},
+		{"de, fr;q=0.9", "", false},
+		{"*", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := i18n.Match(tt.header)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("Match(%q) = %q, %v, want %q, %v", tt.header, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	ctx := context.Background()
+	if locale := i18n.FromContext(ctx); locale != i18n.Default || i18n.Selected(ctx) {
+		t.Errorf("expected the default locale, got %q", locale)
+	}
+
+	ctx = i18n.WithLocale(ctx, i18n.Ru)
+	if locale := i18n.FromContext(ctx); locale != i18n.Ru || !i18n.Selected(ctx) {
+		t.Errorf("expected %q, got %q", i18n.Ru, locale)
+	}
+}
+
+func TestT(t *testing.T) {
+	if got := i18n.T(i18n.Ru, "Schedule was not found"); got != "Расписание не найдено" {
+		t.Errorf("unexpected translation: %q", got)
+	}
+	if got := i18n.T(i18n.En, "Schedule was not found"); got != "Schedule was not found" {
+		t.Errorf("unexpected translation: %q", got)
+	}
+	if got := i18n.T(i18n.Ru, "Unknown message"); got != "Unknown message" {
+		t.Errorf("expected a missing message to be kept, got %q", got)
+	}
+	if got := i18n.Tf(i18n.Ru, "Time to take %s", "Аспирин"); got != "Пора принять Аспирин" {
+		t.Errorf("unexpected translation: %q", got)
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	date := time.Date(2025, time.May, 1, 8, 30, 0, 0, time.UTC)
+
+	if got := i18n.FormatDate(i18n.En, date); got != "01 May 2025" {
+		t.Errorf("unexpected English date: %q", got)
+	}
+	if got := i18n.FormatDate(i18n.Ru, date); got != "01 мая 2025" {
+		t.Errorf("unexpected Russian date: %q", got)
+	}
+	if got := i18n.FormatDateTime(i18n.Ru, date); got != "01 мая 2025 08:30" {
+		t.Errorf("unexpected Russian date and time: %q", got)
+	}
+}
@@ -0,0 +1,230 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformed   = errors.New("malformed iCalendar data")
+	ErrInvalidRule = errors.New("invalid recurrence rule")
+)
+
+// maxLineLength bounds an unfolded content line.
+const maxLineLength = 64 * 1024
+
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+// Decode reads the first component of the stream, usually VCALENDAR.
+// Property and component names are upper-cased, values are kept as is. Errors
+// of invalid data wrap ErrMalformed, errors of the reader are returned as is.
+func Decode(r io.Reader) (*Component, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var stack []*Component
+	for i, line := range lines {
+		property, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrMalformed, i+1, err)
+		}
+
+		switch property.Name {
+		case "BEGIN":
+			stack = append(stack, NewComponent(strings.ToUpper(property.Value)))
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(property.Value) {
+				return nil, fmt.Errorf("%w: line %d: unexpected END:%s", ErrMalformed, i+1, property.Value)
+			}
+			component := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return component, nil
+			}
+			stack[len(stack)-1].AddComponent(component)
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("%w: line %d: property outside of a component", ErrMalformed, i+1)
+			}
+			component := stack[len(stack)-1]
+			component.Properties = append(component.Properties, property)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unexpected end of data", ErrMalformed)
+}
+
+// unfold joins continuation lines, which start with a space or a tab.
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 4096), maxLineLength)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+		}
+		return nil, err
+	}
+	return lines, nil
+}
+
+// parseLine splits "NAME;PARAM=value;PARAM="quoted":value".
+func parseLine(line string) (Property, error) {
+	var property Property
+
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 {
+		return property, errors.New("no property name")
+	}
+	property.Name = strings.ToUpper(line[:i])
+
+	for line[i] == ';' {
+		rest := line[i+1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return property, errors.New("parameter without a value")
+		}
+		name := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return property, errors.New("unterminated quoted parameter")
+			}
+			value = rest[1 : end+1]
+			rest = rest[end+2:]
+		} else {
+			end := strings.IndexAny(rest, ";:")
+			if end < 0 {
+				return property, errors.New("no property value")
+			}
+			value = rest[:end]
+			rest = rest[end:]
+		}
+		if property.Params == nil {
+			property.Params = make(map[string]string)
+		}
+		property.Params[name] = value
+
+		i = len(line) - len(rest)
+		if i >= len(line) {
+			return property, errors.New("no property value")
+		}
+	}
+
+	property.Value = line[i+1:]
+	return property, nil
+}
+
+func UnescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
+
+// ParseDateTime parses a DATE or DATE-TIME property. UTC values are converted
+// to loc, values with a TZID are converted from that zone when it is known,
+// floating values are taken in loc. dateOnly is set for DATE values.
+func ParseDateTime(property Property, loc *time.Location) (t time.Time, dateOnly bool, err error) {
+	value := property.Value
+	if property.Params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		t, err = time.ParseInLocation("20060102", value, loc)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err = time.Parse(utcDateTimeLayout, value)
+		return t.In(loc), false, err
+	}
+
+	zone := loc
+	if tzid, ok := property.Params["TZID"]; ok {
+		if known, err := time.LoadLocation(tzid); err == nil {
+			zone = known
+		}
+	}
+	t, err = time.ParseInLocation(dateTimeLayout, value, zone)
+	return t.In(loc), false, err
+}
+
+// Rule is a parsed RRULE value.
+type Rule struct {
+	Freq     string
+	Interval int
+	Count    int
+	// Until is the raw DATE or DATE-TIME value.
+	Until    string
+	ByHour   []int
+	ByMinute []int
+	// Other keeps the remaining parts, like BYDAY=MO.
+	Other []string
+}
+
+func ParseRule(value string) (Rule, error) {
+	rule := Rule{Interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		name, partValue, ok := strings.Cut(part, "=")
+		if !ok {
+			return rule, fmt.Errorf("%w: %q", ErrInvalidRule, part)
+		}
+
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			rule.Freq = strings.ToUpper(partValue)
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(partValue)
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(partValue)
+		case "UNTIL":
+			rule.Until = partValue
+		case "BYHOUR":
+			rule.ByHour, err = parseInts(partValue)
+		case "BYMINUTE":
+			rule.ByMinute, err = parseInts(partValue)
+		case "WKST":
+			// The week start does not matter without weekly rules.
+		default:
+			rule.Other = append(rule.Other, part)
+		}
+		if err != nil {
+			return rule, fmt.Errorf("%w: %q", ErrInvalidRule, part)
+		}
+	}
+
+	if rule.Freq == "" || rule.Interval < 1 || rule.Count < 0 {
+		return rule, fmt.Errorf("%w: %q", ErrInvalidRule, value)
+	}
+	return rule, nil
+}
+
+func parseInts(value string) ([]int, error) {
+	var ints []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, err
+		}
+		ints = append(ints, n)
+	}
+	return ints, nil
+}
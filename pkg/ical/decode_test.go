@@ -0,0 +1,119 @@
+package ical_test
+
+import (
+	"bytes"
+	"errors"
+	"pills-taking-reminder/pkg/ical"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeRoundTrip(t *testing.T) {
+	summary := strings.Repeat("Аскорбиновая кислота; ", 6)
+	calendar := ical.NewComponent("VCALENDAR").
+		Add("VERSION", "2.0").
+		AddComponent(ical.NewComponent("VEVENT").AddText("SUMMARY", summary))
+
+	var buf bytes.Buffer
+	if err := ical.Encode(&buf, calendar); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	decoded, err := ical.Decode(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	events := decoded.Children("VEVENT")
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	property, ok := events[0].Get("SUMMARY")
+	if !ok || ical.UnescapeText(property.Value) != summary {
+		t.Errorf("got summary %q, want %q", property.Value, summary)
+	}
+}
+
+func TestDecodeParams(t *testing.T) {
+	data := "BEGIN:VCALENDAR\n" +
+		"BEGIN:VEVENT\n" +
+		"DTSTART;TZID=\"Europe/Moscow\";VALUE=DATE-TIME:20250511T080000\n" +
+		"summary:Aspirin\n" +
+		"END:VEVENT\n" +
+		"END:VCALENDAR\n"
+
+	calendar, err := ical.Decode(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	event := calendar.Children("VEVENT")[0]
+
+	start, ok := event.Get("DTSTART")
+	if !ok || start.Params["TZID"] != "Europe/Moscow" || start.Params["VALUE"] != "DATE-TIME" || start.Value != "20250511T080000" {
+		t.Errorf("unexpected DTSTART: %+v", start)
+	}
+	if _, ok := event.Get("SUMMARY"); !ok {
+		t.Error("expected property names to be case-insensitive")
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	for _, data := range []string{
+		"BEGIN:VCALENDAR\nBEGIN:VEVENT\nEND:VCALENDAR\n",
+		"BEGIN:VCALENDAR\nVERSION:2.0\n",
+		"VERSION:2.0\n",
+		"BEGIN:VCALENDAR\nno colon\nEND:VCALENDAR\n",
+	} {
+		if _, err := ical.Decode(strings.NewReader(data)); !errors.Is(err, ical.ErrMalformed) {
+			t.Errorf("Decode(%q): got error %v, want %v", data, err, ical.ErrMalformed)
+		}
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Skipf("no time zone data: %v", err)
+	}
+
+	tests := []struct {
+		property     ical.Property
+		want         time.Time
+		wantDateOnly bool
+	}{
+		{ical.Property{Value: "20250511T080000"}, time.Date(2025, 5, 11, 8, 0, 0, 0, moscow), false},
+		{ical.Property{Value: "20250511T050000Z"}, time.Date(2025, 5, 11, 8, 0, 0, 0, moscow), false},
+		{ical.Property{Value: "20250511T070000", Params: map[string]string{"TZID": "Europe/Berlin"}}, time.Date(2025, 5, 11, 8, 0, 0, 0, moscow), false},
+		{ical.Property{Value: "20250511", Params: map[string]string{"VALUE": "DATE"}}, time.Date(2025, 5, 11, 0, 0, 0, 0, moscow), true},
+	}
+
+	for _, tt := range tests {
+		got, dateOnly, err := ical.ParseDateTime(tt.property, moscow)
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", tt.property, err)
+			continue
+		}
+		if !got.Equal(tt.want) || dateOnly != tt.wantDateOnly {
+			t.Errorf("%+v: got %v (date only %t), want %v", tt.property, got, dateOnly, tt.want)
+		}
+	}
+}
+
+func TestParseRule(t *testing.T) {
+	rule, err := ical.ParseRule("FREQ=DAILY;COUNT=10;BYHOUR=8,20;BYMINUTE=30;BYDAY=MO;WKST=MO")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rule.Freq != "DAILY" || rule.Interval != 1 || rule.Count != 10 ||
+		!slices.Equal(rule.ByHour, []int{8, 20}) || !slices.Equal(rule.ByMinute, []int{30}) ||
+		!slices.Equal(rule.Other, []string{"BYDAY=MO"}) {
+		t.Errorf("unexpected rule: %+v", rule)
+	}
+
+	for _, value := range []string{"COUNT=3", "FREQ=DAILY;INTERVAL=0", "FREQ=DAILY;BYHOUR=eight", "FREQ"} {
+		if _, err := ical.ParseRule(value); !errors.Is(err, ical.ErrInvalidRule) {
+			t.Errorf("ParseRule(%q): got error %v, want %v", value, err, ical.ErrInvalidRule)
+		}
+	}
+}
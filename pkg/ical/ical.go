@@ -0,0 +1,191 @@
+// Package ical reads and writes iCalendar (RFC 5545) objects.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the line length limit without the CRLF.
+const maxLineOctets = 75
+
+const (
+	dateTimeLayout    = "20060102T150405"
+	utcDateTimeLayout = "20060102T150405Z"
+)
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+type Property struct {
+	Name   string
+	Params map[string]string
+	// Value is written as is, text values need EscapeText.
+	Value string
+}
+
+// Component is a calendar object like VCALENDAR, VEVENT or VALARM.
+type Component struct {
+	Name       string
+	Properties []Property
+	Components []*Component
+}
+
+func NewComponent(name string) *Component {
+	return &Component{Name: name}
+}
+
+// Add appends a property without parameters.
+func (c *Component) Add(name, value string) *Component {
+	c.Properties = append(c.Properties, Property{Name: name, Value: value})
+	return c
+}
+
+// AddText appends a property with an escaped text value.
+func (c *Component) AddText(name, value string) *Component {
+	return c.Add(name, EscapeText(value))
+}
+
+func (c *Component) AddComponent(child *Component) *Component {
+	c.Components = append(c.Components, child)
+	return c
+}
+
+// Get returns the first property with the name.
+func (c *Component) Get(name string) (Property, bool) {
+	for _, property := range c.Properties {
+		if property.Name == name {
+			return property, true
+		}
+	}
+	return Property{}, false
+}
+
+// Children returns the subcomponents with the name.
+func (c *Component) Children(name string) []*Component {
+	var children []*Component
+	for _, child := range c.Components {
+		if child.Name == name {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// Encode writes the component with its subcomponents, folding long lines.
+func Encode(w io.Writer, c *Component) error {
+	bw := bufio.NewWriter(w)
+	if err := encode(bw, c); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func encode(w *bufio.Writer, c *Component) error {
+	if err := writeLine(w, "BEGIN:"+c.Name); err != nil {
+		return err
+	}
+	for _, property := range c.Properties {
+		if err := writeLine(w, contentLine(property)); err != nil {
+			return err
+		}
+	}
+	for _, child := range c.Components {
+		if err := encode(w, child); err != nil {
+			return err
+		}
+	}
+	return writeLine(w, "END:"+c.Name)
+}
+
+func contentLine(property Property) string {
+	var line strings.Builder
+	line.WriteString(property.Name)
+
+	names := make([]string, 0, len(property.Params))
+	for name := range property.Params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := property.Params[name]
+		if strings.ContainsAny(value, ";:,") {
+			value = `"` + value + `"`
+		}
+		fmt.Fprintf(&line, ";%s=%s", name, value)
+	}
+
+	line.WriteString(":")
+	line.WriteString(property.Value)
+	return line.String()
+}
+
+// writeLine folds the line into chunks of at most 75 octets without splitting
+// UTF-8 characters. Continuation lines start with a space.
+func writeLine(w *bufio.Writer, line string) error {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		if _, err := w.WriteString(line[:cut] + "\r\n "); err != nil {
+			return err
+		}
+		line = line[cut:]
+		// The leading space takes one octet of the continuation line.
+		limit = maxLineOctets - 1
+	}
+	_, err := w.WriteString(line + "\r\n")
+	return err
+}
+
+func EscapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// FormatDateTime formats the wall clock of t as a floating date-time, which
+// calendar apps show in the local time zone of the device.
+func FormatDateTime(t time.Time) string {
+	return t.Format(dateTimeLayout)
+}
+
+func FormatUTC(t time.Time) string {
+	return t.UTC().Format(utcDateTimeLayout)
+}
+
+// FormatDuration formats d as a duration value like -PT15M.
+func FormatDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	var value strings.Builder
+	value.WriteString(sign + "P")
+	if days := d / (24 * time.Hour); days > 0 {
+		fmt.Fprintf(&value, "%dD", days)
+		d -= days * 24 * time.Hour
+	}
+	if d == 0 && value.Len() > len(sign)+1 {
+		return value.String()
+	}
+
+	value.WriteString("T")
+	hours, minutes, seconds := d/time.Hour, d%time.Hour/time.Minute, d%time.Minute/time.Second
+	if hours > 0 {
+		fmt.Fprintf(&value, "%dH", hours)
+	}
+	if minutes > 0 {
+		fmt.Fprintf(&value, "%dM", minutes)
+	}
+	if seconds > 0 || (hours == 0 && minutes == 0) {
+		fmt.Fprintf(&value, "%dS", seconds)
+	}
+	return value.String()
+}
@@ -0,0 +1,83 @@
+package ical_test
+
+import (
+	"bytes"
+	"pills-taking-reminder/pkg/ical"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestEncode(t *testing.T) {
+	event := ical.NewComponent("VEVENT").
+		Add("DTSTART", ical.FormatDateTime(time.Date(2025, 5, 11, 8, 0, 0, 0, time.UTC))).
+		AddText("SUMMARY", "Aspirin; 100 mg, after meals").
+		AddComponent(ical.NewComponent("VALARM").Add("TRIGGER", ical.FormatDuration(-15*time.Minute)))
+	calendar := ical.NewComponent("VCALENDAR").Add("VERSION", "2.0").AddComponent(event)
+
+	var buf bytes.Buffer
+	if err := ical.Encode(&buf, calendar); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART:20250511T080000\r\n" +
+		"SUMMARY:Aspirin\\; 100 mg\\, after meals\r\n" +
+		"BEGIN:VALARM\r\n" +
+		"TRIGGER:-PT15M\r\n" +
+		"END:VALARM\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	if buf.String() != want {
+		t.Errorf("got\n%q\nwant\n%q", buf.String(), want)
+	}
+}
+
+func TestEncodeFoldsLongLines(t *testing.T) {
+	summary := strings.Repeat("Аскорбиновая кислота ", 10)
+	var buf bytes.Buffer
+	if err := ical.Encode(&buf, ical.NewComponent("VEVENT").AddText("SUMMARY", summary)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	var unfolded strings.Builder
+	for _, line := range lines {
+		if len(line) > 75 {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("line splits a character: %q", line)
+		}
+		if rest, ok := strings.CutPrefix(line, " "); ok {
+			unfolded.WriteString(rest)
+		} else {
+			unfolded.WriteString("\n" + line)
+		}
+	}
+	if !strings.Contains(unfolded.String(), "\nSUMMARY:"+summary+"\n") {
+		t.Errorf("unfolded lines lost the summary: %q", unfolded.String())
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		want     string
+	}{
+		{0, "PT0S"},
+		{-15 * time.Minute, "-PT15M"},
+		{90 * time.Minute, "PT1H30M"},
+		{24 * time.Hour, "P1D"},
+		{25*time.Hour + 30*time.Second, "P1DT1H30S"},
+	}
+
+	for _, tt := range tests {
+		if got := ical.FormatDuration(tt.duration); got != tt.want {
+			t.Errorf("FormatDuration(%v) = %q, want %q", tt.duration, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformed        = errors.New("malformed token")
+	ErrUnsupportedAlg   = errors.New("unsupported signing algorithm")
+	ErrUnknownKey       = errors.New("unknown signing key")
+	ErrInvalidSignature = errors.New("invalid token signature")
+	ErrExpired          = errors.New("token is expired")
+	ErrNotYetValid      = errors.New("token is not valid yet")
+	ErrInvalidIssuer    = errors.New("invalid token issuer")
+	ErrInvalidAudience  = errors.New("invalid token audience")
+	ErrNoKeys           = errors.New("no verification keys configured")
+)
+
+// TimeNow is replaced in tests.
+var TimeNow = time.Now
+
+type Config struct {
+	// HMACSecret verifies HS256 tokens.
+	HMACSecret string
+	// JWKSFile is a path to a JSON Web Key Set with RSA keys verifying RS256 tokens.
+	JWKSFile string
+	// Issuer and Audience are checked when set.
+	Issuer   string
+	Audience string
+	// Leeway allows for clock skew when checking exp and nbf.
+	Leeway time.Duration
+}
+
+type Claims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  Audience `json:"aud"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf"`
+	IssuedAt  int64    `json:"iat"`
+}
+
+// Audience is either a single string or an array of strings in a token.
+type Audience []string
+
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type Verifier struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey
+	issuer     string
+	audience   string
+	leeway     time.Duration
+}
+
+func NewVerifier(cfg Config) (*Verifier, error) {
+	v := &Verifier{
+		rsaKeys:  make(map[string]*rsa.PublicKey),
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		leeway:   cfg.Leeway,
+	}
+
+	if cfg.HMACSecret != "" {
+		v.hmacSecret = []byte(cfg.HMACSecret)
+	}
+
+	if cfg.JWKSFile != "" {
+		keys, err := loadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		v.rsaKeys = keys
+	}
+
+	if v.hmacSecret == nil && len(v.rsaKeys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	return v, nil
+}
+
+// Verify checks the signature and the registered claims of the compact
+// serialized token and returns its claims.
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, ErrMalformed
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+	if err := v.verifySignature(h, signed, signature); err != nil {
+		return nil, err
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrMalformed
+	}
+
+	if err := v.validateClaims(&claims); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+func (v *Verifier) verifySignature(h header, signed, signature []byte) error {
+	digest := sha256.Sum256(signed)
+
+	switch h.Alg {
+	case "HS256":
+		if v.hmacSecret == nil {
+			return ErrUnknownKey
+		}
+		mac := hmac.New(sha256.New, v.hmacSecret)
+		mac.Write(signed)
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return ErrInvalidSignature
+		}
+		return nil
+	case "RS256":
+		key, ok := v.rsaKeys[h.Kid]
+		if !ok {
+			return ErrUnknownKey
+		}
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return ErrInvalidSignature
+		}
+		return nil
+	default:
+		return ErrUnsupportedAlg
+	}
+}
+
+func (v *Verifier) validateClaims(claims *Claims) error {
+	now := TimeNow()
+
+	if claims.ExpiresAt == 0 || now.After(time.Unix(claims.ExpiresAt, 0).Add(v.leeway)) {
+		return ErrExpired
+	}
+	if claims.NotBefore != 0 && now.Before(time.Unix(claims.NotBefore, 0).Add(-v.leeway)) {
+		return ErrNotYetValid
+	}
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return ErrInvalidIssuer
+	}
+	if v.audience != "" && !slices.Contains(claims.Audience, v.audience) {
+		return ErrInvalidAudience
+	}
+
+	return nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+type jwks struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+func loadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jwks file: %w", err)
+	}
+
+	var set jwks
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse jwks file: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus of key %q: %w", key.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent of key %q: %w", key.Kid, err)
+		}
+
+		keys[key.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
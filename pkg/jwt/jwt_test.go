@@ -0,0 +1,143 @@
+package jwt_test
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"pills-taking-reminder/pkg/jwt"
+	"testing"
+	"time"
+)
+
+func TestVerifyHS256(t *testing.T) {
+	now := time.Date(2025, 5, 11, 15, 0, 0, 0, time.UTC)
+	jwt.TimeNow = func() time.Time { return now }
+	defer func() { jwt.TimeNow = time.Now }()
+
+	verifier, err := jwt.NewVerifier(jwt.Config{
+		HMACSecret: "secret",
+		Issuer:     "issuer",
+		Audience:   "pills",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	valid := map[string]any{"sub": "42", "iss": "issuer", "aud": []string{"pills"}, "exp": now.Add(time.Hour).Unix()}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{"valid", signHS256(t, "secret", valid), nil},
+		{"wrong secret", signHS256(t, "other", valid), jwt.ErrInvalidSignature},
+		{"expired", signHS256(t, "secret", with(valid, "exp", now.Add(-time.Minute).Unix())), jwt.ErrExpired},
+		{"not yet valid", signHS256(t, "secret", with(valid, "nbf", now.Add(time.Minute).Unix())), jwt.ErrNotYetValid},
+		{"wrong issuer", signHS256(t, "secret", with(valid, "iss", "other")), jwt.ErrInvalidIssuer},
+		{"wrong audience", signHS256(t, "secret", with(valid, "aud", "other")), jwt.ErrInvalidAudience},
+		{"unsigned", encode(t, map[string]string{"alg": "none"}) + "." + encode(t, valid) + ".", jwt.ErrUnsupportedAlg},
+		{"malformed", "not a token", jwt.ErrMalformed},
+	}
+
+	for _, tt := range tests {
+		claims, err := verifier.Verify(tt.token)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err == nil && claims.Subject != "42" {
+			t.Errorf("%s: got subject %q", tt.name, claims.Subject)
+		}
+	}
+}
+
+func TestVerifyRS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	jwks := map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": "key-1",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+	data, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatalf("failed to marshal jwks: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write jwks: %v", err)
+	}
+
+	verifier, err := jwt.NewVerifier(jwt.Config{JWKSFile: path})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	claims := map[string]any{"sub": "7", "exp": time.Now().Add(time.Hour).Unix()}
+
+	token := signRS256(t, key, "key-1", claims)
+	if _, err := verifier.Verify(token); err != nil {
+		t.Errorf("valid token: unexpected error: %v", err)
+	}
+
+	token = signRS256(t, key, "key-2", claims)
+	if _, err := verifier.Verify(token); !errors.Is(err, jwt.ErrUnknownKey) {
+		t.Errorf("unknown key: got error %v", err)
+	}
+
+	token = signHS256(t, "secret", claims)
+	if _, err := verifier.Verify(token); !errors.Is(err, jwt.ErrUnknownKey) {
+		t.Errorf("HS256 without secret: got error %v", err)
+	}
+}
+
+func with(claims map[string]any, key string, value any) map[string]any {
+	result := make(map[string]any, len(claims)+1)
+	for k, v := range claims {
+		result[k] = v
+	}
+	result[key] = value
+	return result
+}
+
+func encode(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, secret string, claims map[string]any) string {
+	t.Helper()
+	signed := encode(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encode(t, claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	t.Helper()
+	signed := encode(t, map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid}) + "." + encode(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
@@ -0,0 +1,41 @@
+// Package medicine normalizes medicine names, so the spellings differing in
+// case, spacing, punctuation or alphabet compare equal.
+package medicine
+
+import (
+	"strings"
+	"unicode"
+)
+
+// cyrillic transliterates the Russian letters into the Latin ones.
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// Normalize lowercases the name, transliterates it into the Latin alphabet
+// and keeps the words of letters and digits separated by single spaces, so
+// "Aspirin", " aspirin " and "Аспирин" are all "aspirin".
+func Normalize(name string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(name) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			space = b.Len() > 0
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		if latin, ok := cyrillic[r]; ok {
+			b.WriteString(latin)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,27 @@
+package medicine_test
+
+import (
+	"pills-taking-reminder/pkg/medicine"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Aspirin", "aspirin"},
+		{" aspirin ", "aspirin"},
+		{"Аспирин", "aspirin"},
+		{"Vitamin  D3", "vitamin d3"},
+		{"Co-Amoxiclav 625mg", "co amoxiclav 625mg"},
+		{"Щёлочь, ЮЖНАЯ!", "shcheloch yuzhnaya"},
+		{"%_", ""},
+	}
+
+	for _, tt := range tests {
+		if got := medicine.Normalize(tt.name); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package mw
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"pills-taking-reminder/pkg/jwt"
+	"pills-taking-reminder/pkg/problem"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrNoCredentials      = errors.New("no credentials provided")
+	ErrUnsupportedScheme  = errors.New("unsupported authorization scheme")
+	ErrInvalidSubject     = errors.New("token subject is not a user ID")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Principal is the authenticated user. Nil Scopes grant access to every
+// endpoint, which is the case for user tokens.
+type Principal struct {
+	UserID int64
+	Scopes []string
+}
+
+// Authenticator resolves the value of the Authorization header into the
+// authenticated principal.
+type Authenticator interface {
+	Authenticate(ctx context.Context, authorization string) (Principal, error)
+}
+
+// Schemes dispatches the Authorization header to the authenticator of its scheme.
+type Schemes map[string]Authenticator
+
+func (s Schemes) Authenticate(ctx context.Context, authorization string) (Principal, error) {
+	scheme, _, _ := strings.Cut(authorization, " ")
+	for name, auth := range s {
+		if strings.EqualFold(name, scheme) {
+			return auth.Authenticate(ctx, authorization)
+		}
+	}
+	return Principal{}, ErrUnsupportedScheme
+}
+
+type BearerAuthenticator struct {
+	verifier *jwt.Verifier
+}
+
+func NewBearerAuthenticator(verifier *jwt.Verifier) *BearerAuthenticator {
+	return &BearerAuthenticator{
+		verifier: verifier,
+	}
+}
+
+func (a *BearerAuthenticator) Authenticate(_ context.Context, authorization string) (Principal, error) {
+	token, ok := Credentials(authorization, "Bearer")
+	if !ok {
+		return Principal{}, ErrUnsupportedScheme
+	}
+
+	claims, err := a.verifier.Verify(token)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || userID <= 0 {
+		return Principal{}, ErrInvalidSubject
+	}
+
+	return Principal{UserID: userID}, nil
+}
+
+// Credentials returns the credentials of the Authorization header value if it
+// uses the given scheme.
+func Credentials(authorization, scheme string) (string, bool) {
+	name, credentials, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(name, scheme) {
+		return "", false
+	}
+	return strings.TrimSpace(credentials), true
+}
+
+// HTTPAuthMiddleware rejects requests without valid credentials and makes the
+// authenticated user the actor of the request. Paths starting with one of the
+// public prefixes are served without credentials and without an actor.
+func HTTPAuthMiddleware(auth Authenticator, logger *slog.Logger, public ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			for _, prefix := range public {
+				if strings.HasPrefix(r.URL.Path, prefix) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			principal, err := authenticate(ctx, auth, r.Header.Get("Authorization"))
+			if err != nil {
+				logger.Info("request was not authenticated",
+					slog.String("trace_id", GetTraceID(ctx)),
+					slog.String("path", r.URL.Path),
+					slog.String("error", err.Error()))
+
+				w.Header().Set("WWW-Authenticate", `Bearer realm="pills-taking-reminder"`)
+				writeProblem(w, r, logger, problem.New(http.StatusUnauthorized, "Unauthorized").
+					WithCode(problem.CodeUnauthenticated).WithTraceID(GetTraceID(ctx)))
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(withPrincipal(ctx, principal)))
+		})
+	}
+}
+
+func UnaryAuthInterceptor(auth Authenticator, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticateGRPC(ctx, auth, logger, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamAuthInterceptor(auth Authenticator, logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(server any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticateGRPC(ss.Context(), auth, logger, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(server, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticateGRPC(ctx context.Context, auth Authenticator, logger *slog.Logger, method string) (context.Context, error) {
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+
+	principal, err := authenticate(ctx, auth, authorization)
+	if err != nil {
+		logger.Info("request was not authenticated in grpc",
+			slog.String("method", method),
+			slog.String("error", err.Error()))
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated")
+	}
+
+	return withPrincipal(ctx, principal), nil
+}
+
+func authenticate(ctx context.Context, auth Authenticator, authorization string) (Principal, error) {
+	if authorization == "" {
+		return Principal{}, ErrNoCredentials
+	}
+	return auth.Authenticate(ctx, authorization)
+}
+
+func withPrincipal(ctx context.Context, principal Principal) context.Context {
+	ctx = WithActorID(ctx, principal.UserID)
+	if principal.Scopes != nil {
+		ctx = context.WithValue(ctx, contextKey("scopes"), principal.Scopes)
+	}
+	return ctx
+}
+
+// HasScope reports whether the request may use an endpoint requiring the scope.
+func HasScope(ctx context.Context, scope string) bool {
+	scopes, ok := ctx.Value(contextKey("scopes")).([]string)
+	if !ok {
+		return true
+	}
+	return slices.Contains(scopes, scope)
+}
+
+// HTTPScopeMiddleware rejects requests whose credentials lack the scope
+// required by the endpoint. Scopes are keyed by "METHOD /path"; endpoints
+// missing from the map are only available without scope restrictions.
+func HTTPScopeMiddleware(scopes map[string]string, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			if !allowed(ctx, scopes, r.Method+" "+r.URL.Path) {
+				logger.Info("request lacks the required scope",
+					slog.String("trace_id", GetTraceID(ctx)),
+					slog.String("method", r.Method),
+					slog.String("path", r.URL.Path))
+
+				writeProblem(w, r, logger, problem.New(http.StatusForbidden, "Insufficient scope").
+					WithCode(problem.CodePermissionDenied).WithTraceID(GetTraceID(ctx)))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// UnaryScopeInterceptor is HTTPScopeMiddleware for gRPC with scopes keyed by
+// the full method name.
+func UnaryScopeInterceptor(scopes map[string]string, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !allowed(ctx, scopes, info.FullMethod) {
+			logger.Info("request lacks the required scope in grpc",
+				slog.String("method", info.FullMethod))
+			return nil, status.Error(codes.PermissionDenied, "Insufficient scope")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamScopeInterceptor(scopes map[string]string, logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(server any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !allowed(ss.Context(), scopes, info.FullMethod) {
+			logger.Info("request lacks the required scope in grpc",
+				slog.String("method", info.FullMethod))
+			return status.Error(codes.PermissionDenied, "Insufficient scope")
+		}
+		return handler(server, ss)
+	}
+}
+
+func allowed(ctx context.Context, scopes map[string]string, endpoint string) bool {
+	if _, restricted := ctx.Value(contextKey("scopes")).([]string); !restricted {
+		return true
+	}
+	scope, ok := scopes[endpoint]
+	return ok && HasScope(ctx, scope)
+}
+
+func writeProblem(w http.ResponseWriter, r *http.Request, logger *slog.Logger, p *problem.Problem) {
+	if err := problem.Write(w, p.Localize(requestLocale(r))); err != nil {
+		logger.Error("failed to write response into writer", slog.String("error", err.Error()))
+	}
+}
@@ -3,11 +3,14 @@ package mw
 import (
 	"context"
 	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"log/slog"
+	"pills-taking-reminder/pkg/problem"
+	"strconv"
 	"time"
 )
 
@@ -23,6 +26,7 @@ func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 			if userAgents := md.Get("user-agent"); len(userAgents) > 0 {
 				userAgent = userAgents[0]
 			}
+			ctx = actorFromMetadata(ctx, md)
 		}
 
 		if traceID == "" {
@@ -35,6 +39,7 @@ func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 		}
 
 		ctx = metadata.AppendToOutgoingContext(ctx, "x-trace-id", traceID)
+		ctx = context.WithValue(ctx, contextKey("trace_id"), traceID)
 
 		startTime := time.Now()
 
@@ -76,6 +81,7 @@ func StreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
 			if userAgents := md.Get("user-agent"); len(userAgents) > 0 {
 				userAgent = userAgents[0]
 			}
+			ctx = actorFromMetadata(ctx, md)
 		}
 		if traceID == "" {
 			traceID = uuid.New().String()
@@ -97,7 +103,7 @@ func StreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
 			slog.Bool("server_stream", info.IsServerStream),
 			slog.Int64("timestamp", startTime.Unix()))
 
-		err := handler(server, ss)
+		err := handler(server, &serverStream{ServerStream: ss, ctx: ctx})
 
 		duration := time.Since(startTime)
 		statusCode, _ := status.FromError(err)
@@ -114,3 +120,47 @@ func StreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
 		return err
 	}
 }
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func actorFromMetadata(ctx context.Context, md metadata.MD) context.Context {
+	ids := md.Get("x-actor-id")
+	if len(ids) == 0 {
+		return ctx
+	}
+	actorID, err := strconv.ParseInt(ids[0], 10, 64)
+	if err != nil {
+		return ctx
+	}
+	return WithActorID(ctx, actorID)
+}
+
+// UnaryErrorDetailsInterceptor adds an ErrorInfo with the problem code and
+// the trace ID to the errors returned without one.
+func UnaryErrorDetailsInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		response, err := handler(ctx, req)
+		if err == nil {
+			return response, nil
+		}
+
+		st := status.Convert(err)
+		for _, detail := range st.Details() {
+			if _, ok := detail.(*errdetails.ErrorInfo); ok {
+				return response, err
+			}
+		}
+		detailed, detailsErr := st.WithDetails(problem.ErrorInfo(problem.CodeForGRPC(st.Code()), GetTraceID(ctx)))
+		if detailsErr != nil {
+			return response, err
+		}
+		return response, detailed.Err()
+	}
+}
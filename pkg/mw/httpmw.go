@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"maps"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -61,6 +62,9 @@ func HTTPLoggingMiddleware(logger *slog.Logger) func(http.Handler) http.Handler
 			ctx := context.WithValue(r.Context(), contextKey("trace_id"), traceID)
 			ctx = context.WithValue(ctx, contextKey("client_ip"), clientIP)
 			ctx = context.WithValue(ctx, contextKey("user_agent"), userAgent)
+			if actorID, err := strconv.ParseInt(r.Header.Get("X-Actor-ID"), 10, 64); err == nil {
+				ctx = WithActorID(ctx, actorID)
+			}
 
 			rw := &responseWriter{
 				ResponseWriter: w,
@@ -111,3 +115,15 @@ func GetTraceID(ctx context.Context) string {
 	}
 	return ""
 }
+
+// WithActorID stores the ID of the user performing the request, which may
+// differ from the user the request is about when a caregiver acts on behalf
+// of a dependant.
+func WithActorID(ctx context.Context, actorID int64) context.Context {
+	return context.WithValue(ctx, contextKey("actor_id"), actorID)
+}
+
+func GetActorID(ctx context.Context) (int64, bool) {
+	actorID, ok := ctx.Value(contextKey("actor_id")).(int64)
+	return actorID, ok
+}
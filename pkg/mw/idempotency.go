@@ -0,0 +1,360 @@
+package mw
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"pills-taking-reminder/pkg/problem"
+	"strconv"
+	"time"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// ReplayedHeader marks the responses replayed for an idempotency key.
+	ReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// replayedHeaders are the response headers stored along with the body.
+var replayedHeaders = []string{"ETag", "Content-Language"}
+
+var (
+	// ErrIdempotencyKeyInUse is returned while the first request with the key
+	// has not completed.
+	ErrIdempotencyKeyInUse = errors.New("request with the idempotency key is in progress")
+	// ErrIdempotencyKeyReused is returned when the key was used for a request
+	// with another body.
+	ErrIdempotencyKeyReused = errors.New("idempotency key was used for another request")
+)
+
+// IdempotentResponse is the stored response to a request with an idempotency
+// key. gRPC responses keep the status code in StatusCode.
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Headers     map[string]string
+	Body        []byte
+}
+
+// IdempotencyLease is the reservation of a key by a request. A request which
+// outlives the lock loses the key to a retry, then its lease neither stores
+// the response nor releases the key.
+type IdempotencyLease struct {
+	Key         string
+	RequestHash string
+	LockedUntil time.Time
+}
+
+// IdempotencyStore keeps the responses to requests with an idempotency key.
+type IdempotencyStore interface {
+	// Begin reserves the key for the request with the given hash and returns
+	// the lease. It returns the stored response instead if a request with the
+	// key has completed.
+	Begin(ctx context.Context, key, requestHash string) (*IdempotencyLease, *IdempotentResponse, error)
+	// Complete stores the response to the request which holds the lease.
+	Complete(ctx context.Context, lease IdempotencyLease, response IdempotentResponse) error
+	// Release forgets the key held by the lease so the request can be
+	// retried.
+	Release(ctx context.Context, lease IdempotencyLease) error
+}
+
+// HTTPIdempotencyMiddleware replays the stored response to POST requests
+// retried with the same Idempotency-Key header instead of executing them
+// again. Keys are scoped to the actor and endpoint. Server errors are not
+// stored, so such requests are executed again on retry.
+func HTTPIdempotencyMiddleware(store IdempotencyStore, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			clientKey := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || clientKey == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(clientKey) > maxIdempotencyKeyLength {
+				writeIdempotencyError(w, r, logger, http.StatusBadRequest, problem.CodeInvalidInput, "Idempotency key is too long")
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				writeIdempotencyError(w, r, logger, http.StatusBadRequest, problem.CodeInvalidInput, "Invalid request format")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			endpoint := r.Method + " " + r.URL.Path
+			key := idempotencyKey(ctx, endpoint, clientKey)
+			lease, stored, err := store.Begin(ctx, key, requestHash(endpoint, []byte(r.URL.RawQuery), body))
+			if err != nil {
+				logger.Info("idempotent request was not started",
+					slog.String("trace_id", GetTraceID(ctx)),
+					slog.String("path", r.URL.Path),
+					slog.String("error", err.Error()))
+				switch {
+				case errors.Is(err, ErrIdempotencyKeyInUse):
+					writeIdempotencyError(w, r, logger, http.StatusConflict, problem.CodeIdempotencyInUse,
+						"Request with this idempotency key is in progress")
+				case errors.Is(err, ErrIdempotencyKeyReused):
+					writeIdempotencyError(w, r, logger, http.StatusUnprocessableEntity, problem.CodeIdempotencyReuse,
+						"Idempotency key was used for another request")
+				default:
+					writeIdempotencyError(w, r, logger, http.StatusInternalServerError, problem.CodeInternal, "Internal server error")
+				}
+				return
+			}
+
+			if stored != nil {
+				logger.Info("replaying idempotent response",
+					slog.String("trace_id", GetTraceID(ctx)),
+					slog.String("path", r.URL.Path),
+					slog.Int("status_code", stored.StatusCode))
+				if stored.ContentType != "" {
+					w.Header().Set("Content-Type", stored.ContentType)
+				}
+				for name, value := range stored.Headers {
+					w.Header().Set(name, value)
+				}
+				w.Header().Set(ReplayedHeader, "true")
+				w.WriteHeader(stored.StatusCode)
+				if _, err := w.Write(stored.Body); err != nil {
+					logger.Error("failed to write response into writer", slog.String("error", err.Error()))
+				}
+				return
+			}
+
+			recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			completed := false
+			// The response is stored even if the client has gone away, which
+			// is exactly when it retries.
+			storeCtx := context.WithoutCancel(ctx)
+			defer func() {
+				if !completed {
+					releaseIdempotencyKey(storeCtx, store, logger, *lease)
+				}
+			}()
+
+			next.ServeHTTP(recorder, r)
+
+			if recorder.statusCode >= http.StatusInternalServerError {
+				return
+			}
+			err = store.Complete(storeCtx, *lease, IdempotentResponse{
+				StatusCode:  recorder.statusCode,
+				ContentType: recorder.Header().Get("Content-Type"),
+				Headers:     storedHeaders(recorder.Header()),
+				Body:        recorder.body.Bytes(),
+			})
+			if err != nil {
+				logger.Error("failed to store idempotent response",
+					slog.String("trace_id", GetTraceID(ctx)),
+					slog.String("error", err.Error()))
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+// UnaryIdempotencyInterceptor is HTTPIdempotencyMiddleware for unary gRPC
+// calls with the idempotency-key metadata. Responses and statuses other than
+// server errors are stored as protobuf messages.
+func UnaryIdempotencyInterceptor(store IdempotencyStore, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var clientKey string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(IdempotencyKeyHeader); len(values) > 0 {
+				clientKey = values[0]
+			}
+		}
+		message, ok := req.(proto.Message)
+		if clientKey == "" || !ok {
+			return handler(ctx, req)
+		}
+		if len(clientKey) > maxIdempotencyKeyLength {
+			return nil, status.Error(codes.InvalidArgument, "Idempotency key is too long")
+		}
+
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		if err != nil {
+			logger.Error("failed to marshal idempotent request in grpc", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+
+		key := idempotencyKey(ctx, info.FullMethod, clientKey)
+		lease, stored, err := store.Begin(ctx, key, requestHash(info.FullMethod, body))
+		if err != nil {
+			logger.Info("idempotent request was not started in grpc",
+				slog.String("method", info.FullMethod),
+				slog.String("error", err.Error()))
+			switch {
+			case errors.Is(err, ErrIdempotencyKeyInUse):
+				return nil, problem.Status(codes.Aborted, problem.CodeIdempotencyInUse,
+					"Request with this idempotency key is in progress", GetTraceID(ctx), nil).Err()
+			case errors.Is(err, ErrIdempotencyKeyReused):
+				return nil, problem.Status(codes.InvalidArgument, problem.CodeIdempotencyReuse,
+					"Idempotency key was used for another request", GetTraceID(ctx), nil).Err()
+			default:
+				return nil, status.Error(codes.Internal, "Internal server error")
+			}
+		}
+
+		if stored != nil {
+			logger.Info("replaying idempotent response in grpc",
+				slog.String("method", info.FullMethod),
+				slog.Int("status_code", stored.StatusCode))
+			if err := grpc.SetHeader(ctx, metadata.Pairs(ReplayedHeader, "true")); err != nil {
+				logger.Error("failed to set header in grpc", slog.String("error", err.Error()))
+			}
+			return replayGRPC(stored)
+		}
+
+		storeCtx := context.WithoutCancel(ctx)
+		completed := false
+		defer func() {
+			if !completed {
+				releaseIdempotencyKey(storeCtx, store, logger, *lease)
+			}
+		}()
+
+		resp, handlerErr := handler(ctx, req)
+
+		code := status.Code(handlerErr)
+		if serverError(code) {
+			return resp, handlerErr
+		}
+
+		var result proto.Message
+		if handlerErr != nil {
+			result = status.Convert(handlerErr).Proto()
+		} else if result, ok = resp.(proto.Message); !ok {
+			return resp, handlerErr
+		}
+		stored, err = grpcResponse(code, result)
+		if err == nil {
+			err = store.Complete(storeCtx, *lease, *stored)
+		}
+		if err != nil {
+			logger.Error("failed to store idempotent response in grpc", slog.String("error", err.Error()))
+			return resp, handlerErr
+		}
+		completed = true
+
+		return resp, handlerErr
+	}
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// idempotencyKey hashes the client key along with the actor and endpoint, so
+// different users and endpoints never share a key.
+func idempotencyKey(ctx context.Context, endpoint, clientKey string) string {
+	actorID, _ := GetActorID(ctx)
+	return requestHash(strconv.FormatInt(actorID, 10), []byte(endpoint), []byte(clientKey))
+}
+
+func requestHash(endpoint string, parts ...[]byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(endpoint))
+	for _, part := range parts {
+		hash.Write([]byte{0})
+		hash.Write(part)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func storedHeaders(header http.Header) map[string]string {
+	stored := make(map[string]string)
+	for _, name := range replayedHeaders {
+		if value := header.Get(name); value != "" {
+			stored[name] = value
+		}
+	}
+	return stored
+}
+
+func releaseIdempotencyKey(ctx context.Context, store IdempotencyStore, logger *slog.Logger, lease IdempotencyLease) {
+	if err := store.Release(ctx, lease); err != nil {
+		logger.Error("failed to release idempotency key", slog.String("error", err.Error()))
+	}
+}
+
+func writeIdempotencyError(w http.ResponseWriter, r *http.Request, logger *slog.Logger, statusCode int, code,
+	message string) {
+	writeProblem(w, r, logger, problem.New(statusCode, message).WithCode(code).WithTraceID(GetTraceID(r.Context())))
+}
+
+// serverError reports whether the call failed for reasons the client can not
+// know about, its retries are executed again.
+func serverError(code codes.Code) bool {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return true
+	}
+	return false
+}
+
+func grpcResponse(code codes.Code, result proto.Message) (*IdempotentResponse, error) {
+	wrapped, err := anypb.New(result)
+	if err != nil {
+		return nil, err
+	}
+	body, err := proto.Marshal(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	return &IdempotentResponse{
+		StatusCode: int(code),
+		Body:       body,
+	}, nil
+}
+
+func replayGRPC(stored *IdempotentResponse) (any, error) {
+	var wrapped anypb.Any
+	if err := proto.Unmarshal(stored.Body, &wrapped); err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	result, err := wrapped.UnmarshalNew()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	if codes.Code(stored.StatusCode) == codes.OK {
+		return result, nil
+	}
+	st, ok := result.(*spb.Status)
+	if !ok {
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	return nil, status.ErrorProto(st)
+}
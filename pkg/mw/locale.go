@@ -0,0 +1,110 @@
+package mw
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"pills-taking-reminder/pkg/i18n"
+	"pills-taking-reminder/pkg/problem"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AcceptLanguageHeader selects the locale of the request, the gRPC metadata
+// has the same name.
+const AcceptLanguageHeader = "Accept-Language"
+
+// LocaleStore returns the locale the user chose in their profile.
+type LocaleStore interface {
+	Locale(ctx context.Context, userID int64) (i18n.Locale, bool, error)
+}
+
+// HTTPLocaleMiddleware selects the locale of the request from the
+// Accept-Language header, falling back to the profile of the actor and then
+// to the default locale. The locale is sent in the Content-Language header.
+// The default locale is not stored in the context, so i18n.Selected tells
+// the handlers whether the request chose one.
+func HTTPLocaleMiddleware(store LocaleStore, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			locale, ok := selectLocale(ctx, store, logger, r.Header.Get(AcceptLanguageHeader))
+			w.Header().Set("Content-Language", string(locale))
+			if ok {
+				ctx = i18n.WithLocale(ctx, locale)
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// UnaryLocaleInterceptor is HTTPLocaleMiddleware for unary gRPC calls with
+// the accept-language metadata. Errors get a LocalizedMessage detail, the
+// message of the status stays in English.
+func UnaryLocaleInterceptor(store LocaleStore, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var acceptLanguage string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(AcceptLanguageHeader); len(values) > 0 {
+				acceptLanguage = values[0]
+			}
+		}
+		locale, ok := selectLocale(ctx, store, logger, acceptLanguage)
+		if ok {
+			ctx = i18n.WithLocale(ctx, locale)
+		}
+
+		response, err := handler(ctx, req)
+		if err == nil {
+			return response, nil
+		}
+
+		st := status.Convert(err)
+		localized, detailsErr := st.WithDetails(problem.LocalizedMessage(st, locale))
+		if detailsErr != nil {
+			return response, err
+		}
+		return response, localized.Err()
+	}
+}
+
+// selectLocale returns the locale of the request and whether one was
+// selected, the default locale otherwise.
+func selectLocale(ctx context.Context, store LocaleStore, logger *slog.Logger, acceptLanguage string) (i18n.Locale, bool) {
+	if locale, ok := i18n.Match(acceptLanguage); ok {
+		return locale, true
+	}
+
+	actorID, ok := GetActorID(ctx)
+	if store == nil || !ok {
+		return i18n.Default, false
+	}
+	locale, ok, err := store.Locale(ctx, actorID)
+	if err != nil {
+		logger.Error("failed to get locale of the actor",
+			slog.String("trace_id", GetTraceID(ctx)),
+			slog.Int64("actor_id", actorID),
+			slog.String("error", err.Error()))
+		return i18n.Default, false
+	}
+	if !ok {
+		return i18n.Default, false
+	}
+	return locale, true
+}
+
+// requestLocale returns the locale of the request for the responses written
+// before HTTPLocaleMiddleware has run.
+func requestLocale(r *http.Request) i18n.Locale {
+	if i18n.Selected(r.Context()) {
+		return i18n.FromContext(r.Context())
+	}
+	if locale, ok := i18n.Match(r.Header.Get(AcceptLanguageHeader)); ok {
+		return locale
+	}
+	return i18n.Default
+}
@@ -0,0 +1,74 @@
+package problem
+
+import (
+	"errors"
+	"pills-taking-reminder/pkg/i18n"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError is an invalid field of the request. Code is the failed
+// validation rule, like required or gte.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewValidator returns a validator reporting the fields by their JSON names.
+func NewValidator() *validator.Validate {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return validate
+}
+
+// FieldErrors returns the invalid fields of a validator error with the
+// messages in the locale, or nil for other errors.
+func FieldErrors(err error, locale i18n.Locale) []FieldError {
+	var invalid validator.ValidationErrors
+	if !errors.As(err, &invalid) {
+		return nil
+	}
+
+	fields := make([]FieldError, len(invalid))
+	for i, fieldErr := range invalid {
+		fields[i] = FieldError{
+			Field:   fieldErr.Field(),
+			Code:    fieldCode(fieldErr),
+			Message: fieldMessage(fieldErr, locale),
+		}
+	}
+	return fields
+}
+
+// fieldCode returns the failed rule, the conditionally required fields are
+// reported as the required ones.
+func fieldCode(fieldErr validator.FieldError) string {
+	if fieldErr.Tag() == "required_without" {
+		return "required"
+	}
+	return fieldErr.Tag()
+}
+
+func fieldMessage(fieldErr validator.FieldError, locale i18n.Locale) string {
+	switch fieldCode(fieldErr) {
+	case "required":
+		return i18n.T(locale, "is required")
+	case "gte", "min":
+		return i18n.Tf(locale, "must be at least %s", fieldErr.Param())
+	case "lte", "max":
+		return i18n.Tf(locale, "must be at most %s", fieldErr.Param())
+	case "oneof":
+		return i18n.Tf(locale, "must be one of %s", fieldErr.Param())
+	default:
+		return i18n.Tf(locale, "failed the %s rule", fieldErr.Tag())
+	}
+}
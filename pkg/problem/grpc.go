@@ -0,0 +1,84 @@
+package problem
+
+import (
+	"pills-taking-reminder/pkg/i18n"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain is the domain of the ErrorInfo details.
+const Domain = "pills-taking-reminder"
+
+var grpcCodes = map[codes.Code]string{
+	codes.InvalidArgument:    CodeInvalidInput,
+	codes.Unauthenticated:    CodeUnauthenticated,
+	codes.PermissionDenied:   CodePermissionDenied,
+	codes.NotFound:           CodeNotFound,
+	codes.AlreadyExists:      CodeConflict,
+	codes.Aborted:            CodeConflict,
+	codes.FailedPrecondition: CodePreconditionFailed,
+}
+
+// CodeForGRPC returns the generic code of the gRPC status code.
+func CodeForGRPC(code codes.Code) string {
+	if problemCode, ok := grpcCodes[code]; ok {
+		return problemCode
+	}
+	return CodeInternal
+}
+
+// Status returns the gRPC status with an ErrorInfo whose reason is the code
+// in upper case, and a BadRequest for the invalid fields.
+func Status(code codes.Code, problemCode, message, traceID string, fields []FieldError) *status.Status {
+	st := status.New(code, message)
+	info := ErrorInfo(problemCode, traceID)
+
+	var badRequest *errdetails.BadRequest
+	if len(fields) > 0 {
+		badRequest = &errdetails.BadRequest{
+			FieldViolations: make([]*errdetails.BadRequest_FieldViolation, len(fields)),
+		}
+		for i, field := range fields {
+			badRequest.FieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+				Field:       field.Field,
+				Description: field.Message,
+				Reason:      strings.ToUpper(field.Code),
+			}
+		}
+	}
+
+	var detailed *status.Status
+	var err error
+	if badRequest != nil {
+		detailed, err = st.WithDetails(info, badRequest)
+	} else {
+		detailed, err = st.WithDetails(info)
+	}
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// ErrorInfo returns the details of the problem code.
+func ErrorInfo(problemCode, traceID string) *errdetails.ErrorInfo {
+	info := &errdetails.ErrorInfo{
+		Reason: strings.ToUpper(problemCode),
+		Domain: Domain,
+	}
+	if traceID != "" {
+		info.Metadata = map[string]string{"trace_id": traceID}
+	}
+	return info
+}
+
+// LocalizedMessage returns the message of the status in the locale.
+func LocalizedMessage(st *status.Status, locale i18n.Locale) *errdetails.LocalizedMessage {
+	return &errdetails.LocalizedMessage{
+		Locale:  string(locale),
+		Message: i18n.T(locale, st.Message()),
+	}
+}
@@ -0,0 +1,118 @@
+// Package problem describes failed requests: RFC 7807 problem details for
+// HTTP and status details for gRPC, both carrying the same stable codes.
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+	"pills-taking-reminder/pkg/i18n"
+)
+
+const ContentType = "application/problem+json"
+
+// Codes of the problems. Clients may rely on them, unlike on the details.
+const (
+	CodeInvalidInput         = "invalid_input"
+	CodeValidationFailed     = "validation_failed"
+	CodeUnauthenticated      = "unauthenticated"
+	CodePermissionDenied     = "permission_denied"
+	CodeNotFound             = "not_found"
+	CodeConflict             = "conflict"
+	CodePreconditionFailed   = "precondition_failed"
+	CodePayloadTooLarge      = "payload_too_large"
+	CodeUnprocessable        = "unprocessable"
+	CodePreconditionRequired = "precondition_required"
+	CodeInternal             = "internal"
+
+	CodeScheduleExists             = "schedule_exists"
+	CodeScheduleModified           = "schedule_modified"
+	CodeVersionRequired            = "version_required"
+	CodeMedicineNotFound           = "medicine_not_found"
+	CodeInteractionContraindicated = "interaction_contraindicated"
+	CodeDailyDoseExceeded          = "daily_dose_exceeded"
+	CodeSeparationUnsatisfiable    = "separation_unsatisfiable"
+	CodeIdempotencyInUse           = "idempotency_key_in_use"
+	CodeIdempotencyReuse           = "idempotency_key_reused"
+)
+
+var statusCodes = map[int]string{
+	http.StatusBadRequest:            CodeInvalidInput,
+	http.StatusUnauthorized:          CodeUnauthenticated,
+	http.StatusForbidden:             CodePermissionDenied,
+	http.StatusNotFound:              CodeNotFound,
+	http.StatusConflict:              CodeConflict,
+	http.StatusPreconditionFailed:    CodePreconditionFailed,
+	http.StatusRequestEntityTooLarge: CodePayloadTooLarge,
+	http.StatusUnprocessableEntity:   CodeUnprocessable,
+	http.StatusPreconditionRequired:  CodePreconditionRequired,
+}
+
+// Problem is the body of the error responses. The problems are not
+// documented by type, Code tells them apart.
+type Problem struct {
+	Type    string       `json:"type"`
+	Title   string       `json:"title"`
+	Status  int          `json:"status"`
+	Detail  string       `json:"detail,omitempty"`
+	Code    string       `json:"code"`
+	TraceID string       `json:"trace_id,omitempty"`
+	Errors  []FieldError `json:"errors,omitempty"`
+}
+
+// New returns the problem with the generic code of the status.
+func New(status int, detail string) *Problem {
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   CodeForStatus(status),
+	}
+}
+
+// CodeForStatus returns the generic code of the HTTP status.
+func CodeForStatus(status int) string {
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+	return CodeInternal
+}
+
+func (p *Problem) WithCode(code string) *Problem {
+	p.Code = code
+	return p
+}
+
+func (p *Problem) WithTraceID(traceID string) *Problem {
+	p.TraceID = traceID
+	return p
+}
+
+// WithErrors adds the invalid fields, the problem becomes a validation
+// failure.
+func (p *Problem) WithErrors(errors []FieldError) *Problem {
+	p.Errors = errors
+	p.Code = CodeValidationFailed
+	return p
+}
+
+// Localize translates the title and the detail. Clients should rely on the
+// code, which is never translated.
+func (p *Problem) Localize(locale i18n.Locale) *Problem {
+	p.Title = i18n.T(locale, p.Title)
+	p.Detail = i18n.T(locale, p.Detail)
+	return p
+}
+
+// Write sends the problem as the response.
+func Write(w http.ResponseWriter, p *Problem) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return err
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	_, err = w.Write(body)
+	return err
+}
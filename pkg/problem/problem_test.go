@@ -0,0 +1,128 @@
+package problem_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"pills-taking-reminder/pkg/i18n"
+	"pills-taking-reminder/pkg/problem"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+type scheduleRequest struct {
+	MedicineName string  `json:"medicine_name" validate:"required_without=MedicineID"`
+	MedicineID   *string `json:"medicine_id,omitempty"`
+	Frequency    int     `json:"frequency" validate:"required,gte=1,lte=15"`
+	Duration     *int    `json:"duration,omitempty" validate:"omitempty,gte=0"`
+}
+
+func TestWrite(t *testing.T) {
+	err := problem.NewValidator().Struct(scheduleRequest{Frequency: 20})
+	p := problem.New(http.StatusBadRequest, "Invalid request parameters").
+		WithTraceID("trace").
+		WithErrors(problem.FieldErrors(err, i18n.En))
+
+	recorder := httptest.NewRecorder()
+	if err := problem.Write(recorder, p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, recorder.Code)
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != problem.ContentType {
+		t.Errorf("expected content type %q, got %q", problem.ContentType, contentType)
+	}
+
+	var got problem.Problem
+	if err := json.NewDecoder(recorder.Body).Decode(&got); err != nil {
+		t.Fatalf("failed to decode problem: %v", err)
+	}
+	if got.Code != problem.CodeValidationFailed || got.Title != "Bad Request" || got.TraceID != "trace" {
+		t.Errorf("unexpected problem: %+v", got)
+	}
+	want := []problem.FieldError{
+		{Field: "medicine_name", Code: "required", Message: "is required"},
+		{Field: "frequency", Code: "lte", Message: "must be at most 15"},
+	}
+	if len(got.Errors) != len(want) {
+		t.Fatalf("expected %d field errors, got %+v", len(want), got.Errors)
+	}
+	for i := range want {
+		if got.Errors[i] != want[i] {
+			t.Errorf("expected field error %+v, got %+v", want[i], got.Errors[i])
+		}
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	err := problem.NewValidator().Struct(scheduleRequest{MedicineName: "Aspirin"})
+	p := problem.New(http.StatusBadRequest, "Invalid request parameters").
+		WithErrors(problem.FieldErrors(err, i18n.Ru)).
+		Localize(i18n.Ru)
+
+	if p.Title != "Некорректный запрос" || p.Detail != "Некорректные параметры запроса" {
+		t.Errorf("unexpected title and detail: %q, %q", p.Title, p.Detail)
+	}
+	if p.Code != problem.CodeValidationFailed {
+		t.Errorf("expected the code not to be translated, got %q", p.Code)
+	}
+	if len(p.Errors) != 1 || p.Errors[0].Message != "обязательное поле" || p.Errors[0].Code != "required" {
+		t.Errorf("unexpected field errors: %+v", p.Errors)
+	}
+}
+
+func TestCodeForStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		want   string
+	}{
+		{http.StatusNotFound, problem.CodeNotFound},
+		{http.StatusPreconditionRequired, problem.CodePreconditionRequired},
+		{http.StatusTeapot, problem.CodeInternal},
+		{http.StatusInternalServerError, problem.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		if got := problem.CodeForStatus(tt.status); got != tt.want {
+			t.Errorf("CodeForStatus(%d) = %q, want %q", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestStatus(t *testing.T) {
+	fields := []problem.FieldError{{Field: "frequency", Code: "lte", Message: "must be at most 15"}}
+	st := problem.Status(codes.InvalidArgument, problem.CodeValidationFailed, "Invalid input parameters", "trace", fields)
+
+	if st.Code() != codes.InvalidArgument || st.Message() != "Invalid input parameters" {
+		t.Errorf("unexpected status: %v", st)
+	}
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = detail
+		case *errdetails.BadRequest:
+			badRequest = detail
+		}
+	}
+
+	if info == nil || info.Reason != "VALIDATION_FAILED" || info.Domain != problem.Domain ||
+		info.Metadata["trace_id"] != "trace" {
+		t.Errorf("unexpected error info: %v", info)
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 ||
+		badRequest.FieldViolations[0].Field != "frequency" || badRequest.FieldViolations[0].Reason != "LTE" {
+		t.Errorf("unexpected bad request: %v", badRequest)
+	}
+
+	st = problem.Status(codes.NotFound, problem.CodeForGRPC(codes.NotFound), "Schedule was not found", "", nil)
+	if len(st.Details()) != 1 {
+		t.Errorf("expected only the error info, got %v", st.Details())
+	}
+}
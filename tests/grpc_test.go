@@ -11,6 +11,9 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestGRPCCreateSchedule(t *testing.T) {
@@ -18,9 +21,9 @@ func TestGRPCCreateSchedule(t *testing.T) {
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
 	interval := 90 * time.Minute
-	useCase := usecase.NewScheduleUseCase(testRepo, interval)
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, interval)
 
-	server := grpc.NewGRPCServer(useCase, logger)
+	server := grpc.NewGRPCServer(usecase.UseCases{Schedule: useCase}, logger)
 
 	validTestCases := []struct {
 		name    string
@@ -208,8 +211,8 @@ func TestGRPCCreateSchedule(t *testing.T) {
 			return
 		}
 
-		if !strings.Contains(err.Error(), "Internal server error") {
-			t.Errorf("Expected error with 'Internal server error', got: %v", err)
+		if status.Code(err) != codes.AlreadyExists {
+			t.Errorf("Expected AlreadyExists for duplicate schedule, got: %v", err)
 		}
 
 		t.Logf("Got expected error for duplicate: %v", err)
@@ -222,7 +225,7 @@ func TestGRPCGetNextTakings(t *testing.T) {
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
 	interval := 90 * time.Minute
-	useCase := usecase.NewScheduleUseCase(testRepo, interval)
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, interval)
 
 	testUsers := []int64{5001, 5002}
 	testSchedules := []struct {
@@ -243,7 +246,7 @@ func TestGRPCGetNextTakings(t *testing.T) {
 			UserID:       s.userID,
 		}
 
-		scheduleID, err := useCase.CreateSchedule(context.Background(), input)
+		scheduleID, _, err := useCase.CreateSchedule(context.Background(), input)
 		if err != nil {
 			t.Fatalf("Failed to create test schedule: %v", err)
 		}
@@ -251,11 +254,11 @@ func TestGRPCGetNextTakings(t *testing.T) {
 			scheduleID, s.medicineName, s.userID, s.frequency)
 	}
 
-	server := grpc.NewGRPCServer(useCase, logger)
+	server := grpc.NewGRPCServer(usecase.UseCases{Schedule: useCase}, logger)
 
 	for _, userID := range testUsers {
 		t.Run(fmt.Sprintf("GetNextTakings for user %d", userID), func(t *testing.T) {
-			req := &pb.UserIDRequest{
+			req := &pb.NextTakingsRequest{
 				UserId: userID,
 			}
 
@@ -283,6 +286,10 @@ func TestGRPCGetNextTakings(t *testing.T) {
 				if hour < "00" || hour > "23" || minute < "00" || minute > "59" {
 					t.Errorf("Taking %d: invalid time value: %s", i, taking.TakingTime)
 				}
+
+				if _, err := time.Parse(time.DateOnly, taking.TakingDate); err != nil {
+					t.Errorf("Taking %d: invalid date: %s", i, taking.TakingDate)
+				}
 			}
 
 			switch userID {
@@ -322,7 +329,7 @@ func TestGRPCGetNextTakings(t *testing.T) {
 
 	t.Run("GetNextTakings for user with no schedules", func(t *testing.T) {
 		nonExistentUserID := int64(9999)
-		req := &pb.UserIDRequest{
+		req := &pb.NextTakingsRequest{
 			UserId: nonExistentUserID,
 		}
 
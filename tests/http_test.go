@@ -2,13 +2,25 @@ package tests
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
 
 	httpHandler "pills-taking-reminder/internal/api/http"
+	api "pills-taking-reminder/internal/api/http/generated"
+	apiv2 "pills-taking-reminder/internal/api/http/generated/v2"
+	"pills-taking-reminder/internal/infrastructure/postgres"
+	"pills-taking-reminder/pkg/i18n"
 	"pills-taking-reminder/pkg/logger"
+	"pills-taking-reminder/pkg/mw"
 
 	"pills-taking-reminder/internal/api/dto"
 	"pills-taking-reminder/internal/domain/entities"
@@ -75,8 +87,8 @@ func TestCreateScheduleHTTP(t *testing.T) {
 	}
 
 	logger := logger.SetupLogger("local")
-	useCase := usecase.NewScheduleUseCase(testRepo, 90*time.Minute)
-	handler := httpHandler.NewScheduleHandler(useCase, logger)
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
 	router := chi.NewRouter()
 	handler.RegisterRoutes(router)
 	server := httptest.NewServer(router)
@@ -255,3 +267,2634 @@ func TestScheduleGetNextTakingsHTTP(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordRefillHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	inventoryUseCase := usecase.NewInventoryUseCase(testRepo, postgres.NewInventoryRepository(testDB, logger), testPolicy)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase, Inventory: inventoryUseCase}, logger)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	scheduleID, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Refill Med",
+		Frequency:    2,
+		Duration:     30,
+		UserID:       3001,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		wantStatusCode int
+		wantQuantity   int
+	}{
+		{
+			name:           "valid refill",
+			body:           fmt.Sprintf(`{"user_id": 3001, "schedule_id": %d, "quantity": 30}`, scheduleID),
+			wantStatusCode: http.StatusOK,
+			wantQuantity:   30,
+		},
+		{
+			name:           "refill resets the count",
+			body:           fmt.Sprintf(`{"user_id": 3001, "schedule_id": %d, "quantity": 60, "dose_per_taking": 2}`, scheduleID),
+			wantStatusCode: http.StatusOK,
+			wantQuantity:   60,
+		},
+		{
+			name:           "zero quantity",
+			body:           fmt.Sprintf(`{"user_id": 3001, "schedule_id": %d, "quantity": 0}`, scheduleID),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "foreign schedule",
+			body:           fmt.Sprintf(`{"user_id": 3002, "schedule_id": %d, "quantity": 30}`, scheduleID),
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Post(server.URL+"/schedule/refill", "application/json", bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("Expected status %d, got %d", tt.wantStatusCode, resp.StatusCode)
+				return
+			}
+
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+
+			var inventory api.InventoryResponse
+			if err := json.NewDecoder(resp.Body).Decode(&inventory); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+
+			if inventory.Quantity == nil || *inventory.Quantity != tt.wantQuantity {
+				t.Errorf("Expected quantity %d, got %v", tt.wantQuantity, inventory.Quantity)
+			}
+		})
+	}
+}
+
+func TestCaregiverAccessHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	caregiverUseCase := usecase.NewCaregiverUseCase(testCaregiverRepo, testPolicy)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase, Caregiver: caregiverUseCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	scheduleID, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Dependant Med",
+		Frequency:    2,
+		Duration:     30,
+		UserID:       4001,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	if _, err := caregiverUseCase.LinkCaregiver(context.Background(), usecase.CaregiverInput{
+		UserID:      4001,
+		CaregiverID: 4002,
+	}); err != nil {
+		t.Fatalf("Failed to link caregiver: %v", err)
+	}
+
+	updateBody := fmt.Sprintf(`{"user_id": 4001, "schedule_id": %d, "medicine_name": "Dependant Med", "frequency": 3, "duration": 10, "version": 1}`, scheduleID)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		actorID        string
+		wantStatusCode int
+	}{
+		{
+			name:           "caregiver views schedule",
+			method:         http.MethodGet,
+			path:           fmt.Sprintf("/schedule?user_id=4001&schedule_id=%d", scheduleID),
+			actorID:        "4002",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "stranger views schedule",
+			method:         http.MethodGet,
+			path:           fmt.Sprintf("/schedule?user_id=4001&schedule_id=%d", scheduleID),
+			actorID:        "4003",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "caregiver edits without permission",
+			method:         http.MethodPut,
+			path:           "/schedule",
+			body:           updateBody,
+			actorID:        "4002",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "caregiver grants themselves permission",
+			method:         http.MethodPut,
+			path:           "/caregivers",
+			body:           `{"user_id": 4001, "caregiver_id": 4002, "can_edit": true}`,
+			actorID:        "4002",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "user grants edit permission",
+			method:         http.MethodPut,
+			path:           "/caregivers",
+			body:           `{"user_id": 4001, "caregiver_id": 4002, "can_edit": true}`,
+			actorID:        "4001",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "caregiver edits schedule",
+			method:         http.MethodPut,
+			path:           "/schedule",
+			body:           updateBody,
+			actorID:        "4002",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "caregiver creates schedule",
+			method:         http.MethodPost,
+			path:           "/schedule",
+			body:           `{"user_id": 4001, "medicine_name": "Second Med", "frequency": 1}`,
+			actorID:        "4002",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "caregiver lists dependants",
+			method:         http.MethodGet,
+			path:           "/dependants?caregiver_id=4002",
+			actorID:        "4002",
+			wantStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Actor-ID", tt.actorID)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("Expected status %d, got %d", tt.wantStatusCode, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestAPIKeyScopesHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	apiKeyUseCase := usecase.NewAPIKeyUseCase(postgres.NewAPIKeyRepository(testDB, logger), testPolicy)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase, APIKey: apiKeyUseCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	router.Use(mw.HTTPAuthMiddleware(mw.Schemes{"ApiKey": apiKeyUseCase}, logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ctx := mw.WithActorID(context.Background(), 5001)
+	readKey, err := apiKeyUseCase.CreateAPIKey(ctx, usecase.APIKeyInput{
+		UserID: 5001,
+		Name:   "reader",
+		Scopes: []string{string(entities.ScopeSchedulesRead)},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create api key: %v", err)
+	}
+	revokedKey, err := apiKeyUseCase.CreateAPIKey(ctx, usecase.APIKeyInput{
+		UserID: 5001,
+		Name:   "revoked",
+		Scopes: []string{string(entities.ScopeSchedulesRead)},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create api key: %v", err)
+	}
+	if err := apiKeyUseCase.RevokeAPIKey(ctx, 5001, revokedKey.ID); err != nil {
+		t.Fatalf("Failed to revoke api key: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		authorization  string
+		wantStatusCode int
+	}{
+		{
+			name:           "key reads schedules",
+			method:         http.MethodGet,
+			path:           "/schedules?user_id=5001",
+			authorization:  "ApiKey " + readKey.Key,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "key reads schedules of another user",
+			method:         http.MethodGet,
+			path:           "/schedules?user_id=5002",
+			authorization:  "ApiKey " + readKey.Key,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "key writes without scope",
+			method:         http.MethodPost,
+			path:           "/schedule",
+			body:           `{"user_id": 5001, "medicine_name": "Key Med", "frequency": 1}`,
+			authorization:  "ApiKey " + readKey.Key,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "key creates another key",
+			method:         http.MethodPost,
+			path:           "/api-keys",
+			body:           `{"user_id": 5001, "name": "escalated", "scopes": ["schedules:write"]}`,
+			authorization:  "ApiKey " + readKey.Key,
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "revoked key",
+			method:         http.MethodGet,
+			path:           "/schedules?user_id=5001",
+			authorization:  "ApiKey " + revokedKey.Key,
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "unknown key",
+			method:         http.MethodGet,
+			path:           "/schedules?user_id=5001",
+			authorization:  "ApiKey ptr_unknown",
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", tt.authorization)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("Expected status %d, got %d", tt.wantStatusCode, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestClinicianAccessHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	caregiverUseCase := usecase.NewCaregiverUseCase(testCaregiverRepo, testPolicy)
+	roleUseCase := usecase.NewRoleUseCase(testRoleRepo, testPolicy)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase, Caregiver: caregiverUseCase, Role: roleUseCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	scheduleID, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Patient Med",
+		Frequency:    2,
+		Duration:     30,
+		UserID:       6001,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	canView := false
+	if _, err := caregiverUseCase.LinkCaregiver(context.Background(), usecase.CaregiverInput{
+		UserID:      6001,
+		CaregiverID: 6002,
+		CanView:     &canView,
+	}); err != nil {
+		t.Fatalf("Failed to link clinician: %v", err)
+	}
+	if _, err := roleUseCase.SetRole(context.Background(), 6003, "admin"); err != nil {
+		t.Fatalf("Failed to set admin role: %v", err)
+	}
+
+	schedulePath := fmt.Sprintf("/schedule?user_id=6001&schedule_id=%d", scheduleID)
+	updateBody := fmt.Sprintf(`{"user_id": 6001, "schedule_id": %d, "medicine_name": "Patient Med", "frequency": 3, "duration": 10, "version": 1}`, scheduleID)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		actorID        string
+		wantStatusCode int
+	}{
+		{
+			name:           "patient views schedule of linked user",
+			method:         http.MethodGet,
+			path:           schedulePath,
+			actorID:        "6002",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "non-admin assigns role",
+			method:         http.MethodPut,
+			path:           "/roles",
+			body:           `{"user_id": 6002, "role": "clinician"}`,
+			actorID:        "6002",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "admin assigns clinician role",
+			method:         http.MethodPut,
+			path:           "/roles",
+			body:           `{"user_id": 6002, "role": "clinician"}`,
+			actorID:        "6003",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "clinician views schedule",
+			method:         http.MethodGet,
+			path:           schedulePath,
+			actorID:        "6002",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "clinician edits without consent",
+			method:         http.MethodPut,
+			path:           "/schedule",
+			body:           updateBody,
+			actorID:        "6002",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "patient gives consent",
+			method:         http.MethodPut,
+			path:           "/caregivers",
+			body:           `{"user_id": 6001, "caregiver_id": 6002, "can_edit": true}`,
+			actorID:        "6001",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "clinician edits with consent",
+			method:         http.MethodPut,
+			path:           "/schedule",
+			body:           updateBody,
+			actorID:        "6002",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "unlinked clinician views schedule",
+			method:         http.MethodGet,
+			path:           "/schedules?user_id=6004",
+			actorID:        "6002",
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "admin views schedule",
+			method:         http.MethodGet,
+			path:           schedulePath,
+			actorID:        "6003",
+			wantStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Actor-ID", tt.actorID)
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("Expected status %d, got %d", tt.wantStatusCode, resp.StatusCode)
+			}
+		})
+	}
+
+	var denied int
+	err = testDB.QueryRow("SELECT COUNT(*) FROM access_log WHERE user_id = 6001 AND actor_id = 6002 AND NOT allowed").Scan(&denied)
+	if err != nil {
+		t.Fatalf("Failed to query access log: %v", err)
+	}
+	if denied != 2 {
+		t.Errorf("Expected 2 denied accesses in the access log, got %d", denied)
+	}
+}
+
+func TestScheduleHistoryHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ctx := mw.WithActorID(context.Background(), 7001)
+	scheduleID, _, err := useCase.CreateSchedule(ctx, usecase.ScheduleInput{
+		MedicineName: "Audited Med",
+		Frequency:    2,
+		UserID:       7001,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+	if _, err := useCase.UpdateSchedule(ctx, usecase.UpdateScheduleInput{
+		ScheduleID:   scheduleID,
+		MedicineName: "Audited Med",
+		Frequency:    3,
+		UserID:       7001,
+		Version:      1,
+	}); err != nil {
+		t.Fatalf("Failed to update test schedule: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/schedule/history?schedule_id=%d", server.URL, scheduleID), nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	req.Header.Set("X-Actor-ID", "7001")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	var history []api.AuditEntry
+	if err := json.NewDecoder(resp.Body).Decode(&history); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("Expected 2 history entries, got %d", len(history))
+	}
+	if *history[0].Action != api.ScheduleCreated || history[0].Before != nil {
+		t.Errorf("Unexpected first entry: %+v", history[0])
+	}
+	if *history[1].Action != api.ScheduleUpdated || *history[1].ActorId != 7001 {
+		t.Errorf("Unexpected second entry: %+v", history[1])
+	}
+	if (*history[1].Before)["frequency"] != float64(2) || (*history[1].After)["frequency"] != float64(3) {
+		t.Errorf("Unexpected snapshots: %v -> %v", *history[1].Before, *history[1].After)
+	}
+
+	req.Header.Set("X-Actor-ID", "7002")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status %d for a stranger, got %d", http.StatusForbidden, resp.StatusCode)
+	}
+
+	var legacyID int64
+	if err := testDB.QueryRow(`INSERT INTO schedules (medicine_name, start_date, user_id)
+		VALUES ('Legacy Med', CURRENT_DATE, 7001) RETURNING id`).Scan(&legacyID); err != nil {
+		t.Fatalf("Failed to insert a schedule without history: %v", err)
+	}
+	for _, tc := range []struct {
+		scheduleID int64
+		status     int
+	}{
+		{legacyID, http.StatusForbidden},
+		{legacyID + 1000, http.StatusNotFound},
+	} {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/schedule/history?schedule_id=%d", server.URL, tc.scheduleID), nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("X-Actor-ID", "7002")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tc.status {
+			t.Errorf("Expected status %d for schedule %d, got %d", tc.status, tc.scheduleID, resp.StatusCode)
+		}
+	}
+
+	if _, err := testDB.Exec("UPDATE schedule_audit SET action = 'forged'"); err == nil {
+		t.Error("Expected the audit log to reject updates")
+	}
+	if _, err := testDB.Exec("DELETE FROM schedule_audit"); err == nil {
+		t.Error("Expected the audit log to reject deletes")
+	}
+}
+
+func TestListSchedulesHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	for _, name := range []string{"Ibuprofen", "Aspirin", "Insulin", "Cetirizine", "Amoxicillin"} {
+		if _, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+			MedicineName: name,
+			Frequency:    2,
+			Duration:     10,
+			UserID:       8001,
+		}); err != nil {
+			t.Fatalf("Failed to create test schedule: %v", err)
+		}
+	}
+
+	list := func(t *testing.T, query string) (int, api.ScheduleList) {
+		t.Helper()
+		resp, err := http.Get(server.URL + "/schedule/list?user_id=8001&" + query)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var page api.ScheduleList
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+		}
+		return resp.StatusCode, page
+	}
+
+	var names []string
+	query := "sort=medicine_name&limit=2"
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("Expected the listing to end after 3 pages")
+		}
+		code, page := list(t, query)
+		if code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+		}
+		for _, schedule := range *page.Schedules {
+			names = append(names, *schedule.MedicineName)
+			if len(*schedule.TakingTime) != 2 {
+				t.Errorf("Expected 2 taking times of %s, got %v", *schedule.MedicineName, *schedule.TakingTime)
+			}
+		}
+		if page.NextCursor == nil {
+			break
+		}
+		query = "sort=medicine_name&limit=2&cursor=" + *page.NextCursor
+	}
+
+	want := []string{"Amoxicillin", "Aspirin", "Cetirizine", "Ibuprofen", "Insulin"}
+	if fmt.Sprint(names) != fmt.Sprint(want) {
+		t.Errorf("Expected %v, got %v", want, names)
+	}
+
+	if _, page := list(t, "medicine_prefix=i&order=desc&sort=medicine_name"); len(*page.Schedules) != 2 ||
+		*(*page.Schedules)[0].MedicineName != "Insulin" {
+		t.Errorf("Unexpected schedules with prefix: %+v", *page.Schedules)
+	}
+	if _, page := list(t, "status=finished"); len(*page.Schedules) != 0 {
+		t.Errorf("Expected no finished schedules, got %d", len(*page.Schedules))
+	}
+	if _, page := list(t, "from=2000-01-01&to=2000-12-31"); len(*page.Schedules) != 0 {
+		t.Errorf("Expected no schedules running in 2000, got %d", len(*page.Schedules))
+	}
+	if code, _ := list(t, "cursor=garbage"); code != http.StatusBadRequest {
+		t.Errorf("Expected status %d for an invalid cursor, got %d", http.StatusBadRequest, code)
+	}
+
+	_, page := list(t, "medicine_prefix=insulin")
+	insulin := (*page.Schedules)[0]
+	pause := func(t *testing.T, paused bool) api.ScheduleResponse {
+		t.Helper()
+		body := fmt.Sprintf(`{"user_id": 8001, "schedule_id": %d, "paused": %t}`, *insulin.Id, paused)
+		resp, err := http.Post(server.URL+"/schedule/pause", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		var schedule api.ScheduleResponse
+		if err := json.NewDecoder(resp.Body).Decode(&schedule); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		return schedule
+	}
+
+	if schedule := pause(t, true); !*schedule.Paused || *schedule.Version != *insulin.Version+1 {
+		t.Errorf("Expected the schedule to be paused, got %+v", schedule)
+	}
+	if schedule := pause(t, true); *schedule.Version != *insulin.Version+1 {
+		t.Errorf("Expected pausing a paused schedule to change nothing, got version %d", *schedule.Version)
+	}
+	if _, page := list(t, "status=paused"); len(*page.Schedules) != 1 || *(*page.Schedules)[0].Id != *insulin.Id {
+		t.Errorf("Expected the paused schedule, got %+v", *page.Schedules)
+	}
+	if _, page := list(t, "status=active"); len(*page.Schedules) != 4 {
+		t.Errorf("Expected 4 active schedules, got %d", len(*page.Schedules))
+	}
+
+	if schedule := pause(t, false); *schedule.Paused {
+		t.Errorf("Expected the schedule to be resumed, got %+v", schedule)
+	}
+	if _, page := list(t, "status=paused"); len(*page.Schedules) != 0 {
+		t.Errorf("Expected no paused schedules, got %d", len(*page.Schedules))
+	}
+
+	history, err := useCase.GetScheduleHistory(context.Background(), *insulin.Id)
+	if err != nil {
+		t.Fatalf("Failed to get history: %v", err)
+	}
+	var actions []string
+	for _, entry := range history {
+		actions = append(actions, entry.Action)
+	}
+	if want := "[schedule.created schedule.paused schedule.resumed]"; fmt.Sprint(actions) != want {
+		t.Errorf("Expected the history %s, got %v", want, actions)
+	}
+}
+
+func TestCalendarHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	takingRepo := postgres.NewTakingRepository(testDB, logger)
+	intakeRepo := postgres.NewIntakeRepository(testDB, logger)
+	intakeUseCase := usecase.NewIntakeUseCase(testRepo, intakeRepo, takingRepo, testPolicy)
+	calendarUseCase := usecase.NewCalendarUseCase(testRepo, intakeRepo, takingRepo,
+		postgres.NewCalendarFeedRepository(testDB, logger), postgres.NewProfileRepository(testDB, logger), testPolicy)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase, Calendar: calendarUseCase}, logger)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	scheduleID, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Aspirin",
+		Frequency:    1,
+		Duration:     10,
+		UserID:       8101,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	today := time.Now()
+	tomorrow := today.AddDate(0, 0, 1)
+	_, err = intakeUseCase.RecordIntake(context.Background(), usecase.IntakeInput{
+		UserID:     8101,
+		ScheduleID: scheduleID,
+		PlannedAt:  time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 15, 0, 0, 0, tomorrow.Location()),
+	})
+	if err != nil {
+		t.Fatalf("Failed to record test intake: %v", err)
+	}
+
+	calendar := func(t *testing.T, from, to time.Time) (int, []api.CalendarTaking) {
+		t.Helper()
+		resp, err := http.Get(fmt.Sprintf("%s/calendar?user_id=8101&from=%s&to=%s",
+			server.URL, from.Format(time.DateOnly), to.Format(time.DateOnly)))
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var takings []api.CalendarTaking
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&takings); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+		}
+		return resp.StatusCode, takings
+	}
+
+	code, takings := calendar(t, today, today.AddDate(0, 0, 2))
+	if code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+	}
+	if len(takings) != 3 {
+		t.Fatalf("Expected 3 takings, got %+v", takings)
+	}
+	if takings[1].Status != api.CalendarTakingStatusTaken || takings[1].TakenAt == nil {
+		t.Errorf("Expected tomorrow's taking to be taken, got %+v", takings[1])
+	}
+	if takings[2].Status != api.CalendarTakingStatusUpcoming {
+		t.Errorf("Expected the last taking to be upcoming, got %s", takings[2].Status)
+	}
+
+	if _, takings := calendar(t, today.AddDate(0, 0, 20), today.AddDate(0, 0, 30)); len(takings) != 0 {
+		t.Errorf("Expected no takings after the schedule ended, got %d", len(takings))
+	}
+	if code, _ := calendar(t, today, today.AddDate(0, 0, -1)); code != http.StatusBadRequest {
+		t.Errorf("Expected status %d for a reversed range, got %d", http.StatusBadRequest, code)
+	}
+	if code, _ := calendar(t, today, today.AddDate(0, 0, usecase.MaxCalendarDays)); code != http.StatusBadRequest {
+		t.Errorf("Expected status %d for a too long range, got %d", http.StatusBadRequest, code)
+	}
+}
+
+func TestCalendarFeedHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	profileRepo := postgres.NewProfileRepository(testDB, logger)
+	calendarUseCase := usecase.NewCalendarUseCase(testRepo, postgres.NewIntakeRepository(testDB, logger),
+		postgres.NewTakingRepository(testDB, logger), postgres.NewCalendarFeedRepository(testDB, logger), profileRepo, testPolicy)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase, Calendar: calendarUseCase}, logger)
+
+	// No credentials are accepted, only the public feed can be reached.
+	locked := chi.NewRouter()
+	locked.Use(mw.HTTPAuthMiddleware(mw.Schemes{}, logger, httpHandler.PublicPaths...))
+	handler.RegisterRoutes(locked)
+	lockedServer := httptest.NewServer(locked)
+	defer lockedServer.Close()
+
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	if _, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Aspirin",
+		Frequency:    2,
+		Duration:     10,
+		UserID:       8201,
+	}); err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	do := func(t *testing.T, method, url, actorID string) (*http.Response, string) {
+		t.Helper()
+		req, err := http.NewRequest(method, url, nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		if actorID != "" {
+			req.Header.Set("X-Actor-ID", actorID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var body bytes.Buffer
+		if _, err := body.ReadFrom(resp.Body); err != nil {
+			t.Fatalf("Failed to read response: %v", err)
+		}
+		return resp, body.String()
+	}
+
+	resp, calendar := do(t, http.MethodGet, server.URL+"/schedules.ics?user_id=8201", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	for _, want := range []string{"BEGIN:VCALENDAR\r\n", "X-WR-CALNAME:Medicines\r\n", "RRULE:FREQ=DAILY;UNTIL=",
+		"SUMMARY:Aspirin\r\n", "DESCRIPTION:Take Aspirin\r\n", "BEGIN:VALARM\r\n"} {
+		if !strings.Contains(calendar, want) {
+			t.Errorf("Expected the calendar to contain %q, got %s", want, calendar)
+		}
+	}
+	if n := strings.Count(calendar, "BEGIN:VEVENT"); n != 2 {
+		t.Errorf("Expected an event per taking time, got %d", n)
+	}
+
+	if resp, _ := do(t, http.MethodPost, server.URL+"/calendar/feed?user_id=8201", "7001"); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status %d for a stranger, got %d", http.StatusForbidden, resp.StatusCode)
+	}
+
+	resp, body := do(t, http.MethodPost, server.URL+"/calendar/feed?user_id=8201", "8201")
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d", http.StatusCreated, resp.StatusCode)
+	}
+	var feed api.CalendarFeed
+	if err := json.Unmarshal([]byte(body), &feed); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+
+	if resp, _ := do(t, http.MethodGet, lockedServer.URL+"/schedules.ics?user_id=8201", ""); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected status %d without credentials, got %d", http.StatusUnauthorized, resp.StatusCode)
+	}
+	resp, body = do(t, http.MethodGet, lockedServer.URL+*feed.Path, "")
+	if resp.StatusCode != http.StatusOK || !strings.Contains(body, "SUMMARY:Aspirin") {
+		t.Errorf("Expected the feed without credentials, got %d: %s", resp.StatusCode, body)
+	}
+
+	// Calendar apps send no Accept-Language, the feed follows the profile of
+	// the owner.
+	if err := profileRepo.Save(context.Background(), entities.NewProfile(8201, "ru")); err != nil {
+		t.Fatalf("Failed to save profile: %v", err)
+	}
+	_, body = do(t, http.MethodGet, lockedServer.URL+*feed.Path, "")
+	for _, want := range []string{"X-WR-CALNAME:Лекарства\r\n", "DESCRIPTION:Принять Aspirin\r\n"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected the feed to contain %q, got %s", want, body)
+		}
+	}
+
+	if resp, _ := do(t, http.MethodDelete, server.URL+"/calendar/feed?user_id=8201", "8201"); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected status %d, got %d", http.StatusNoContent, resp.StatusCode)
+	}
+	if resp, _ := do(t, http.MethodGet, lockedServer.URL+*feed.Path, ""); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status %d for a revoked feed, got %d", http.StatusNotFound, resp.StatusCode)
+	}
+}
+
+func TestImportICSHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"UID:morning",
+		"SUMMARY:Aspirin",
+		"DTSTART:20250511T080000",
+		"RRULE:FREQ=DAILY;UNTIL=20250520",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:evening",
+		"SUMMARY:Aspirin",
+		"DTSTART:20250511T200000",
+		"RRULE:FREQ=DAILY;UNTIL=20250520",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:vitamins",
+		"SUMMARY:Vitamin D",
+		"DTSTART:20250511T090000",
+		"RRULE:FREQ=DAILY;COUNT=10;BYHOUR=9,21",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:weekly",
+		"SUMMARY:Methotrexate",
+		"DTSTART:20250511T090000",
+		"RRULE:FREQ=WEEKLY",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:once",
+		"SUMMARY:Vaccine",
+		"DTSTART;VALUE=DATE:20250511",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n") + "\r\n"
+
+	upload := func(t *testing.T, query, actorID, body string) (int, api.ICSImportReport) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/schedules.ics?user_id=8301&"+query, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "text/calendar")
+		if actorID != "" {
+			req.Header.Set("X-Actor-ID", actorID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var report api.ICSImportReport
+		if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+			if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+		}
+		return resp.StatusCode, report
+	}
+
+	code, report := upload(t, "dry_run=true", "", calendar)
+	if code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+	}
+	if len(*report.Schedules) != 2 || len(*report.Skipped) != 2 {
+		t.Fatalf("Expected 2 schedules and 2 skipped events, got %+v", report)
+	}
+	aspirin := (*report.Schedules)[0]
+	if *aspirin.MedicineName != "Aspirin" || fmt.Sprint(*aspirin.TakingTime) != "[08:00 20:00]" ||
+		*aspirin.StartDate != "11 May 2025" || *aspirin.EndDate != "21 May 2025" || *aspirin.Id != 0 {
+		t.Errorf("Unexpected merged schedule: %+v", aspirin)
+	}
+	if vitamins := (*report.Schedules)[1]; *vitamins.EndDate != "16 May 2025" {
+		t.Errorf("Expected COUNT=10 of 2 daily takings to end on 16 May 2025, got %s", *vitamins.EndDate)
+	}
+	if uid := *(*report.Skipped)[0].Uid; uid != "weekly" {
+		t.Errorf("Expected the weekly event to be skipped first, got %s", uid)
+	}
+	count := func(t *testing.T) int {
+		t.Helper()
+		list, err := useCase.ListSchedules(context.Background(), usecase.ListSchedulesInput{UserID: 8301})
+		if err != nil {
+			t.Fatalf("Failed to list schedules: %v", err)
+		}
+		return len(list.Schedules)
+	}
+	if n := count(t); n != 0 {
+		t.Errorf("Expected a dry run to create nothing, got %d schedules", n)
+	}
+
+	if code, _ := upload(t, "", "7001", calendar); code != http.StatusForbidden {
+		t.Errorf("Expected status %d for a stranger, got %d", http.StatusForbidden, code)
+	}
+	if code, _ := upload(t, "", "", "not a calendar"); code != http.StatusBadRequest {
+		t.Errorf("Expected status %d for garbage, got %d", http.StatusBadRequest, code)
+	}
+
+	code, report = upload(t, "", "", calendar)
+	if code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d", http.StatusCreated, code)
+	}
+	for _, schedule := range *report.Schedules {
+		if *schedule.Id == 0 {
+			t.Errorf("Expected the created schedule to have an ID: %+v", schedule)
+		}
+	}
+	if n := count(t); n != 2 {
+		t.Errorf("Expected 2 schedules, got %d", n)
+	}
+
+	code, report = upload(t, "", "", calendar)
+	if code != http.StatusCreated || len(*report.Schedules) != 0 || len(*report.Skipped) != 4 {
+		t.Errorf("Expected existing schedules to be skipped, got %d: %+v", code, report)
+	}
+
+	interacting := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"UID:statin",
+		"SUMMARY:Simvastatin",
+		"DTSTART:20250511T200000",
+		"RRULE:FREQ=DAILY",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:antibiotic",
+		"SUMMARY:Clarithromycin",
+		"DTSTART:20250511T080000",
+		"RRULE:FREQ=DAILY;COUNT=7",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n") + "\r\n"
+	code, report = upload(t, "dry_run=true", "", interacting)
+	if code != http.StatusOK || len(*report.Schedules) != 1 || len(*report.Skipped) != 1 ||
+		*(*report.Skipped)[0].Reason != "medicine is contraindicated with the medicine of an active schedule" {
+		t.Errorf("Expected the contraindicated medicine to be skipped, got %d: %+v", code, report)
+	}
+}
+
+func TestExportImportHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	intakeRepo := postgres.NewIntakeRepository(testDB, logger)
+	scheduleUseCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	exportUseCase := usecase.NewExportUseCase(testRepo, intakeRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: scheduleUseCase, Export: exportUseCase}, logger)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ctx := context.Background()
+	scheduleID, _, err := scheduleUseCase.CreateSchedule(ctx, usecase.ScheduleInput{
+		MedicineName: "Aspirin",
+		Dose:         500,
+		Frequency:    1,
+		Duration:     10,
+		UserID:       8401,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create schedule: %v", err)
+	}
+	now := time.Now()
+	plannedAt := time.Date(now.Year(), now.Month(), now.Day(), 15, 0, 0, 0, now.Location())
+	_, err = intakeRepo.Create(ctx, &entities.Intake{
+		ScheduleID: scheduleID,
+		UserID:     8401,
+		PlannedAt:  plannedAt,
+		TakenAt:    plannedAt.Add(5 * time.Minute),
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to record intake: %v", err)
+	}
+
+	export := func(t *testing.T, format string) (*http.Response, []byte) {
+		t.Helper()
+		resp, err := http.Get(server.URL + "/export?user_id=8401&format=" + format)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+		var body bytes.Buffer
+		if _, err := body.ReadFrom(resp.Body); err != nil {
+			t.Fatalf("Failed to read response: %v", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		return resp, body.Bytes()
+	}
+
+	_, document := export(t, "json")
+	var data api.UserDataExport
+	if err := json.Unmarshal(document, &data); err != nil {
+		t.Fatalf("Failed to decode export: %v", err)
+	}
+	if len(*data.Schedules) != 1 {
+		t.Fatalf("Expected 1 schedule, got %+v", data)
+	}
+	schedule := (*data.Schedules)[0]
+	if *schedule.MedicineName != "Aspirin" || fmt.Sprint(*schedule.TakingTimes) != "[15:00]" ||
+		*schedule.StartDate != now.Format("2006-01-02") || *schedule.EndDate != now.AddDate(0, 0, 10).Format("2006-01-02") ||
+		len(*schedule.Intakes) != 1 || !(*schedule.Intakes)[0].PlannedAt.Equal(plannedAt) ||
+		schedule.Dose == nil || *schedule.Dose != 500 || schedule.MedicineId == nil || *schedule.MedicineId != "acetylsalicylic-acid" {
+		t.Errorf("Unexpected exported schedule: %+v", schedule)
+	}
+
+	resp, archive := export(t, "csv")
+	if resp.Header.Get("Content-Type") != "application/zip" || !bytes.HasPrefix(archive, []byte("PK")) {
+		t.Errorf("Expected a ZIP archive, got %s", resp.Header.Get("Content-Type"))
+	}
+
+	upload := func(t *testing.T, query, actorID string, body []byte) (int, api.ImportReport) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/import?user_id=8402&"+query, bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		if actorID != "" {
+			req.Header.Set("X-Actor-ID", actorID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var report api.ImportReport
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&report); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+		}
+		return resp.StatusCode, report
+	}
+	result := func(t *testing.T, report api.ImportReport) api.ImportedSchedule {
+		t.Helper()
+		if report.Schedules == nil || len(*report.Schedules) != 1 {
+			t.Fatalf("Expected 1 imported schedule, got %+v", report)
+		}
+		return (*report.Schedules)[0]
+	}
+
+	if code, _ := upload(t, "format=csv", "7001", archive); code != http.StatusForbidden {
+		t.Errorf("Expected status %d for a stranger, got %d", http.StatusForbidden, code)
+	}
+	if code, _ := upload(t, "format=csv", "", []byte("not a zip")); code != http.StatusBadRequest {
+		t.Errorf("Expected status %d for garbage, got %d", http.StatusBadRequest, code)
+	}
+
+	code, report := upload(t, "format=csv", "", archive)
+	if code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+	}
+	if imported := result(t, report); *imported.Action != api.Created || *imported.Intakes != 1 || *imported.ScheduleId == scheduleID {
+		t.Errorf("Expected a new schedule with its intake, got %+v", imported)
+	}
+
+	code, report = upload(t, "format=csv&policy=merge", "", archive)
+	if imported := result(t, report); code != http.StatusOK || *imported.Action != api.Unchanged || *imported.Intakes != 0 {
+		t.Errorf("Expected the same import to change nothing, got %d: %+v", code, imported)
+	}
+
+	*schedule.TakingTimes = []string{"08:00"}
+	schedule.Dose = nil
+	(*data.Schedules)[0] = schedule
+	changed, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("Failed to encode export: %v", err)
+	}
+
+	code, report = upload(t, "policy=skip", "", changed)
+	if imported := result(t, report); code != http.StatusOK || *imported.Action != api.Skipped {
+		t.Errorf("Expected the existing schedule to be skipped, got %d: %+v", code, imported)
+	}
+
+	code, report = upload(t, "policy=merge", "", changed)
+	if imported := result(t, report); code != http.StatusOK || *imported.Action != api.Merged {
+		t.Errorf("Expected the schedules to be merged, got %d: %+v", code, imported)
+	}
+	list, err := scheduleUseCase.ListSchedules(ctx, usecase.ListSchedulesInput{UserID: 8402})
+	if err != nil {
+		t.Fatalf("Failed to list schedules: %v", err)
+	}
+	if len(list.Schedules) != 1 || fmt.Sprint(list.Schedules[0].TakingTimes) != "[08:00 15:00]" || list.Schedules[0].Dose != 500 {
+		t.Errorf("Expected one schedule with both taking times and the imported dose, got %+v", list.Schedules)
+	}
+
+	code, report = upload(t, "policy=replace", "", changed)
+	if imported := result(t, report); code != http.StatusOK || *imported.Action != api.Replaced {
+		t.Errorf("Expected the schedule to be replaced, got %d: %+v", code, imported)
+	}
+	list, err = scheduleUseCase.ListSchedules(ctx, usecase.ListSchedulesInput{UserID: 8402})
+	if err != nil {
+		t.Fatalf("Failed to list schedules: %v", err)
+	}
+	if len(list.Schedules) != 1 || list.Schedules[0].Dose != 500 {
+		t.Errorf("Expected an import without a dose to keep the dose, got %+v", list.Schedules)
+	}
+}
+
+func TestImportRollbackHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	intakeRepo := postgres.NewIntakeRepository(testDB, logger)
+	scheduleUseCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	exportUseCase := usecase.NewExportUseCase(testRepo, intakeRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: scheduleUseCase, Export: exportUseCase}, logger)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ctx := context.Background()
+	scheduleID, _, err := scheduleUseCase.CreateSchedule(ctx, usecase.ScheduleInput{
+		MedicineName: "Ibuprofen",
+		Frequency:    1,
+		Duration:     10,
+		UserID:       8451,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create schedule: %v", err)
+	}
+
+	// The schedule in the middle of the document fails to be written after
+	// the first one is merged.
+	if _, err := testDB.Exec(`
+		CREATE OR REPLACE FUNCTION reject_broken_med() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'broken medicine';
+		END;
+		$$ LANGUAGE plpgsql;
+		CREATE TRIGGER reject_broken_med BEFORE INSERT ON schedules
+		FOR EACH ROW WHEN (NEW.medicine_name = 'Broken Med') EXECUTE FUNCTION reject_broken_med()`); err != nil {
+		t.Fatalf("Failed to create trigger: %v", err)
+	}
+	dropTrigger := func() {
+		if _, err := testDB.Exec("DROP TRIGGER IF EXISTS reject_broken_med ON schedules"); err != nil {
+			t.Fatalf("Failed to drop trigger: %v", err)
+		}
+	}
+	defer dropTrigger()
+
+	now := time.Now()
+	start := now.Format("2006-01-02")
+	plannedAt := time.Date(now.Year(), now.Month(), now.Day(), 20, 0, 0, 0, now.Location()).Format(time.RFC3339)
+	document := fmt.Sprintf(`{"version": 1, "schedules": [
+		{"medicine_name": "Ibuprofen", "start_date": %[1]q, "taking_times": ["20:00"],
+		 "intakes": [{"planned_at": %[2]q, "taken_at": %[2]q}]},
+		{"medicine_name": "Broken Med", "start_date": %[1]q, "taking_times": ["09:00"]},
+		{"medicine_name": "Cetirizine", "start_date": %[1]q, "taking_times": ["20:00"],
+		 "intakes": [{"planned_at": %[2]q, "taken_at": %[2]q}]}
+	]}`, start, plannedAt)
+
+	upload := func(t *testing.T) int {
+		t.Helper()
+		resp, err := http.Post(server.URL+"/import?user_id=8451&policy=merge", "application/json", strings.NewReader(document))
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if code := upload(t); code != http.StatusInternalServerError {
+		t.Fatalf("Expected status %d, got %d", http.StatusInternalServerError, code)
+	}
+	list, err := scheduleUseCase.ListSchedules(ctx, usecase.ListSchedulesInput{UserID: 8451})
+	if err != nil {
+		t.Fatalf("Failed to list schedules: %v", err)
+	}
+	if len(list.Schedules) != 1 || fmt.Sprint(list.Schedules[0].TakingTimes) != "[15:00]" || list.Schedules[0].Version != 1 {
+		t.Errorf("Expected the failed import to leave the schedule as it was, got %+v", list.Schedules)
+	}
+	var intakes, entries int
+	if err := testDB.QueryRow("SELECT COUNT(*) FROM intakes WHERE user_id = 8451").Scan(&intakes); err != nil {
+		t.Fatalf("Failed to count intakes: %v", err)
+	}
+	if err := testDB.QueryRow("SELECT COUNT(*) FROM schedule_audit WHERE user_id = 8451").Scan(&entries); err != nil {
+		t.Fatalf("Failed to count audit entries: %v", err)
+	}
+	if intakes != 0 || entries != 1 {
+		t.Errorf("Expected no intakes and only the creation entry, got %d intakes and %d entries", intakes, entries)
+	}
+
+	dropTrigger()
+	if code := upload(t); code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+	}
+	list, err = scheduleUseCase.ListSchedules(ctx, usecase.ListSchedulesInput{UserID: 8451})
+	if err != nil {
+		t.Fatalf("Failed to list schedules: %v", err)
+	}
+	if len(list.Schedules) != 3 || list.Schedules[0].ID != scheduleID ||
+		fmt.Sprint(list.Schedules[0].TakingTimes) != "[15:00 20:00]" {
+		t.Errorf("Expected the import to merge one schedule and create two, got %+v", list.Schedules)
+	}
+}
+
+func TestFHIRHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	intakeRepo := postgres.NewIntakeRepository(testDB, logger)
+	scheduleUseCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	exportUseCase := usecase.NewExportUseCase(testRepo, intakeRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: scheduleUseCase, Export: exportUseCase}, logger)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	upload := func(t *testing.T, sample string) (int, api.ScheduleResponse) {
+		t.Helper()
+		data, err := os.ReadFile("../pkg/fhir/testdata/" + sample)
+		if err != nil {
+			t.Fatalf("Failed to read sample: %v", err)
+		}
+		resp, err := http.Post(server.URL+"/fhir/MedicationRequest?user_id=8501", "application/fhir+json", bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var schedule api.ScheduleResponse
+		if resp.StatusCode == http.StatusCreated {
+			if err := json.NewDecoder(resp.Body).Decode(&schedule); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+		}
+		return resp.StatusCode, schedule
+	}
+
+	code, schedule := upload(t, "medication-request-when.json")
+	if code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d", http.StatusCreated, code)
+	}
+	if *schedule.MedicineName != "Metformin 500 mg" || fmt.Sprint(*schedule.TakingTime) != "[07:45 18:45]" ||
+		*schedule.StartDate != "11 May 2025" || *schedule.EndDate != "11 Jun 2025" {
+		t.Errorf("Unexpected schedule: %+v", schedule)
+	}
+	if code, _ := upload(t, "medication-request-when.json"); code != http.StatusConflict {
+		t.Errorf("Expected status %d for the same medication, got %d", http.StatusConflict, code)
+	}
+	if code, _ := upload(t, "medication-request-weekly.json"); code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status %d for a weekly dosage, got %d", http.StatusUnprocessableEntity, code)
+	}
+
+	plannedAt := time.Date(2025, 5, 12, 7, 45, 0, 0, time.Local)
+	_, err := intakeRepo.Create(context.Background(), &entities.Intake{
+		ScheduleID: *schedule.Id,
+		UserID:     8501,
+		PlannedAt:  plannedAt,
+		TakenAt:    plannedAt.Add(10 * time.Minute),
+	}, nil)
+	if err != nil {
+		t.Fatalf("Failed to record intake: %v", err)
+	}
+
+	bundle := func(t *testing.T, resourceType string) []map[string]any {
+		t.Helper()
+		resp, err := http.Get(server.URL + "/fhir/" + resourceType + "?user_id=8501")
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/fhir+json" {
+			t.Fatalf("Expected status %d with a FHIR bundle, got %d %s", http.StatusOK, resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+
+		var decoded struct {
+			ResourceType string `json:"resourceType"`
+			Entry        []struct {
+				Resource map[string]any `json:"resource"`
+			} `json:"entry"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+			t.Fatalf("Failed to decode bundle: %v", err)
+		}
+		if decoded.ResourceType != "Bundle" {
+			t.Fatalf("Expected a bundle, got %s", decoded.ResourceType)
+		}
+		resources := make([]map[string]any, len(decoded.Entry))
+		for i, entry := range decoded.Entry {
+			resources[i] = entry.Resource
+		}
+		return resources
+	}
+
+	statements := bundle(t, "MedicationStatement")
+	if len(statements) != 1 {
+		t.Fatalf("Expected 1 statement, got %d", len(statements))
+	}
+	statement := statements[0]
+	period := fmt.Sprint(statement["effectivePeriod"])
+	if statement["resourceType"] != "MedicationStatement" || statement["status"] != "completed" ||
+		period != "map[end:2025-06-10 start:2025-05-11]" {
+		t.Errorf("Unexpected statement: %v", statement)
+	}
+
+	administrations := bundle(t, "MedicationAdministration")
+	if len(administrations) != 1 {
+		t.Fatalf("Expected 1 administration, got %d", len(administrations))
+	}
+	administration := administrations[0]
+	reference := fmt.Sprintf("[map[reference:MedicationStatement/schedule-%d]]", *schedule.Id)
+	if administration["status"] != "completed" || fmt.Sprint(administration["supportingInformation"]) != reference {
+		t.Errorf("Unexpected administration: %v", administration)
+	}
+}
+
+func TestCreateSchedulesHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	scheduleUseCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: scheduleUseCase}, logger)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	post := func(t *testing.T, body string) (int, api.BatchScheduleResponse) {
+		t.Helper()
+		resp, err := http.Post(server.URL+"/schedules:batch", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var batch api.BatchScheduleResponse
+		if resp.StatusCode != http.StatusBadRequest {
+			if err := json.NewDecoder(resp.Body).Decode(&batch); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+		}
+		return resp.StatusCode, batch
+	}
+
+	code, batch := post(t, `{"schedules": [
+		{"medicine_name": "Aspirin", "frequency": 2, "user_id": 8601},
+		{"medicine_name": "Ibuprofen", "frequency": 0, "user_id": 8601}
+	]}`)
+	if code != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected status %d, got %d", http.StatusUnprocessableEntity, code)
+	}
+	results := *batch.Results
+	if *batch.Created != 0 || *results[0].Error != "aborted" || *results[1].Error != "invalid_input" {
+		t.Errorf("Unexpected all-or-nothing results: %+v", results)
+	}
+
+	code, batch = post(t, `{"mode": "best_effort", "schedules": [
+		{"medicine_name": "Aspirin", "frequency": 2, "user_id": 8601},
+		{"medicine_name": "Aspirin", "frequency": 3, "user_id": 8601},
+		{"medicine_name": "Vitamin D", "frequency": 1, "duration": 30, "user_id": 8601}
+	]}`)
+	if code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+	}
+	results = *batch.Results
+	if *batch.Created != 2 || results[0].Id == nil || *results[1].Error != "duplicate" || results[2].Id == nil {
+		t.Errorf("Unexpected best-effort results: %+v", results)
+	}
+
+	code, batch = post(t, `{"schedules": [
+		{"medicine_name": "Aspirin", "frequency": 2, "user_id": 8601},
+		{"medicine_name": "Aspirin", "frequency": 2, "user_id": 8602}
+	]}`)
+	if code != http.StatusUnprocessableEntity || *(*batch.Results)[0].Error != "schedule_exists" {
+		t.Errorf("Expected an existing schedule to abort the batch, got %d %+v", code, batch)
+	}
+
+	code, batch = post(t, `{"schedules": [{"medicine_name": "Aspirin", "frequency": 2, "user_id": 8602}]}`)
+	if code != http.StatusCreated || *batch.Created != 1 {
+		t.Errorf("Expected status %d, got %d %+v", http.StatusCreated, code, batch)
+	}
+
+	code, batch = post(t, `{"schedules": [{"medicine_id": "simvastatin", "frequency": 1, "user_id": 8604}]}`)
+	if code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d %+v", http.StatusCreated, code, batch)
+	}
+	code, batch = post(t, `{"mode": "best_effort", "schedules": [
+		{"medicine_id": "clarithromycin", "frequency": 2, "user_id": 8604},
+		{"medicine_name": "Vitamin D", "frequency": 1, "user_id": 8604}
+	]}`)
+	if code != http.StatusOK || *(*batch.Results)[0].Error != "interaction_contraindicated" || (*batch.Results)[1].Id == nil {
+		t.Errorf("Expected the contraindicated medicine to be rejected, got %d %+v", code, batch)
+	}
+	code, batch = post(t, `{"schedules": [{"medicine_id": "clarithromycin", "frequency": 2, "user_id": 8604, "override_interactions": true}]}`)
+	if code != http.StatusCreated {
+		t.Fatalf("Expected the override to create the schedule, got %d %+v", code, batch)
+	}
+	if warnings := (*batch.Results)[0].Warnings; warnings == nil || len(*warnings) != 1 ||
+		(*warnings)[0].MedicineId != "simvastatin" || (*warnings)[0].Severity != api.Contraindicated {
+		t.Errorf("Expected the overridden interaction in the warnings, got %+v", (*batch.Results)[0])
+	}
+
+	code, batch = post(t, `{"schedules": [
+		{"medicine_id": "levothyroxine", "frequency": 1, "user_id": 8605},
+		{"medicine_id": "calcium-carbonate", "frequency": 1, "user_id": 8605}
+	]}`)
+	if code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d %+v", http.StatusCreated, code, batch)
+	}
+	levothyroxine, err := testRepo.GetByID(context.Background(), 8605, *(*batch.Results)[0].Id)
+	if err != nil {
+		t.Fatalf("Failed to get schedule: %v", err)
+	}
+	calcium, err := testRepo.GetByID(context.Background(), 8605, *(*batch.Results)[1].Id)
+	if err != nil {
+		t.Fatalf("Failed to get schedule: %v", err)
+	}
+	if gap := calcium.TakingTimes[0].Time.Sub(levothyroxine.TakingTimes[0].Time).Abs(); gap < 4*time.Hour {
+		t.Errorf("Expected the schedules of one batch to be taken 4h apart, got %v", gap)
+	}
+
+	code, batch = post(t, `{"mode": "best_effort", "schedules": [
+		{"medicine_id": "paracetamol", "dose": 1000, "frequency": 3, "user_id": 8606},
+		{"medicine_id": "cefekon-d", "dose": 1000, "frequency": 2, "user_id": 8606}
+	]}`)
+	if code != http.StatusOK || (*batch.Results)[0].Id == nil || *(*batch.Results)[1].Error != "daily_dose_exceeded" {
+		t.Errorf("Expected the daily dose of one batch to be limited, got %d %+v", code, batch)
+	}
+
+	if code, _ := post(t, `{"mode": "sometimes", "schedules": [{"medicine_name": "Aspirin", "frequency": 2, "user_id": 8603}]}`); code != http.StatusBadRequest {
+		t.Errorf("Expected status %d for an unknown mode, got %d", http.StatusBadRequest, code)
+	}
+	if code, _ := post(t, `{"schedules": []}`); code != http.StatusBadRequest {
+		t.Errorf("Expected status %d for an empty batch, got %d", http.StatusBadRequest, code)
+	}
+}
+
+func TestIdempotencyKeyHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	scheduleUseCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	idempotencyUseCase := usecase.NewIdempotencyUseCase(postgres.NewIdempotencyRepository(testDB, logger), time.Hour, time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{
+		Schedule:    scheduleUseCase,
+		Idempotency: idempotencyUseCase,
+	}, logger)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	post := func(t *testing.T, key, body string, language ...string) (*http.Response, string) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/schedule", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if len(language) > 0 {
+			req.Header.Set("Accept-Language", language[0])
+		}
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Failed to read response: %v", err)
+		}
+		return resp, string(data)
+	}
+
+	body := `{"medicine_name": "Aspirin", "frequency": 2, "user_id": 8701}`
+	first, created := post(t, "retry-1", body)
+	if first.StatusCode != http.StatusOK || first.Header.Get("Idempotent-Replayed") != "" {
+		t.Fatalf("Expected the schedule to be created, got %d", first.StatusCode)
+	}
+
+	retry, replayed := post(t, "retry-1", body)
+	if retry.StatusCode != http.StatusOK || replayed != created {
+		t.Errorf("Expected the response %d %s to be replayed, got %d %s", first.StatusCode, created, retry.StatusCode, replayed)
+	}
+	if retry.Header.Get("Idempotent-Replayed") != "true" {
+		t.Error("Expected the replayed response to be marked")
+	}
+
+	list, err := scheduleUseCase.ListSchedules(context.Background(), usecase.ListSchedulesInput{UserID: 8701})
+	if err != nil {
+		t.Fatalf("Failed to list schedules: %v", err)
+	}
+	if len(list.Schedules) != 1 {
+		t.Errorf("Expected one schedule, got %d", len(list.Schedules))
+	}
+
+	if resp, _ := post(t, "retry-1", `{"medicine_name": "Ibuprofen", "frequency": 2, "user_id": 8701}`); resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status %d for a reused key, got %d", http.StatusUnprocessableEntity, resp.StatusCode)
+	}
+	if resp, _ := post(t, "retry-2", body); resp.StatusCode == http.StatusOK || resp.Header.Get("Idempotent-Replayed") != "" {
+		t.Errorf("Expected a new key to create the schedule again, got %d", resp.StatusCode)
+	}
+
+	invalid := `{"medicine_name": "Aspirin", "frequency": 0, "user_id": 8701}`
+	if resp, _ := post(t, "retry-3", invalid); resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Expected status %d, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+	if resp, _ := post(t, "retry-3", invalid); resp.StatusCode != http.StatusBadRequest || resp.Header.Get("Idempotent-Replayed") != "true" {
+		t.Errorf("Expected the client error to be replayed, got %d", resp.StatusCode)
+	}
+
+	localized, _ := post(t, "retry-4", `{"medicine_name": "Paracetamol", "frequency": 2, "user_id": 8701}`, "ru")
+	replayedLocalized, _ := post(t, "retry-4", `{"medicine_name": "Paracetamol", "frequency": 2, "user_id": 8701}`, "en")
+	if language := localized.Header.Get("Content-Language"); language != "ru" || replayedLocalized.Header.Get("Content-Language") != language {
+		t.Errorf("Expected the Content-Language %q to be replayed, got %q", language, replayedLocalized.Header.Get("Content-Language"))
+	}
+
+	now := time.Now()
+	clock := func() time.Time { return now }
+	entities.TimeNow, usecase.TimeNow = clock, clock
+	t.Cleanup(func() { entities.TimeNow, usecase.TimeNow = time.Now, time.Now })
+
+	ctx := context.Background()
+	crashed, stored, err := idempotencyUseCase.Begin(ctx, "crashed", "hash")
+	if crashed == nil || stored != nil || err != nil {
+		t.Fatalf("Expected the key to be reserved, got %v %v", stored, err)
+	}
+	if _, _, err := idempotencyUseCase.Begin(ctx, "crashed", "hash"); !errors.Is(err, mw.ErrIdempotencyKeyInUse) {
+		t.Errorf("Expected the locked key to be in use, got %v", err)
+	}
+	now = now.Add(2 * time.Minute)
+	reserved, stored, err := idempotencyUseCase.Begin(ctx, "crashed", "hash")
+	if reserved == nil || stored != nil || err != nil {
+		t.Fatalf("Expected the key of the crashed request to be reserved again, got %v %v", stored, err)
+	}
+
+	// The request which outlived its lock neither releases the key of the
+	// retry nor replaces its response.
+	if err := idempotencyUseCase.Release(ctx, *crashed); err != nil {
+		t.Fatalf("Failed to release the expired lease: %v", err)
+	}
+	if _, _, err := idempotencyUseCase.Begin(ctx, "crashed", "hash"); !errors.Is(err, mw.ErrIdempotencyKeyInUse) {
+		t.Errorf("Expected the key of the retry to stay in use, got %v", err)
+	}
+	if err := idempotencyUseCase.Complete(ctx, *crashed, mw.IdempotentResponse{StatusCode: http.StatusConflict}); err == nil {
+		t.Error("Expected the expired lease not to complete the key")
+	}
+	if err := idempotencyUseCase.Complete(ctx, *reserved, mw.IdempotentResponse{StatusCode: http.StatusCreated}); err != nil {
+		t.Fatalf("Failed to complete the key: %v", err)
+	}
+	if _, stored, err := idempotencyUseCase.Begin(ctx, "crashed", "hash"); err != nil || stored == nil || stored.StatusCode != http.StatusCreated {
+		t.Errorf("Expected the response of the retry to be stored, got %+v %v", stored, err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if deleted, err := idempotencyUseCase.Cleanup(ctx); err != nil || deleted == 0 {
+		t.Errorf("Expected the expired keys to be deleted, got %d %v", deleted, err)
+	}
+	if resp, _ := post(t, "retry-1", body); resp.Header.Get("Idempotent-Replayed") != "" {
+		t.Error("Expected the expired response not to be replayed")
+	}
+}
+
+func TestScheduleVersionHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
+	router := chi.NewRouter()
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	scheduleID, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Versioned Med",
+		Frequency:    2,
+		UserID:       8801,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	resp, err := http.Get(fmt.Sprintf("%s/schedule?user_id=8801&schedule_id=%d", server.URL, scheduleID))
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+	etag := resp.Header.Get("ETag")
+	if etag != `"1"` {
+		t.Fatalf("Expected ETag %q, got %q", `"1"`, etag)
+	}
+
+	update := func(t *testing.T, ifMatch string, frequency int) (*http.Response, api.ScheduleResponse) {
+		t.Helper()
+		body := fmt.Sprintf(`{"user_id": 8801, "schedule_id": %d, "medicine_name": "Versioned Med", "frequency": %d}`,
+			scheduleID, frequency)
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/schedule", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var schedule api.ScheduleResponse
+		if resp.StatusCode == http.StatusOK {
+			if err := json.NewDecoder(resp.Body).Decode(&schedule); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+		}
+		return resp, schedule
+	}
+
+	if resp, _ := update(t, "", 3); resp.StatusCode != http.StatusPreconditionRequired {
+		t.Errorf("Expected status %d without a version, got %d", http.StatusPreconditionRequired, resp.StatusCode)
+	}
+
+	resp, schedule := update(t, etag, 3)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if *schedule.Version != 2 || resp.Header.Get("ETag") != `"2"` {
+		t.Errorf("Expected version 2, got %d and ETag %q", *schedule.Version, resp.Header.Get("ETag"))
+	}
+
+	// The second caregiver still edits the first version.
+	if resp, _ := update(t, etag, 4); resp.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("Expected status %d for an outdated version, got %d", http.StatusPreconditionFailed, resp.StatusCode)
+	}
+	if resp, _ := update(t, `W/"2"`, 4); resp.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("Expected status %d for a weak ETag, got %d", http.StatusPreconditionFailed, resp.StatusCode)
+	}
+
+	current, err := useCase.GetSchedule(context.Background(), 8801, scheduleID)
+	if err != nil {
+		t.Fatalf("Failed to get schedule: %v", err)
+	}
+	if len(current.TakingTimes) != 3 || current.Version != 2 {
+		t.Errorf("Expected the first update to be kept, got %+v", current)
+	}
+}
+
+func TestProblemDetailsHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	scheduleID, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Problem Med",
+		Frequency:    2,
+		UserID:       8901,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	send := func(t *testing.T, method, path, body string) (int, api.Error) {
+		t.Helper()
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if contentType := resp.Header.Get("Content-Type"); contentType != "application/problem+json" {
+			t.Fatalf("Expected problem content type, got %q", contentType)
+		}
+		var problem api.Error
+		if err := json.NewDecoder(resp.Body).Decode(&problem); err != nil {
+			t.Fatalf("Failed to decode problem: %v", err)
+		}
+		if problem.Status != resp.StatusCode {
+			t.Errorf("Expected status %d in the problem, got %d", resp.StatusCode, problem.Status)
+		}
+		if problem.TraceId == nil || *problem.TraceId != resp.Header.Get("X-TRACE-ID") {
+			t.Errorf("Expected the trace ID %q in the problem, got %v", resp.Header.Get("X-TRACE-ID"), problem.TraceId)
+		}
+		return resp.StatusCode, problem
+	}
+
+	code, problem := send(t, http.MethodPost, "/schedule", `{"user_id": 8901, "frequency": 20}`)
+	if code != http.StatusBadRequest || problem.Code != "validation_failed" {
+		t.Fatalf("Expected a validation failure, got %d %q", code, problem.Code)
+	}
+	fields := map[string]string{}
+	if problem.Errors != nil {
+		for _, field := range *problem.Errors {
+			fields[field.Field] = field.Code
+		}
+	}
+	if fields["medicine_name"] != "required" || fields["frequency"] != "lte" || len(fields) != 2 {
+		t.Errorf("Expected medicine_name and frequency to be invalid, got %v", fields)
+	}
+
+	code, problem = send(t, http.MethodGet, "/schedule?user_id=8901&schedule_id=abc", "")
+	if code != http.StatusBadRequest || problem.Errors == nil || (*problem.Errors)[0].Field != "schedule_id" {
+		t.Errorf("Expected schedule_id to be invalid, got %d %+v", code, problem.Errors)
+	}
+
+	body := fmt.Sprintf(`{"user_id": 8901, "schedule_id": %d, "medicine_name": "Problem Med", "frequency": 3}`, scheduleID)
+	code, problem = send(t, http.MethodPut, "/schedule", body)
+	if code != http.StatusPreconditionRequired || problem.Code != "version_required" {
+		t.Errorf("Expected version_required, got %d %q", code, problem.Code)
+	}
+
+	code, problem = send(t, http.MethodGet, fmt.Sprintf("/schedule?user_id=8901&schedule_id=%d", scheduleID+1), "")
+	if code != http.StatusNotFound || problem.Code != "not_found" {
+		t.Errorf("Expected not_found, got %d %q", code, problem.Code)
+	}
+}
+
+func TestProblemCodesHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{
+		Schedule:  useCase,
+		Inventory: usecase.NewInventoryUseCase(testRepo, postgres.NewInventoryRepository(testDB, logger), testPolicy),
+		Export: usecase.NewExportUseCase(testRepo, postgres.NewIntakeRepository(testDB, logger), testMedicineRepo,
+			testDoseLimitRepo, testInteractions, testPolicy),
+	}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	scheduleID, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Coded Med",
+		Frequency:    2,
+		UserID:       9101,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		actorID  string
+		wantCode string
+	}{
+		{"malformed body", http.MethodPost, "/schedule", `{`, "", "invalid_input"},
+		{"invalid field", http.MethodPost, "/schedule", `{"user_id": 9101, "frequency": 2}`, "", "validation_failed"},
+		{"invalid parameter", http.MethodGet, "/schedule?user_id=9101&schedule_id=abc", "", "", "validation_failed"},
+		{"unknown schedule", http.MethodGet, fmt.Sprintf("/schedule?user_id=9101&schedule_id=%d", scheduleID+1000), "", "", "not_found"},
+		{"stranger", http.MethodGet, fmt.Sprintf("/schedule?user_id=9101&schedule_id=%d", scheduleID), "", "9102", "permission_denied"},
+		{"duplicate schedule", http.MethodPost, "/schedule", `{"user_id": 9101, "medicine_name": "Coded Med", "frequency": 2}`, "", "schedule_exists"},
+		{"invalid history", http.MethodGet, "/schedule/history?schedule_id=0", "", "", "invalid_input"},
+		{"foreign history", http.MethodGet, fmt.Sprintf("/schedule/history?schedule_id=%d", scheduleID), "", "9102", "permission_denied"},
+		{"foreign refill", http.MethodPost, "/schedule/refill", fmt.Sprintf(`{"user_id": 9102, "schedule_id": %d, "quantity": 30}`, scheduleID), "", "not_found"},
+		{"unknown pause", http.MethodPost, "/schedule/pause", fmt.Sprintf(`{"user_id": 9101, "schedule_id": %d, "paused": true}`, scheduleID+1000), "", "not_found"},
+		{"garbage import", http.MethodPost, "/import?user_id=9101", "not a document", "", "invalid_input"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("Failed to create request: %v", err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			if tt.actorID != "" {
+				req.Header.Set("X-Actor-ID", tt.actorID)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if contentType := resp.Header.Get("Content-Type"); contentType != "application/problem+json" {
+				t.Fatalf("Expected problem content type, got %q", contentType)
+			}
+			var problem api.Error
+			if err := json.NewDecoder(resp.Body).Decode(&problem); err != nil {
+				t.Fatalf("Failed to decode problem: %v", err)
+			}
+			if problem.Code != tt.wantCode {
+				t.Errorf("Expected code %q, got %d %q", tt.wantCode, resp.StatusCode, problem.Code)
+			}
+		})
+	}
+}
+
+func TestLocaleHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	profileUseCase := usecase.NewProfileUseCase(postgres.NewProfileRepository(testDB, logger), testPolicy)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase, Profile: profileUseCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	scheduleID, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Localized Med",
+		Frequency:    2,
+		UserID:       9001,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	send := func(t *testing.T, method, path, body, acceptLanguage string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Actor-ID", "9001")
+		if acceptLanguage != "" {
+			req.Header.Set("Accept-Language", acceptLanguage)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	if resp := send(t, http.MethodPut, "/profile", `{"user_id": 9001, "locale": "de"}`, ""); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status %d for an unsupported locale, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+	if resp := send(t, http.MethodPut, "/profile", `{"user_id": 9001, "locale": "ru"}`, ""); resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	schedulePath := fmt.Sprintf("/schedule?user_id=9001&schedule_id=%d", scheduleID)
+	today := usecase.TimeNow()
+
+	resp := send(t, http.MethodGet, schedulePath, "", "")
+	var schedule api.ScheduleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&schedule); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if resp.Header.Get("Content-Language") != "ru" {
+		t.Errorf("Expected the locale of the profile, got %q", resp.Header.Get("Content-Language"))
+	}
+	if *schedule.StartDate != i18n.FormatDate(i18n.Ru, today) || *schedule.EndDate != "бессрочно" {
+		t.Errorf("Expected the dates in Russian, got %s - %s", *schedule.StartDate, *schedule.EndDate)
+	}
+
+	resp = send(t, http.MethodGet, schedulePath, "", "en-US,en;q=0.9")
+	if err := json.NewDecoder(resp.Body).Decode(&schedule); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if resp.Header.Get("Content-Language") != "en" || *schedule.StartDate != today.Format("02 Jan 2006") ||
+		*schedule.EndDate != "infinite" {
+		t.Errorf("Expected the header to select English, got %s - %s", *schedule.StartDate, *schedule.EndDate)
+	}
+
+	resp = send(t, http.MethodGet, fmt.Sprintf("/schedule?user_id=9001&schedule_id=%d", scheduleID+1), "", "")
+	var problem api.Error
+	if err := json.NewDecoder(resp.Body).Decode(&problem); err != nil {
+		t.Fatalf("Failed to decode problem: %v", err)
+	}
+	if problem.Code != "not_found" || problem.Detail == nil || *problem.Detail != "Расписание не найдено" {
+		t.Errorf("Expected the problem in Russian with a stable code, got %q %v", problem.Code, problem.Detail)
+	}
+}
+
+func TestScheduleV2HTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	infiniteID, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Typed Infinite Med",
+		Frequency:    2,
+		UserID:       9101,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+	weekID, _, err := useCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Typed Week Med",
+		Frequency:    3,
+		Duration:     7,
+		UserID:       9101,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	send := func(t *testing.T, method, path, body string, header http.Header) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header = header.Clone()
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Actor-ID", "9101")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	today := usecase.TimeNow().Format(time.DateOnly)
+
+	resp := send(t, http.MethodGet, fmt.Sprintf("/v2/schedule?user_id=9101&schedule_id=%d", infiniteID), "",
+		http.Header{"Accept-Language": {"ru"}})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	var raw map[string]json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if string(raw["start_date"]) != `"`+today+`"` || string(raw["end_date"]) != "null" {
+		t.Errorf("Expected an ISO start date and a null end date, got %s - %s", raw["start_date"], raw["end_date"])
+	}
+	etag := resp.Header.Get("ETag")
+
+	resp = send(t, http.MethodGet, fmt.Sprintf("/v2/schedule?user_id=9101&schedule_id=%d", weekID), "", nil)
+	var schedule apiv2.Schedule
+	if err := json.NewDecoder(resp.Body).Decode(&schedule); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if schedule.EndDate == nil || !schedule.EndDate.Equal(schedule.StartDate.AddDate(0, 0, 7)) {
+		t.Errorf("Expected the end date a week after the start, got %v - %v", schedule.StartDate, schedule.EndDate)
+	}
+	if len(schedule.TakingTimes) != 3 {
+		t.Errorf("Expected 3 taking times, got %v", schedule.TakingTimes)
+	}
+
+	body := fmt.Sprintf(`{"user_id": 9101, "schedule_id": %d, "medicine_name": "Typed Infinite Med", "frequency": 4}`, infiniteID)
+	resp = send(t, http.MethodPut, "/v2/schedule", body, http.Header{"If-Match": {etag}})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&schedule); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(schedule.TakingTimes) != 4 || schedule.EndDate != nil || schedule.Version != 2 {
+		t.Errorf("Unexpected updated schedule: %+v", schedule)
+	}
+
+	resp = send(t, http.MethodGet, "/v2/schedule/list?user_id=9101&sort=medicine_name&from="+today, "", nil)
+	var list apiv2.ScheduleList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(list.Schedules) != 2 || list.Schedules[0].Id != infiniteID || list.Schedules[1].Id != weekID {
+		t.Errorf("Expected both schedules sorted by medicine name, got %+v", list.Schedules)
+	}
+
+	resp = send(t, http.MethodGet, fmt.Sprintf("/schedule?user_id=9101&schedule_id=%d", infiniteID), "", nil)
+	var v1 api.ScheduleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&v1); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if v1.EndDate == nil || *v1.EndDate != "infinite" {
+		t.Errorf("Expected the first version to keep the formatted dates, got %v", v1.EndDate)
+	}
+}
+
+func TestNextTakingsWindowHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	now := time.Date(2025, 5, 11, 21, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	entities.TimeNow, usecase.TimeNow, postgres.TimeNow = clock, clock, clock
+	t.Cleanup(func() { entities.TimeNow, usecase.TimeNow, postgres.TimeNow = time.Now, time.Now, time.Now })
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	// Night Med is taken at 08:00 and 22:00, Noon Med at 15:00.
+	for _, input := range []usecase.ScheduleInput{
+		{MedicineName: "Night Med", Frequency: 2, UserID: 9201},
+		{MedicineName: "Noon Med", Frequency: 1, UserID: 9201},
+	} {
+		if _, _, err := useCase.CreateSchedule(context.Background(), input); err != nil {
+			t.Fatalf("Failed to create test schedule: %v", err)
+		}
+	}
+
+	get := func(t *testing.T, query string) (int, []apiv2.Taking, api.Error) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/v2/next_takings?user_id=9201"+query, nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("X-Actor-ID", "9201")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var takings []apiv2.Taking
+		var problem api.Error
+		if resp.StatusCode == http.StatusOK {
+			err = json.NewDecoder(resp.Body).Decode(&takings)
+		} else {
+			err = json.NewDecoder(resp.Body).Decode(&problem)
+		}
+		if err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		return resp.StatusCode, takings, problem
+	}
+
+	at := func(day, hour int) time.Time { return time.Date(2025, 5, day, hour, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name  string
+		query string
+		want  []time.Time
+	}{
+		{"configured window", "", []time.Time{at(11, 22)}},
+		{"across midnight", "&within=12h", []time.Time{at(11, 22), at(12, 8)}},
+		{"next one", "&limit=1", []time.Time{at(11, 22)}},
+		{"limit without window", "&limit=3", []time.Time{at(11, 22), at(12, 8), at(12, 15)}},
+		{"window and limit", "&within=48h&limit=4", []time.Time{at(11, 22), at(12, 8), at(12, 15), at(12, 22)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, takings, _ := get(t, tt.query)
+			if code != http.StatusOK {
+				t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+			}
+			if len(takings) != len(tt.want) {
+				t.Fatalf("Expected %d takings, got %+v", len(tt.want), takings)
+			}
+			for i, want := range tt.want {
+				if !takings[i].TakingTime.Equal(want) {
+					t.Errorf("Taking %d: expected %v, got %v", i, want, takings[i].TakingTime)
+				}
+			}
+		})
+	}
+
+	for _, query := range []string{"&within=soon", "&within=-1h", "&limit=0"} {
+		code, _, problem := get(t, query)
+		if code != http.StatusBadRequest || problem.Errors == nil || len(*problem.Errors) != 1 {
+			t.Errorf("Expected a field error for %q, got %d %+v", query, code, problem.Errors)
+		}
+	}
+}
+
+func TestMedicineCatalogHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{
+		Schedule: useCase,
+		Medicine: usecase.NewMedicineUseCase(testMedicineRepo),
+	}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	send := func(t *testing.T, method, path, body string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Actor-ID", "9301")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	for _, query := range []string{"асп", "ASPI", "thrombo", "кардио"} {
+		resp := send(t, http.MethodGet, "/medicines?q="+url.QueryEscape(query), "")
+		var medicines []api.Medicine
+		if err := json.NewDecoder(resp.Body).Decode(&medicines); err != nil {
+			t.Fatalf("Failed to decode medicines: %v", err)
+		}
+		if len(medicines) != 1 || medicines[0].Id != "acetylsalicylic-acid" || len(medicines[0].Synonyms) == 0 {
+			t.Errorf("Expected aspirin for %q, got %+v", query, medicines)
+		}
+	}
+	if resp := send(t, http.MethodGet, "/medicines?q=%20-", ""); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status %d for an empty query, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+
+	create := func(t *testing.T, body string) (int, int64, api.Error) {
+		t.Helper()
+		resp := send(t, http.MethodPost, "/schedule", body)
+		var id int64
+		var problem api.Error
+		var err error
+		if resp.StatusCode == http.StatusOK {
+			err = json.NewDecoder(resp.Body).Decode(&id)
+		} else {
+			err = json.NewDecoder(resp.Body).Decode(&problem)
+		}
+		if err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		return resp.StatusCode, id, problem
+	}
+	get := func(t *testing.T, id int64) api.ScheduleResponse {
+		t.Helper()
+		resp := send(t, http.MethodGet, fmt.Sprintf("/schedule?user_id=9301&schedule_id=%d", id), "")
+		var schedule api.ScheduleResponse
+		if err := json.NewDecoder(resp.Body).Decode(&schedule); err != nil {
+			t.Fatalf("Failed to decode schedule: %v", err)
+		}
+		return schedule
+	}
+
+	code, id, _ := create(t, `{"medicine_name": "Aspirin ", "frequency": 1, "user_id": 9301}`)
+	if code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+	}
+	if schedule := get(t, id); schedule.MedicineId == nil || *schedule.MedicineId != "acetylsalicylic-acid" {
+		t.Errorf("Expected the schedule to reference the catalog, got %v", schedule.MedicineId)
+	}
+
+	code, id, _ = create(t, `{"medicine_id": "paracetamol", "frequency": 2, "user_id": 9301}`)
+	if code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+	}
+	if schedule := get(t, id); *schedule.MedicineName != "Paracetamol" {
+		t.Errorf("Expected the schedule to be named after the catalog, got %q", *schedule.MedicineName)
+	}
+
+	code, id, _ = create(t, `{"medicine_name": "Jelly bears", "frequency": 1, "user_id": 9301}`)
+	if code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+	}
+	if schedule := get(t, id); schedule.MedicineId != nil {
+		t.Errorf("Expected a custom medicine, got %q", *schedule.MedicineId)
+	}
+
+	for _, name := range []string{"aspirin", "Аспирин", "Тромбо АСС", "Panadol", "jelly-bears"} {
+		code, _, problem := create(t, fmt.Sprintf(`{"medicine_name": %q, "frequency": 1, "user_id": 9301}`, name))
+		if code != http.StatusConflict || problem.Code != "schedule_exists" {
+			t.Errorf("Expected %q to be the same medicine, got %d %q", name, code, problem.Code)
+		}
+	}
+
+	code, _, problem := create(t, `{"medicine_id": "unknown", "frequency": 1, "user_id": 9301}`)
+	if code != http.StatusNotFound || problem.Code != "medicine_not_found" {
+		t.Errorf("Expected medicine_not_found, got %d %q", code, problem.Code)
+	}
+}
+
+func TestMedicineInteractionsHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	create := func(t *testing.T, body string) (int, apiv2.ScheduleCreated, apiv2.Error) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/v2/schedule", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Actor-ID", "9401")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var created apiv2.ScheduleCreated
+		var problem apiv2.Error
+		if resp.StatusCode == http.StatusCreated {
+			err = json.NewDecoder(resp.Body).Decode(&created)
+		} else {
+			err = json.NewDecoder(resp.Body).Decode(&problem)
+		}
+		if err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		return resp.StatusCode, created, problem
+	}
+
+	code, created, _ := create(t, `{"medicine_name": "Варфарин", "frequency": 1, "user_id": 9401}`)
+	if code != http.StatusCreated || len(created.Warnings) != 0 {
+		t.Fatalf("Expected status %d with no warnings, got %d %+v", http.StatusCreated, code, created.Warnings)
+	}
+	warfarinID := created.Id
+
+	code, created, _ = create(t, `{"medicine_name": "Nurofen", "frequency": 2, "user_id": 9401}`)
+	if code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d", http.StatusCreated, code)
+	}
+	if len(created.Warnings) != 1 || created.Warnings[0].ScheduleId != warfarinID ||
+		created.Warnings[0].Severity != apiv2.Major || created.Warnings[0].MedicineId != "warfarin" {
+		t.Errorf("Expected a major interaction with warfarin, got %+v", created.Warnings)
+	}
+
+	code, _, _ = create(t, `{"medicine_name": "Jelly bears", "frequency": 1, "user_id": 9401}`)
+	if code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d", http.StatusCreated, code)
+	}
+
+	code, _, _ = create(t, `{"medicine_id": "simvastatin", "frequency": 1, "user_id": 9401}`)
+	if code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d", http.StatusCreated, code)
+	}
+
+	code, _, problem := create(t, `{"medicine_id": "clarithromycin", "frequency": 2, "user_id": 9401}`)
+	if code != http.StatusConflict || problem.Code != "interaction_contraindicated" {
+		t.Fatalf("Expected interaction_contraindicated, got %d %q", code, problem.Code)
+	}
+
+	code, created, _ = create(t, `{"medicine_id": "clarithromycin", "frequency": 2, "user_id": 9401, "override_interactions": true}`)
+	if code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d", http.StatusCreated, code)
+	}
+	if len(created.Warnings) != 2 || created.Warnings[0].Severity != apiv2.Contraindicated ||
+		created.Warnings[1].Severity != apiv2.Major {
+		t.Errorf("Expected the contraindication before the warfarin interaction, got %+v", created.Warnings)
+	}
+}
+
+func TestUpdateScheduleInteractionsHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ctx := mw.WithActorID(context.Background(), 9701)
+	if _, _, err := useCase.CreateSchedule(ctx, usecase.ScheduleInput{MedicineID: "simvastatin", Frequency: 1, UserID: 9701}); err != nil {
+		t.Fatalf("Failed to create schedule: %v", err)
+	}
+	id, _, err := useCase.CreateSchedule(ctx, usecase.ScheduleInput{MedicineName: "Jelly bears", Frequency: 2, UserID: 9701})
+	if err != nil {
+		t.Fatalf("Failed to create schedule: %v", err)
+	}
+
+	update := func(t *testing.T, body string) (int, apiv2.Schedule, apiv2.Error) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/v2/schedule", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Actor-ID", "9701")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var schedule apiv2.Schedule
+		var problem apiv2.Error
+		if resp.StatusCode == http.StatusOK {
+			err = json.NewDecoder(resp.Body).Decode(&schedule)
+		} else {
+			err = json.NewDecoder(resp.Body).Decode(&problem)
+		}
+		if err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		return resp.StatusCode, schedule, problem
+	}
+
+	code, _, problem := update(t, fmt.Sprintf(`{"schedule_id": %d, "medicine_id": "clarithromycin", "frequency": 2, "user_id": 9701, "version": 1}`, id))
+	if code != http.StatusConflict || problem.Code != "interaction_contraindicated" {
+		t.Fatalf("Expected interaction_contraindicated, got %d %q", code, problem.Code)
+	}
+
+	code, schedule, _ := update(t, fmt.Sprintf(`{"schedule_id": %d, "medicine_id": "clarithromycin", "frequency": 2, "user_id": 9701, "version": 1,
+		"override_interactions": true}`, id))
+	if code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, code)
+	}
+	if schedule.Warnings == nil || len(*schedule.Warnings) != 1 || (*schedule.Warnings)[0].Severity != apiv2.Contraindicated {
+		t.Errorf("Expected a contraindication warning, got %+v", schedule.Warnings)
+	}
+
+	code, schedule, _ = update(t, fmt.Sprintf(`{"schedule_id": %d, "medicine_id": "clarithromycin", "frequency": 3, "user_id": 9701, "version": %d}`,
+		id, schedule.Version))
+	if code != http.StatusOK || schedule.Warnings != nil {
+		t.Errorf("Expected an update keeping the medicine to skip the interactions, got %d %+v", code, schedule.Warnings)
+	}
+}
+
+func TestDailyDoseLimitHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{
+		Schedule:  useCase,
+		DoseLimit: usecase.NewDoseLimitUseCase(testDoseLimitRepo, testMedicineRepo, testPolicy),
+	}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	do := func(t *testing.T, method, url, body string) (int, []byte) {
+		t.Helper()
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Actor-ID", "9501")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var buf bytes.Buffer
+		if _, err := buf.ReadFrom(resp.Body); err != nil {
+			t.Fatalf("Failed to read response: %v", err)
+		}
+		return resp.StatusCode, buf.Bytes()
+	}
+	problemCode := func(t *testing.T, body []byte) string {
+		t.Helper()
+		var problem api.Error
+		if err := json.Unmarshal(body, &problem); err != nil {
+			t.Fatalf("Failed to decode problem: %v", err)
+		}
+		return problem.Code
+	}
+
+	code, body := do(t, http.MethodPost, server.URL+"/schedule",
+		`{"medicine_name": "Panadol", "frequency": 5, "dose": 1000, "user_id": 9501}`)
+	if code != http.StatusUnprocessableEntity || problemCode(t, body) != "daily_dose_exceeded" {
+		t.Fatalf("Expected daily_dose_exceeded for 5 g of paracetamol, got %d: %s", code, body)
+	}
+
+	code, body = do(t, http.MethodPost, server.URL+"/schedule",
+		`{"medicine_name": "Panadol", "frequency": 3, "dose": 1000, "user_id": 9501}`)
+	if code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, code, body)
+	}
+
+	// Cefekon D is paracetamol too, the doses of both are summed.
+	code, body = do(t, http.MethodPost, server.URL+"/schedule",
+		`{"medicine_name": "Цефекон Д", "frequency": 2, "dose": 1000, "user_id": 9501}`)
+	if code != http.StatusUnprocessableEntity || problemCode(t, body) != "daily_dose_exceeded" {
+		t.Fatalf("Expected daily_dose_exceeded across the schedules, got %d: %s", code, body)
+	}
+
+	code, body = do(t, http.MethodPut, server.URL+"/dose-limits",
+		`{"medicine_name": "Tylenol", "max_daily_dose": 5000, "user_id": 9501}`)
+	if code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, code, body)
+	}
+	var limit api.DoseLimit
+	if err := json.Unmarshal(body, &limit); err != nil {
+		t.Fatalf("Failed to decode dose limit: %v", err)
+	}
+	if limit.Ingredient != "paracetamol" || limit.MaxDailyDose != 5000 {
+		t.Errorf("Expected a limit of 5000 mg of paracetamol, got %+v", limit)
+	}
+
+	code, body = do(t, http.MethodPost, server.URL+"/schedule",
+		`{"medicine_name": "Цефекон Д", "frequency": 2, "dose": 1000, "user_id": 9501}`)
+	if code != http.StatusOK {
+		t.Fatalf("Expected the user limit to apply, got %d: %s", code, body)
+	}
+
+	code, body = do(t, http.MethodDelete, server.URL+"/dose-limits?user_id=9501&ingredient=Paracetamol", "")
+	if code != http.StatusNoContent {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusNoContent, code, body)
+	}
+	code, body = do(t, http.MethodGet, server.URL+"/dose-limits?user_id=9501", "")
+	if code != http.StatusOK || strings.TrimSpace(string(body)) != "[]" {
+		t.Errorf("Expected no dose limits, got %d: %s", code, body)
+	}
+	code, _ = do(t, http.MethodDelete, server.URL+"/dose-limits?user_id=9501&ingredient=paracetamol", "")
+	if code != http.StatusNotFound {
+		t.Errorf("Expected status %d, got %d", http.StatusNotFound, code)
+	}
+
+	code, body = do(t, http.MethodPost, server.URL+"/schedule",
+		`{"medicine_name": "Vitamin C", "frequency": 15, "dose": 1000, "user_id": 9501}`)
+	if code != http.StatusOK {
+		t.Errorf("Expected no limit for a custom medicine, got %d: %s", code, body)
+	}
+}
+
+func TestTakingSeparationHTTP(t *testing.T) {
+	cleanupDatabase()
+
+	logger := logger.SetupLogger("local")
+	useCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	handler := httpHandler.NewScheduleHandler(usecase.UseCases{Schedule: useCase}, logger)
+	router := chi.NewRouter()
+	router.Use(mw.HTTPLoggingMiddleware(logger))
+	handler.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	do := func(t *testing.T, method, url, body string) (int, []byte) {
+		t.Helper()
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Actor-ID", "9601")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var buf bytes.Buffer
+		if _, err := buf.ReadFrom(resp.Body); err != nil {
+			t.Fatalf("Failed to read response: %v", err)
+		}
+		return resp.StatusCode, buf.Bytes()
+	}
+	create := func(t *testing.T, body string) []string {
+		t.Helper()
+		code, respBody := do(t, http.MethodPost, server.URL+"/schedule", body)
+		if code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, code, respBody)
+		}
+		var id int64
+		if err := json.Unmarshal(respBody, &id); err != nil {
+			t.Fatalf("Failed to decode schedule id: %v", err)
+		}
+
+		code, respBody = do(t, http.MethodGet, fmt.Sprintf("%s/schedule?user_id=9601&schedule_id=%d", server.URL, id), "")
+		if code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, code, respBody)
+		}
+		var schedule api.ScheduleResponse
+		if err := json.Unmarshal(respBody, &schedule); err != nil {
+			t.Fatalf("Failed to decode schedule: %v", err)
+		}
+		return *schedule.TakingTime
+	}
+
+	if times := create(t, `{"medicine_name": "Кальций", "frequency": 1, "user_id": 9601}`); !slices.Equal(times, []string{"15:00"}) {
+		t.Fatalf("Expected calcium at 15:00, got %v", times)
+	}
+	if times := create(t, `{"medicine_name": "Эутирокс", "frequency": 1, "user_id": 9601}`); !slices.Equal(times, []string{"11:00"}) {
+		t.Errorf("Expected levothyroxine 4 hours before calcium, got %v", times)
+	}
+	if times := create(t, `{"medicine_name": "Сорбифер Дурулес", "frequency": 2, "user_id": 9601}`); !slices.Equal(times, []string{"15:00", "22:00"}) {
+		t.Errorf("Expected iron 4 hours after levothyroxine, got %v", times)
+	}
+
+	// Doxycycline is taken 3 hours apart from both calcium and iron, which
+	// leaves room for 7 takings.
+	code, body := do(t, http.MethodPost, server.URL+"/schedule",
+		`{"medicine_name": "Doxycycline", "frequency": 8, "user_id": 9601}`)
+	if code != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusUnprocessableEntity, code, body)
+	}
+	var problem api.Error
+	if err := json.Unmarshal(body, &problem); err != nil {
+		t.Fatalf("Failed to decode problem: %v", err)
+	}
+	if problem.Code != "separation_unsatisfiable" || problem.Detail == nil || !strings.Contains(*problem.Detail, "Сорбифер Дурулес") {
+		t.Errorf("Expected separation_unsatisfiable naming iron, got %q: %v", problem.Code, problem.Detail)
+	}
+}
@@ -1,10 +1,13 @@
 package tests
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log/slog"
 	"os"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/internal/infrastructure/catalog"
 	"pills-taking-reminder/internal/infrastructure/postgres"
 	"pills-taking-reminder/pkg/logger"
 	"testing"
@@ -12,8 +15,15 @@ import (
 )
 
 var (
-	testDB   *sql.DB
-	testRepo *postgres.ScheduleRepository
+	testDB            *sql.DB
+	testRepo          *postgres.ScheduleRepository
+	testCaregiverRepo *postgres.CaregiverRepository
+	testRoleRepo      *postgres.RoleRepository
+	testAuditRepo     *postgres.AuditRepository
+	testMedicineRepo  *postgres.MedicineRepository
+	testDoseLimitRepo *postgres.DoseLimitRepository
+	testInteractions  *usecase.InteractionChecker
+	testPolicy        *usecase.AccessPolicy
 )
 
 func TestMain(m *testing.M) {
@@ -58,6 +68,28 @@ func TestMain(m *testing.M) {
 
 	interval := 90 * time.Minute
 	testRepo = postgres.NewScheduleRepository(testDB, logger, interval)
+	testCaregiverRepo = postgres.NewCaregiverRepository(testDB, logger)
+	testRoleRepo = postgres.NewRoleRepository(testDB, logger)
+	testAuditRepo = postgres.NewAuditRepository(testDB, logger)
+	testMedicineRepo = postgres.NewMedicineRepository(testDB, logger)
+	testDoseLimitRepo = postgres.NewDoseLimitRepository(testDB, logger)
+	testPolicy = usecase.NewAccessPolicy(testRoleRepo, testCaregiverRepo, postgres.NewAccessLogRepository(testDB, logger))
+
+	medicines, err := catalog.Medicines()
+	if err != nil {
+		fmt.Printf("Failed to read medicine catalog: %v\n", err)
+		os.Exit(1)
+	}
+	if err = testMedicineRepo.Seed(context.Background(), medicines); err != nil {
+		fmt.Printf("Failed to seed medicine catalog: %v\n", err)
+		os.Exit(1)
+	}
+	interactions, err := catalog.Interactions()
+	if err != nil {
+		fmt.Printf("Failed to read medicine interactions: %v\n", err)
+		os.Exit(1)
+	}
+	testInteractions = usecase.NewInteractionChecker(interactions)
 
 	exitCode := m.Run()
 
@@ -70,7 +102,83 @@ func TestMain(m *testing.M) {
 // Cleanup function to reset the database between test runs
 func cleanupDatabase() {
 	// Clean up the data but keep the tables
-	_, err := testDB.Exec("DELETE FROM takings")
+	_, err := testDB.Exec("DELETE FROM api_keys")
+	if err != nil {
+		fmt.Printf("Failed to clean up api keys: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM idempotency_keys")
+	if err != nil {
+		fmt.Printf("Failed to clean up idempotency keys: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM calendar_feeds")
+	if err != nil {
+		fmt.Printf("Failed to clean up calendar feeds: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM dose_limits")
+	if err != nil {
+		fmt.Printf("Failed to clean up dose limits: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM user_profiles")
+	if err != nil {
+		fmt.Printf("Failed to clean up user profiles: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM user_roles")
+	if err != nil {
+		fmt.Printf("Failed to clean up user roles: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM access_log")
+	if err != nil {
+		fmt.Printf("Failed to clean up access log: %v\n", err)
+	}
+
+	// The audit table rejects deletes, only truncation clears it.
+	_, err = testDB.Exec("TRUNCATE schedule_audit")
+	if err != nil {
+		fmt.Printf("Failed to clean up schedule audit: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM notifications")
+	if err != nil {
+		fmt.Printf("Failed to clean up notifications: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM dispatch_state")
+	if err != nil {
+		fmt.Printf("Failed to clean up dispatch state: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM planned_takings")
+	if err != nil {
+		fmt.Printf("Failed to clean up planned takings: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM intakes")
+	if err != nil {
+		fmt.Printf("Failed to clean up intakes: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM escalation_rules")
+	if err != nil {
+		fmt.Printf("Failed to clean up escalation rules: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM caregiver_links")
+	if err != nil {
+		fmt.Printf("Failed to clean up caregiver links: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM packs")
+	if err != nil {
+		fmt.Printf("Failed to clean up packs: %v\n", err)
+	}
+
+	_, err = testDB.Exec("DELETE FROM takings")
 	if err != nil {
 		fmt.Printf("Failed to clean up takings: %v\n", err)
 	}
@@ -80,3 +188,46 @@ func cleanupDatabase() {
 		fmt.Printf("Failed to clean up schedules: %v\n", err)
 	}
 }
+
+func TestMedicineKeyBackfill(t *testing.T) {
+	cleanupDatabase()
+
+	if _, err := testDB.Exec("ALTER TABLE schedules ALTER COLUMN medicine_key DROP NOT NULL"); err != nil {
+		t.Fatalf("Failed to drop the constraint: %v", err)
+	}
+	for _, name := range []string{"Аспирин", "aspirin ", "Vitamin D"} {
+		_, err := testDB.Exec(`INSERT INTO schedules(medicine_name, start_date, user_id) VALUES ($1, CURRENT_DATE, 9702)`, name)
+		if err != nil {
+			t.Fatalf("Failed to insert a schedule: %v", err)
+		}
+	}
+
+	if err := postgres.InitializeSchema(testDB, logger.SetupLogger("local")); err != nil {
+		t.Fatalf("Failed to initialize schema: %v", err)
+	}
+
+	rows, err := testDB.Query(`SELECT id, medicine_key FROM schedules WHERE user_id = 9702 ORDER BY id`)
+	if err != nil {
+		t.Fatalf("Failed to get medicine keys: %v", err)
+	}
+	defer rows.Close()
+	var ids []int64
+	var keys []string
+	for rows.Next() {
+		var id int64
+		var key string
+		if err := rows.Scan(&id, &key); err != nil {
+			t.Fatalf("Failed to scan medicine key: %v", err)
+		}
+		ids = append(ids, id)
+		keys = append(keys, key)
+	}
+	if len(keys) != 3 || keys[0] != "name:aspirin" || keys[1] != fmt.Sprintf("name:aspirin#%d", ids[1]) || keys[2] != "name:vitamin d" {
+		t.Errorf("Unexpected medicine keys: %v", keys)
+	}
+
+	_, err = testDB.Exec(`INSERT INTO schedules(medicine_name, start_date, user_id) VALUES ('Ibuprofen', CURRENT_DATE, 9702)`)
+	if err == nil {
+		t.Error("Expected a schedule without a medicine key to be rejected")
+	}
+}
@@ -0,0 +1,196 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"pills-taking-reminder/internal/domain/entities"
+	"pills-taking-reminder/internal/domain/usecase"
+	"pills-taking-reminder/internal/infrastructure/postgres"
+	"pills-taking-reminder/pkg/logger"
+)
+
+// fakeNotifier records the sent notifications instead of delivering them.
+type fakeNotifier struct {
+	mu   sync.Mutex
+	sent []entities.Notification
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, notification entities.Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = append(n.sent, notification)
+	return nil
+}
+
+func (n *fakeNotifier) count(notificationType entities.NotificationType, recipientID int64) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	count := 0
+	for _, notification := range n.sent {
+		if notification.Type == notificationType && notification.RecipientID == recipientID {
+			count++
+		}
+	}
+	return count
+}
+
+// reminderFixture is a dispatcher with a fake clock and notifier and a
+// schedule of userID taken daily at 15:00, whose caregiver gets the alerts.
+type reminderFixture struct {
+	now        time.Time
+	notifier   *fakeNotifier
+	reminders  *usecase.ReminderUseCase
+	intakes    *usecase.IntakeUseCase
+	scheduleID int64
+}
+
+func newReminderFixture(t *testing.T, userID, caregiverID int64) *reminderFixture {
+	t.Helper()
+	cleanupDatabase()
+
+	f := &reminderFixture{
+		now:      time.Date(2025, 6, 2, 14, 0, 0, 0, time.UTC),
+		notifier: &fakeNotifier{},
+	}
+	clock := func() time.Time { return f.now }
+	entities.TimeNow, usecase.TimeNow, postgres.TimeNow = clock, clock, clock
+	t.Cleanup(func() { entities.TimeNow, usecase.TimeNow, postgres.TimeNow = time.Now, time.Now, time.Now })
+
+	logger := logger.SetupLogger("local")
+	intakeRepo := postgres.NewIntakeRepository(testDB, logger)
+	takingRepo := postgres.NewTakingRepository(testDB, logger)
+	scheduleUseCase := usecase.NewScheduleUseCase(testRepo, testAuditRepo, testMedicineRepo, testDoseLimitRepo, testInteractions, testPolicy, 90*time.Minute)
+	f.reminders = usecase.NewReminderUseCase(testRepo, postgres.NewInventoryRepository(testDB, logger),
+		postgres.NewNotificationRepository(testDB, logger), takingRepo, intakeRepo, testCaregiverRepo,
+		postgres.NewEscalationRepository(testDB, logger), postgres.NewProfileRepository(testDB, logger),
+		f.notifier, testPolicy, usecase.ReminderSettings{
+			Tick:                time.Minute,
+			RefillThresholdDays: 3,
+			SnoozePeriod:        10 * time.Minute,
+			MaxSnoozes:          3,
+			MissedGracePeriod:   30 * time.Minute,
+			CaregiverDelay:      30 * time.Minute,
+		})
+	f.intakes = usecase.NewIntakeUseCase(testRepo, intakeRepo, takingRepo, testPolicy)
+
+	var err error
+	f.scheduleID, _, err = scheduleUseCase.CreateSchedule(context.Background(), usecase.ScheduleInput{
+		MedicineName: "Reminder Med",
+		Frequency:    1,
+		UserID:       userID,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test schedule: %v", err)
+	}
+
+	alerts := true
+	_, err = usecase.NewCaregiverUseCase(testCaregiverRepo, testPolicy).LinkCaregiver(context.Background(), usecase.CaregiverInput{
+		UserID:        userID,
+		CaregiverID:   caregiverID,
+		ReceiveAlerts: &alerts,
+	})
+	if err != nil {
+		t.Fatalf("Failed to link caregiver: %v", err)
+	}
+
+	return f
+}
+
+// dispatchAt moves the clock to the time of the planned day and dispatches.
+func (f *reminderFixture) dispatchAt(t *testing.T, hour, minute int) {
+	t.Helper()
+	f.now = time.Date(2025, 6, 2, hour, minute, 0, 0, time.UTC)
+	if err := f.reminders.Dispatch(context.Background()); err != nil {
+		t.Fatalf("Failed to dispatch at %02d:%02d: %v", hour, minute, err)
+	}
+}
+
+func (f *reminderFixture) plannedAt() time.Time {
+	return time.Date(2025, 6, 2, 15, 0, 0, 0, time.UTC)
+}
+
+func TestReminderSnooze(t *testing.T) {
+	f := newReminderFixture(t, 9801, 9802)
+
+	f.dispatchAt(t, 14, 59)
+	f.dispatchAt(t, 15, 0)
+	f.dispatchAt(t, 15, 0)
+	if count := f.notifier.count(entities.NotificationTaking, 9801); count != 1 {
+		t.Fatalf("Expected 1 reminder at the taking, got %d", count)
+	}
+
+	f.now = time.Date(2025, 6, 2, 15, 1, 0, 0, time.UTC)
+	_, err := f.reminders.SnoozeTaking(context.Background(), usecase.SnoozeInput{
+		UserID:     9801,
+		ScheduleID: f.scheduleID,
+		PlannedAt:  f.plannedAt(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to snooze: %v", err)
+	}
+
+	f.dispatchAt(t, 15, 5)
+	if count := f.notifier.count(entities.NotificationTaking, 9801); count != 1 {
+		t.Errorf("Expected no reminder while snoozed, got %d", count)
+	}
+
+	f.dispatchAt(t, 15, 11)
+	f.dispatchAt(t, 15, 12)
+	if count := f.notifier.count(entities.NotificationTaking, 9801); count != 2 {
+		t.Fatalf("Expected the snoozed reminder once, got %d reminders", count)
+	}
+	if last := f.notifier.sent[len(f.notifier.sent)-1]; last.Attempt != 1 || !last.PlannedAt.Equal(f.plannedAt()) {
+		t.Errorf("Expected the first snooze of the 15:00 taking, got %+v", last)
+	}
+}
+
+func TestReminderEscalation(t *testing.T) {
+	f := newReminderFixture(t, 9803, 9804)
+
+	f.dispatchAt(t, 15, 0)
+	f.dispatchAt(t, 15, 30)
+	if count := f.notifier.count(entities.NotificationMissed, 9803); count != 1 {
+		t.Errorf("Expected 1 missed dose reminder after the grace period, got %d", count)
+	}
+	if count := f.notifier.count(entities.NotificationEscalation, 9804); count != 0 {
+		t.Errorf("Expected no escalation before the caregiver delay, got %d", count)
+	}
+
+	f.dispatchAt(t, 16, 0)
+	f.dispatchAt(t, 16, 1)
+	f.dispatchAt(t, 16, 5)
+	if count := f.notifier.count(entities.NotificationEscalation, 9804); count != 1 {
+		t.Errorf("Expected the caregiver to be alerted exactly once, got %d", count)
+	}
+	if count := f.notifier.count(entities.NotificationMissed, 9803); count != 1 {
+		t.Errorf("Expected the missed dose reminder exactly once, got %d", count)
+	}
+}
+
+func TestReminderIntakeStopsEscalation(t *testing.T) {
+	f := newReminderFixture(t, 9805, 9806)
+
+	f.dispatchAt(t, 15, 0)
+
+	f.now = time.Date(2025, 6, 2, 15, 10, 0, 0, time.UTC)
+	_, err := f.intakes.RecordIntake(context.Background(), usecase.IntakeInput{
+		UserID:     9805,
+		ScheduleID: f.scheduleID,
+		PlannedAt:  f.plannedAt(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to record intake: %v", err)
+	}
+
+	f.dispatchAt(t, 15, 30)
+	f.dispatchAt(t, 16, 0)
+	if count := f.notifier.count(entities.NotificationMissed, 9805); count != 0 {
+		t.Errorf("Expected no missed dose reminder after the intake, got %d", count)
+	}
+	if count := f.notifier.count(entities.NotificationEscalation, 9806); count != 0 {
+		t.Errorf("Expected no escalation after the intake, got %d", count)
+	}
+}